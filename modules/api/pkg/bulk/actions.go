@@ -0,0 +1,236 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"k8s.io/dashboard/api/pkg/resource/deployment"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// action is performed on every target. Validate is called in the dry-run mode as well, so that
+// targets the action is not applicable to are reported. In the dry-run mode perform sends the
+// request with dry-run set, so that existence, RBAC and admission are checked by the API server.
+type action interface {
+	validate(target *target) error
+	perform(target *target, dryRun bool) error
+}
+
+func newAction(spec *ActionSpec) (action, error) {
+	switch spec.Action {
+	case ActionDelete:
+		return newDeleteAction(spec.Propagation)
+	case ActionScale:
+		if spec.Replicas == nil || *spec.Replicas < 0 {
+			return nil, errors.NewBadRequest("replicas must be set to a non-negative number")
+		}
+		return &patchAction{
+			validator:   validateScalable,
+			subresource: "scale",
+			patch:       map[string]interface{}{"spec": map[string]interface{}{"replicas": *spec.Replicas}},
+		}, nil
+	case ActionRestart:
+		return &restartAction{}, nil
+	case ActionLabel:
+		if err := validateMetadata(spec.Labels, true); err != nil {
+			return nil, err
+		}
+		return &patchAction{patch: metadataPatch("labels", spec.Labels)}, nil
+	case ActionAnnotate:
+		if err := validateMetadata(spec.Annotations, false); err != nil {
+			return nil, err
+		}
+		return &patchAction{patch: metadataPatch("annotations", spec.Annotations)}, nil
+	case ActionSuspend:
+		suspend := spec.Suspend == nil || *spec.Suspend
+		return &patchAction{
+			validator: validateSuspendable,
+			patch:     map[string]interface{}{"spec": map[string]interface{}{"suspend": suspend}},
+		}, nil
+	default:
+		return nil, errors.NewBadRequest(fmt.Sprintf("unsupported action %q", spec.Action))
+	}
+}
+
+// deleteAction deletes targets with the given propagation policy.
+type deleteAction struct {
+	propagation metaV1.DeletionPropagation
+}
+
+func newDeleteAction(propagation string) (*deleteAction, error) {
+	switch metaV1.DeletionPropagation(propagation) {
+	case metaV1.DeletePropagationBackground, metaV1.DeletePropagationForeground, metaV1.DeletePropagationOrphan:
+		return &deleteAction{propagation: metaV1.DeletionPropagation(propagation)}, nil
+	case "":
+		// Do cascade delete by default, same as the "_raw" delete endpoints.
+		return &deleteAction{propagation: metaV1.DeletePropagationForeground}, nil
+	default:
+		return nil, errors.NewBadRequest(fmt.Sprintf("unsupported propagation policy %q", propagation))
+	}
+}
+
+func (in *deleteAction) validate(*target) error {
+	return nil
+}
+
+func (in *deleteAction) perform(target *target, dryRun bool) error {
+	return target.client.Delete(context.TODO(), target.ref.Name, metaV1.DeleteOptions{
+		PropagationPolicy: &in.propagation,
+		DryRun:            dryRunOption(dryRun),
+	})
+}
+
+// patchAction applies a JSON merge patch to targets or their subresource.
+type patchAction struct {
+	validator   func(target *target) error
+	subresource string
+	patch       map[string]interface{}
+}
+
+func (in *patchAction) validate(target *target) error {
+	if in.validator == nil {
+		return nil
+	}
+
+	return in.validator(target)
+}
+
+func (in *patchAction) perform(target *target, dryRun bool) error {
+	return mergePatch(target, in.patch, in.subresource, dryRun)
+}
+
+// restartAction restarts workloads in the manner of `kubectl rollout restart`.
+type restartAction struct{}
+
+func (in *restartAction) validate(target *target) error {
+	gvk := target.mapping.GroupVersionKind
+	if gvk.Group != appsv1.GroupName || !kindOf(target).Restartable() {
+		return fmt.Errorf("%s cannot be restarted", gvk.Kind)
+	}
+
+	return nil
+}
+
+func (in *restartAction) perform(target *target, dryRun bool) error {
+	return mergePatch(target, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]interface{}{
+						deployment.RestartedAtAnnotationKey: time.Now().Format(time.RFC3339),
+					},
+				},
+			},
+		},
+	}, "", dryRun)
+}
+
+// validateScalable rejects built-in kinds that cannot be scaled. Custom resources are accepted, as
+// scale support depends on their definition and is checked by the API server.
+func validateScalable(target *target) error {
+	gvk := target.mapping.GroupVersionKind
+	if scheme.Scheme.IsGroupRegistered(gvk.Group) && !kindOf(target).Scalable() {
+		return fmt.Errorf("%s cannot be scaled", gvk.Kind)
+	}
+
+	return nil
+}
+
+func validateSuspendable(target *target) error {
+	gvk := target.mapping.GroupVersionKind
+	if gvk.Group != batchv1.GroupName || (gvk.Kind != "CronJob" && gvk.Kind != "Job") {
+		return fmt.Errorf("%s cannot be suspended", gvk.Kind)
+	}
+
+	return nil
+}
+
+func validateMetadata(values map[string]*string, isLabel bool) error {
+	if len(values) == 0 {
+		return errors.NewBadRequest("at least one key is required")
+	}
+
+	for key, value := range values {
+		if msgs := validation.IsQualifiedName(key); len(msgs) > 0 {
+			return errors.NewBadRequest(fmt.Sprintf("invalid key %q: %s", key, strings.Join(msgs, "; ")))
+		}
+
+		if !isLabel || value == nil {
+			continue
+		}
+
+		if msgs := validation.IsValidLabelValue(*value); len(msgs) > 0 {
+			return errors.NewBadRequest(fmt.Sprintf("invalid value of %q: %s", key, strings.Join(msgs, "; ")))
+		}
+	}
+
+	return nil
+}
+
+func metadataPatch(field string, values map[string]*string) map[string]interface{} {
+	patch := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		if value == nil {
+			// Null removes the key in JSON merge patch.
+			patch[key] = nil
+			continue
+		}
+
+		patch[key] = *value
+	}
+
+	return map[string]interface{}{"metadata": map[string]interface{}{field: patch}}
+}
+
+func mergePatch(target *target, patch map[string]interface{}, subresource string, dryRun bool) error {
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+
+	subresources := make([]string, 0, 1)
+	if len(subresource) > 0 {
+		subresources = append(subresources, subresource)
+	}
+
+	_, err = target.client.Patch(context.TODO(), target.ref.Name, k8stypes.MergePatchType, data,
+		metaV1.PatchOptions{DryRun: dryRunOption(dryRun)}, subresources...)
+	return err
+}
+
+func dryRunOption(dryRun bool) []string {
+	if !dryRun {
+		return nil
+	}
+
+	return []string{metaV1.DryRunAll}
+}
+
+func kindOf(target *target) types.ResourceKind {
+	return types.ResourceKind(strings.ToLower(target.mapping.GroupVersionKind.Kind))
+}
@@ -0,0 +1,259 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulk
+
+import (
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/errors"
+)
+
+// ActionType is the type of the action performed on all selected objects.
+type ActionType string
+
+// List of supported bulk actions.
+const (
+	ActionDelete   ActionType = "delete"
+	ActionScale    ActionType = "scale"
+	ActionRestart  ActionType = "restart"
+	ActionLabel    ActionType = "label"
+	ActionAnnotate ActionType = "annotate"
+	ActionSuspend  ActionType = "suspend"
+)
+
+// ItemStatus is the outcome of the action for a single object.
+type ItemStatus string
+
+// List of item statuses.
+const (
+	// ItemStatusSucceeded means that the action was performed on the object.
+	ItemStatusSucceeded ItemStatus = "succeeded"
+	// ItemStatusFailed means that the action failed, see the item error for details.
+	ItemStatusFailed ItemStatus = "failed"
+	// ItemStatusDryRun means that the object would be affected by the action.
+	ItemStatusDryRun ItemStatus = "dryRun"
+)
+
+const (
+	// defaultConcurrency is the number of objects processed in parallel when not set in the spec.
+	defaultConcurrency = 5
+
+	// maxConcurrency is the upper bound of objects processed in parallel.
+	maxConcurrency = 20
+)
+
+// ObjectReference identifies a single object. Kind is the same as used by the "_raw" endpoints,
+// i.e. lowercase kind of the resource, e.g. "deployment", or "resource.group" for custom resources.
+type ObjectReference struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// Selector selects all objects of given kind matching the label selector.
+type Selector struct {
+	Kind string `json:"kind"`
+
+	// Namespace to select objects from. Empty means all namespaces for namespaced kinds.
+	Namespace string `json:"namespace,omitempty"`
+
+	// LabelSelector in the standard format, e.g. "app=nginx,tier!=frontend". It is required to
+	// prevent accidental actions on all objects of given kind.
+	LabelSelector string `json:"labelSelector"`
+}
+
+// ActionSpec describes an action performed on multiple objects. Objects are selected either by
+// the list of references or by the selector.
+type ActionSpec struct {
+	Action ActionType `json:"action"`
+
+	Items    []ObjectReference `json:"items,omitempty"`
+	Selector *Selector         `json:"selector,omitempty"`
+
+	// Propagation is the delete propagation policy used by the delete action.
+	Propagation string `json:"propagation,omitempty"`
+
+	// Replicas is the desired number of replicas set by the scale action.
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Labels and Annotations are set by the label and annotate actions. Null values remove
+	// the key.
+	Labels      map[string]*string `json:"labels,omitempty"`
+	Annotations map[string]*string `json:"annotations,omitempty"`
+
+	// Suspend is the value set by the suspend action. Defaults to true, false resumes objects.
+	Suspend *bool `json:"suspend,omitempty"`
+
+	// Concurrency is the number of objects processed in parallel.
+	Concurrency int `json:"concurrency,omitempty"`
+
+	// DryRun only lists objects that would be affected by the action. Requests are sent to the API
+	// server in the dry-run mode, so objects that are missing or cannot be modified are reported
+	// as failed.
+	DryRun bool `json:"dryRun"`
+}
+
+// ItemResult is the result of the action for a single object.
+type ItemResult struct {
+	ObjectReference `json:",inline"`
+
+	Status ItemStatus `json:"status"`
+	Error  string     `json:"error,omitempty"`
+}
+
+// ActionResult contains results of the action for all selected objects in the order they were
+// selected.
+type ActionResult struct {
+	Action    ActionType   `json:"action"`
+	DryRun    bool         `json:"dryRun"`
+	Succeeded int          `json:"succeeded"`
+	Failed    int          `json:"failed"`
+	Items     []ItemResult `json:"items"`
+}
+
+// PerformAction performs the action on all objects selected by the spec.
+func PerformAction(cfg *rest.Config, spec *ActionSpec) (*ActionResult, error) {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+	return performAction(dynamicClient, mapper, spec)
+}
+
+func performAction(client dynamic.Interface, mapper meta.RESTMapper, spec *ActionSpec) (*ActionResult, error) {
+	action, err := newAction(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := newTargetResolver(client, mapper)
+	targets, err := resolver.resolve(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(2).InfoS("performing bulk action", "action", spec.Action, "objects", len(targets), "dryRun", spec.DryRun)
+	result := &ActionResult{
+		Action: spec.Action,
+		DryRun: spec.DryRun,
+		Items:  make([]ItemResult, len(targets)),
+	}
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency(spec))
+	for i := range targets {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			result.Items[i] = perform(action, targets[i], spec.DryRun)
+		}(i)
+	}
+	wg.Wait()
+
+	for _, item := range result.Items {
+		switch item.Status {
+		case ItemStatusFailed:
+			result.Failed++
+		case ItemStatusSucceeded:
+			result.Succeeded++
+		}
+	}
+
+	return result, nil
+}
+
+func perform(action action, target *target, dryRun bool) ItemResult {
+	result := ItemResult{ObjectReference: target.ref}
+	if target.err != nil {
+		result.Status = ItemStatusFailed
+		result.Error = target.err.Error()
+		return result
+	}
+
+	if err := action.validate(target); err != nil {
+		result.Status = ItemStatusFailed
+		result.Error = err.Error()
+		return result
+	}
+
+	if err := action.perform(target, dryRun); err != nil {
+		klog.V(3).InfoS("bulk action failed", "kind", target.ref.Kind, "namespace", target.ref.Namespace, "name", target.ref.Name, "dryRun", dryRun, "error", err)
+		result.Status = ItemStatusFailed
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Status = ItemStatusSucceeded
+	if dryRun {
+		result.Status = ItemStatusDryRun
+	}
+
+	return result
+}
+
+func concurrency(spec *ActionSpec) int {
+	switch {
+	case spec.Concurrency <= 0:
+		return defaultConcurrency
+	case spec.Concurrency > maxConcurrency:
+		return maxConcurrency
+	default:
+		return spec.Concurrency
+	}
+}
+
+func validateSpec(spec *ActionSpec) error {
+	if len(spec.Items) == 0 && spec.Selector == nil {
+		return errors.NewBadRequest("either items or selector is required")
+	}
+
+	if len(spec.Items) > 0 && spec.Selector != nil {
+		return errors.NewBadRequest("items and selector cannot be used together")
+	}
+
+	if spec.Selector != nil && len(spec.Selector.LabelSelector) == 0 {
+		return errors.NewBadRequest("label selector is required")
+	}
+
+	if spec.Selector != nil && len(spec.Selector.Kind) == 0 {
+		return errors.NewBadRequest("selector kind is required")
+	}
+
+	for _, ref := range spec.Items {
+		if len(ref.Kind) == 0 || len(ref.Name) == 0 {
+			return errors.NewBadRequest(fmt.Sprintf("kind and name are required for all items, got %+v", ref))
+		}
+	}
+
+	return nil
+}
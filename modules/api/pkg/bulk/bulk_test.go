@@ -0,0 +1,274 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulk
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"k8s.io/dashboard/errors"
+)
+
+var (
+	podGVR        = schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	deploymentGVR = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	cronJobGVR    = schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "cronjobs"}
+)
+
+func newTestObject(apiVersion, kind, namespace, name string, labels map[string]string) *unstructured.Unstructured {
+	object := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+	}}
+	object.SetNamespace(namespace)
+	object.SetName(name)
+	object.SetLabels(labels)
+	return object
+}
+
+func newTestClients(objects ...runtime.Object) (dynamic.Interface, meta.RESTMapper) {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "CronJob"}, meta.RESTScopeNamespace)
+
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		podGVR:        "PodList",
+		deploymentGVR: "DeploymentList",
+		cronJobGVR:    "CronJobList",
+	}, objects...)
+
+	return &dryRunClient{Interface: client}, mapper
+}
+
+// dryRunClient emulates server-side dry-run, which is not supported by the fake dynamic client.
+// Dry-run deletes and patches only get the object.
+type dryRunClient struct {
+	dynamic.Interface
+}
+
+func (in *dryRunClient) Resource(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return &dryRunNamespaceableResource{NamespaceableResourceInterface: in.Interface.Resource(resource)}
+}
+
+type dryRunNamespaceableResource struct {
+	dynamic.NamespaceableResourceInterface
+}
+
+func (in *dryRunNamespaceableResource) Namespace(namespace string) dynamic.ResourceInterface {
+	return &dryRunResource{ResourceInterface: in.NamespaceableResourceInterface.Namespace(namespace)}
+}
+
+type dryRunResource struct {
+	dynamic.ResourceInterface
+}
+
+func (in *dryRunResource) Delete(ctx context.Context, name string, options metaV1.DeleteOptions, subresources ...string) error {
+	if len(options.DryRun) > 0 {
+		_, err := in.Get(ctx, name, metaV1.GetOptions{}, subresources...)
+		return err
+	}
+
+	return in.ResourceInterface.Delete(ctx, name, options, subresources...)
+}
+
+func (in *dryRunResource) Patch(ctx context.Context, name string, pt k8stypes.PatchType, data []byte,
+	options metaV1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	if len(options.DryRun) > 0 {
+		return in.Get(ctx, name, metaV1.GetOptions{}, subresources...)
+	}
+
+	return in.ResourceInterface.Patch(ctx, name, pt, data, options, subresources...)
+}
+
+func TestPerformActionDeleteBySelector(t *testing.T) {
+	client, mapper := newTestClients(
+		newTestObject("v1", "Pod", "default", "failed-1", map[string]string{"app": "job"}),
+		newTestObject("v1", "Pod", "default", "failed-2", map[string]string{"app": "job"}),
+		newTestObject("v1", "Pod", "default", "web", map[string]string{"app": "web"}),
+		newTestObject("v1", "Pod", "other", "failed-3", map[string]string{"app": "job"}),
+	)
+	spec := &ActionSpec{
+		Action:   ActionDelete,
+		Selector: &Selector{Kind: "pod", Namespace: "default", LabelSelector: "app=job"},
+		DryRun:   true,
+	}
+
+	actual, err := performAction(client, mapper, spec)
+	if err != nil {
+		t.Fatalf("performAction() returned error: %v", err)
+	}
+
+	if len(actual.Items) != 2 || actual.Succeeded != 0 || actual.Failed != 0 {
+		t.Fatalf("performAction() in dry-run mode == %#v, expected 2 affected pods", actual)
+	}
+
+	for _, item := range actual.Items {
+		if item.Status != ItemStatusDryRun {
+			t.Errorf("item %s has status %s, expected %s", item.Name, item.Status, ItemStatusDryRun)
+		}
+	}
+
+	pods, _ := client.Resource(podGVR).Namespace("").List(context.TODO(), metaV1.ListOptions{})
+	if len(pods.Items) != 4 {
+		t.Fatalf("performAction() in dry-run mode should not delete pods, %d left", len(pods.Items))
+	}
+
+	spec.DryRun = false
+	actual, err = performAction(client, mapper, spec)
+	if err != nil {
+		t.Fatalf("performAction() returned error: %v", err)
+	}
+
+	if actual.Succeeded != 2 || actual.Failed != 0 {
+		t.Errorf("performAction() == %#v, expected 2 deleted pods", actual)
+	}
+
+	pods, _ = client.Resource(podGVR).Namespace("").List(context.TODO(), metaV1.ListOptions{})
+	if len(pods.Items) != 2 {
+		t.Errorf("performAction() should delete 2 pods, %d left", len(pods.Items))
+	}
+}
+
+func TestPerformActionRestart(t *testing.T) {
+	client, mapper := newTestClients(
+		newTestObject("apps/v1", "Deployment", "default", "web", nil),
+		newTestObject("v1", "Pod", "default", "web", nil),
+	)
+	spec := &ActionSpec{
+		Action: ActionRestart,
+		Items: []ObjectReference{
+			{Kind: "deployment", Namespace: "default", Name: "web"},
+			{Kind: "pod", Namespace: "default", Name: "web"},
+			{Kind: "deployment", Namespace: "default", Name: "missing"},
+			{Kind: "unknown", Namespace: "default", Name: "web"},
+			{Kind: "deployment", Name: "web"},
+		},
+	}
+
+	actual, err := performAction(client, mapper, spec)
+	if err != nil {
+		t.Fatalf("performAction() returned error: %v", err)
+	}
+
+	expected := []ItemStatus{ItemStatusSucceeded, ItemStatusFailed, ItemStatusFailed, ItemStatusFailed, ItemStatusFailed}
+	statuses := make([]ItemStatus, 0, len(actual.Items))
+	for i, item := range actual.Items {
+		statuses = append(statuses, item.Status)
+		if item.ObjectReference != spec.Items[i] {
+			t.Errorf("item %d == %#v, expected results in the order of items", i, item.ObjectReference)
+		}
+	}
+
+	if !reflect.DeepEqual(statuses, expected) || actual.Succeeded != 1 || actual.Failed != 4 {
+		t.Errorf("performAction() returned statuses %v, expected %v", statuses, expected)
+	}
+
+	deployment, _ := client.Resource(deploymentGVR).Namespace("default").Get(context.TODO(), "web", metaV1.GetOptions{})
+	annotations, _, _ := unstructured.NestedStringMap(deployment.Object, "spec", "template", "metadata", "annotations")
+	if len(annotations["kubectl.kubernetes.io/restartedAt"]) == 0 {
+		t.Errorf("performAction() should set restartedAt annotation, got %v", annotations)
+	}
+}
+
+func TestPerformActionDryRunItems(t *testing.T) {
+	client, mapper := newTestClients(
+		newTestObject("batch/v1", "CronJob", "default", "backup", map[string]string{"team": "a"}),
+	)
+	spec := &ActionSpec{
+		Action: ActionSuspend,
+		Items: []ObjectReference{
+			{Kind: "cronjob", Namespace: "default", Name: "backup"},
+			{Kind: "cronjob", Namespace: "default", Name: "missing"},
+		},
+		DryRun: true,
+	}
+
+	actual, err := performAction(client, mapper, spec)
+	if err != nil {
+		t.Fatalf("performAction() returned error: %v", err)
+	}
+
+	if actual.Items[0].Status != ItemStatusDryRun || actual.Items[1].Status != ItemStatusFailed || actual.Failed != 1 {
+		t.Errorf("performAction() in dry-run mode == %#v, expected missing cron job to fail", actual)
+	}
+
+	cronJob, _ := client.Resource(cronJobGVR).Namespace("default").Get(context.TODO(), "backup", metaV1.GetOptions{})
+	if _, found, _ := unstructured.NestedBool(cronJob.Object, "spec", "suspend"); found {
+		t.Error("performAction() in dry-run mode should not suspend cron job")
+	}
+}
+
+func TestPerformActionLabelAndSuspend(t *testing.T) {
+	client, mapper := newTestClients(
+		newTestObject("batch/v1", "CronJob", "default", "backup", map[string]string{"team": "a", "old": "true"}),
+	)
+	ref := ObjectReference{Kind: "cronjob", Namespace: "default", Name: "backup"}
+	team := "b"
+
+	_, err := performAction(client, mapper, &ActionSpec{
+		Action: ActionLabel,
+		Items:  []ObjectReference{ref},
+		Labels: map[string]*string{"team": &team, "old": nil},
+	})
+	if err != nil {
+		t.Fatalf("performAction() returned error: %v", err)
+	}
+
+	result, err := performAction(client, mapper, &ActionSpec{Action: ActionSuspend, Items: []ObjectReference{ref}})
+	if err != nil || result.Succeeded != 1 {
+		t.Fatalf("performAction() == %#v, %v, expected suspended cron job", result, err)
+	}
+
+	cronJob, _ := client.Resource(cronJobGVR).Namespace("default").Get(context.TODO(), "backup", metaV1.GetOptions{})
+	if labels := cronJob.GetLabels(); !reflect.DeepEqual(labels, map[string]string{"team": "b"}) {
+		t.Errorf("performAction() should update labels, got %v", labels)
+	}
+
+	if suspend, _, _ := unstructured.NestedBool(cronJob.Object, "spec", "suspend"); !suspend {
+		t.Error("performAction() should suspend cron job")
+	}
+}
+
+func TestPerformActionInvalidSpec(t *testing.T) {
+	client, mapper := newTestClients()
+	replicas := int32(-1)
+	invalid := "invalid value!"
+	cases := []*ActionSpec{
+		{Action: ActionDelete},
+		{Action: ActionDelete, Selector: &Selector{Kind: "pod"}},
+		{Action: ActionDelete, Selector: &Selector{Kind: "pod", LabelSelector: "app=a"}, Items: []ObjectReference{{Kind: "pod", Name: "a"}}},
+		{Action: ActionDelete, Propagation: "unknown", Items: []ObjectReference{{Kind: "pod", Name: "a"}}},
+		{Action: ActionScale, Replicas: &replicas, Items: []ObjectReference{{Kind: "deployment", Name: "a"}}},
+		{Action: ActionLabel, Labels: map[string]*string{"app": &invalid}, Items: []ObjectReference{{Kind: "pod", Name: "a"}}},
+		{Action: "unknown", Items: []ObjectReference{{Kind: "pod", Name: "a"}}},
+	}
+
+	for _, c := range cases {
+		if _, err := performAction(client, mapper, c); err == nil || errors.IsNotFound(err) {
+			t.Errorf("performAction(%#v) should return bad request error, got %v", c, err)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulk
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"k8s.io/dashboard/errors"
+)
+
+// target is a single object the action is performed on. Err is set when the reference could not
+// be resolved, e.g. because of an unknown kind.
+type target struct {
+	ref     ObjectReference
+	mapping *meta.RESTMapping
+	client  dynamic.ResourceInterface
+	err     error
+}
+
+// targetResolver resolves object references and selectors to targets. REST mappings are cached
+// for the lifetime of the resolver.
+type targetResolver struct {
+	client   dynamic.Interface
+	mapper   meta.RESTMapper
+	mappings map[string]*meta.RESTMapping
+}
+
+func newTargetResolver(client dynamic.Interface, mapper meta.RESTMapper) *targetResolver {
+	return &targetResolver{
+		client:   client,
+		mapper:   mapper,
+		mappings: make(map[string]*meta.RESTMapping),
+	}
+}
+
+func (in *targetResolver) resolve(spec *ActionSpec) ([]*target, error) {
+	if err := validateSpec(spec); err != nil {
+		return nil, err
+	}
+
+	if spec.Selector != nil {
+		return in.resolveSelector(spec.Selector)
+	}
+
+	targets := make([]*target, 0, len(spec.Items))
+	for _, ref := range spec.Items {
+		targets = append(targets, in.resolveReference(ref))
+	}
+
+	return targets, nil
+}
+
+func (in *targetResolver) resolveReference(ref ObjectReference) *target {
+	result := &target{ref: ref}
+
+	mapping, err := in.mappingFor(ref.Kind)
+	if err != nil {
+		result.err = err
+		return result
+	}
+
+	namespaced := mapping.Scope.Name() == meta.RESTScopeNameNamespace
+	if namespaced && len(ref.Namespace) == 0 {
+		result.err = fmt.Errorf("namespace is required for %s", ref.Kind)
+		return result
+	}
+
+	if !namespaced {
+		result.ref.Namespace = ""
+	}
+
+	result.mapping = mapping
+	result.client = in.client.Resource(mapping.Resource).Namespace(result.ref.Namespace)
+	return result
+}
+
+func (in *targetResolver) resolveSelector(selector *Selector) ([]*target, error) {
+	if _, err := labels.Parse(selector.LabelSelector); err != nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("invalid label selector: %s", err))
+	}
+
+	mapping, err := in.mappingFor(selector.Kind)
+	if err != nil {
+		return nil, errors.NewBadRequest(err.Error())
+	}
+
+	namespace := selector.Namespace
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		namespace = ""
+	}
+
+	list, err := in.client.Resource(mapping.Resource).Namespace(namespace).List(context.TODO(),
+		metaV1.ListOptions{LabelSelector: selector.LabelSelector})
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]*target, 0, len(list.Items))
+	for _, item := range list.Items {
+		ref := ObjectReference{Kind: selector.Kind, Namespace: item.GetNamespace(), Name: item.GetName()}
+		targets = append(targets, &target{
+			ref:     ref,
+			mapping: mapping,
+			client:  in.client.Resource(mapping.Resource).Namespace(ref.Namespace),
+		})
+	}
+
+	return targets, nil
+}
+
+// mappingFor returns the REST mapping for the kind. Kinds are resolved the same way as by
+// kubectl, i.e. both "deployment" and "deployments.apps" are accepted.
+func (in *targetResolver) mappingFor(kind string) (*meta.RESTMapping, error) {
+	if mapping, exists := in.mappings[kind]; exists {
+		return mapping, nil
+	}
+
+	gvr, err := in.mapper.ResourceFor(schema.ParseGroupResource(kind).WithVersion(""))
+	if err != nil {
+		return nil, fmt.Errorf("unknown kind %s: %w", kind, err)
+	}
+
+	gvk, err := in.mapper.KindFor(gvr)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping, err := in.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	in.mappings[kind] = mapping
+	return mapping, nil
+}
@@ -0,0 +1,312 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drift
+
+import (
+	"reflect"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+	"sigs.k8s.io/structured-merge-diff/v4/value"
+
+	"k8s.io/dashboard/api/pkg/ownership"
+)
+
+// ignoredPaths are fields populated by the API server or controllers that are never reported.
+var ignoredPaths = map[string]bool{
+	".status":                              true,
+	".metadata.managedFields":              true,
+	".metadata.resourceVersion":            true,
+	".metadata.uid":                        true,
+	".metadata.selfLink":                   true,
+	".metadata.creationTimestamp":          true,
+	".metadata.generation":                 true,
+	".metadata.deletionTimestamp":          true,
+	".metadata.deletionGracePeriodSeconds": true,
+	".metadata.ownerReferences":            true,
+	fieldpath.MakePathOrDie("metadata", "annotations", v1.LastAppliedConfigAnnotation).String():      true,
+	fieldpath.MakePathOrDie("metadata", "annotations", "deployment.kubernetes.io/revision").String(): true,
+}
+
+// listKeyField is the field used to match items of lists of objects, e.g. containers, volumes or
+// environment variables. Other lists are compared by index.
+const listKeyField = "name"
+
+// comparer collects differences between a baseline and a live object.
+type comparer struct {
+	live    *unstructured.Unstructured
+	owners  []fieldOwner
+	changes []FieldChange
+}
+
+func newComparer(live *unstructured.Unstructured) *comparer {
+	return &comparer{live: live, owners: fieldOwners(live), changes: make([]FieldChange, 0)}
+}
+
+// compare walks the desired value and records fields that differ on the live value. Fields set
+// only on the live object are reported when a field manager owns them, so that defaults set by
+// the API server are not reported.
+func (in *comparer) compare(desired, live interface{}, path fieldpath.Path) {
+	if ignoredPaths[path.String()] {
+		return
+	}
+
+	switch typed := desired.(type) {
+	case map[string]interface{}:
+		liveMap, ok := live.(map[string]interface{})
+		if !ok {
+			in.record(path, ChangeTypeChanged, desired, live)
+			return
+		}
+
+		in.compareMaps(typed, liveMap, path)
+	case []interface{}:
+		liveList, ok := live.([]interface{})
+		if !ok {
+			in.record(path, ChangeTypeChanged, desired, live)
+			return
+		}
+
+		in.compareLists(typed, liveList, path)
+	default:
+		if !equalScalars(desired, live) {
+			in.record(path, ChangeTypeChanged, desired, live)
+		}
+	}
+}
+
+func (in *comparer) compareMaps(desired, live map[string]interface{}, path fieldpath.Path) {
+	for _, key := range sortedKeys(desired) {
+		fieldPath := appendPath(path, fieldpath.PathElement{FieldName: &key})
+		liveValue, exists := live[key]
+		if !exists {
+			if !isEmpty(desired[key]) && !ignoredPaths[fieldPath.String()] {
+				in.record(fieldPath, ChangeTypeRemoved, desired[key], nil)
+			}
+			continue
+		}
+
+		in.compare(desired[key], liveValue, fieldPath)
+	}
+
+	for _, key := range sortedKeys(live) {
+		if _, exists := desired[key]; !exists {
+			in.recordAdded(appendPath(path, fieldpath.PathElement{FieldName: &key}), live[key])
+		}
+	}
+}
+
+func (in *comparer) compareLists(desired, live []interface{}, path fieldpath.Path) {
+	if !isKeyedList(desired) || !isKeyedList(live) {
+		if len(desired) != len(live) {
+			in.record(path, ChangeTypeChanged, desired, live)
+			return
+		}
+
+		for i := range desired {
+			index := i
+			in.compare(desired[i], live[i], appendPath(path, fieldpath.PathElement{Index: &index}))
+		}
+		return
+	}
+
+	liveItems := itemsByKey(live)
+	desiredItems := itemsByKey(desired)
+	for _, item := range desired {
+		key := itemKey(item)
+		itemPath := appendPath(path, keyPathElement(key))
+		if liveItem, exists := liveItems[key]; exists {
+			in.compare(item, liveItem, itemPath)
+			continue
+		}
+
+		in.record(itemPath, ChangeTypeRemoved, item, nil)
+	}
+
+	for _, item := range live {
+		if _, exists := desiredItems[itemKey(item)]; !exists {
+			in.recordAdded(appendPath(path, keyPathElement(itemKey(item))), item)
+		}
+	}
+}
+
+// unappliedFields returns fields owned by managers other than server-side apply managers.
+func (in *comparer) unappliedFields() []FieldChange {
+	applied := &fieldpath.Set{}
+	for _, owner := range in.owners {
+		if owner.applied {
+			applied = applied.Union(owner.fields)
+		}
+	}
+
+	for _, owner := range in.owners {
+		if owner.applied {
+			continue
+		}
+
+		owner.fields.Leaves().Iterate(func(path fieldpath.Path) {
+			if applied.Has(path) || isIgnored(path) {
+				return
+			}
+
+			in.changes = append(in.changes, FieldChange{
+				Path:    path.String(),
+				Type:    ChangeTypeChanged,
+				Live:    ownership.ValueAt(in.live.Object, path),
+				Manager: owner.manager,
+			})
+		})
+	}
+
+	return in.changes
+}
+
+func (in *comparer) recordAdded(path fieldpath.Path, live interface{}) {
+	if ignoredPaths[path.String()] {
+		return
+	}
+
+	if manager := in.ownerOf(path); len(manager) > 0 {
+		in.changes = append(in.changes, FieldChange{Path: path.String(), Type: ChangeTypeAdded, Live: live, Manager: manager})
+	}
+}
+
+func (in *comparer) record(path fieldpath.Path, changeType ChangeType, desired, live interface{}) {
+	in.changes = append(in.changes, FieldChange{
+		Path:    path.String(),
+		Type:    changeType,
+		Desired: desired,
+		Live:    live,
+		Manager: in.ownerOf(path),
+	})
+}
+
+// ownerOf returns the first manager owning the field or any of its children.
+func (in *comparer) ownerOf(path fieldpath.Path) string {
+	for _, owner := range in.owners {
+		if ownsAny(owner.fields, path) {
+			return owner.manager
+		}
+	}
+
+	return ""
+}
+
+// isIgnored returns true when the path or any of its parents is ignored.
+func isIgnored(path fieldpath.Path) bool {
+	for i := 1; i <= len(path); i++ {
+		if ignoredPaths[path[:i].String()] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func appendPath(path fieldpath.Path, element fieldpath.PathElement) fieldpath.Path {
+	result := make(fieldpath.Path, 0, len(path)+1)
+	result = append(result, path...)
+	return append(result, element)
+}
+
+func keyPathElement(key string) fieldpath.PathElement {
+	return fieldpath.PathElement{Key: &value.FieldList{{Name: listKeyField, Value: value.NewValueInterface(key)}}}
+}
+
+// isKeyedList returns true when all items are objects with a name.
+func isKeyedList(items []interface{}) bool {
+	for _, item := range items {
+		if len(itemKey(item)) == 0 {
+			return false
+		}
+	}
+
+	return len(items) > 0
+}
+
+func itemKey(item interface{}) string {
+	if itemMap, ok := item.(map[string]interface{}); ok {
+		key, _ := itemMap[listKeyField].(string)
+		return key
+	}
+
+	return ""
+}
+
+func itemsByKey(items []interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(items))
+	for _, item := range items {
+		result[itemKey(item)] = item
+	}
+
+	return result
+}
+
+func sortedKeys(object map[string]interface{}) []string {
+	result := make([]string, 0, len(object))
+	for key := range object {
+		result = append(result, key)
+	}
+
+	sort.Strings(result)
+	return result
+}
+
+// isEmpty returns true for values the API server drops, e.g. empty objects.
+func isEmpty(value interface{}) bool {
+	switch typed := value.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(typed) == 0
+	case []interface{}:
+		return len(typed) == 0
+	}
+
+	return false
+}
+
+// equalScalars compares values. Quantities are compared by value, as the API server stores them
+// in the canonical form, e.g. "1000m" as "1".
+func equalScalars(desired, live interface{}) bool {
+	if reflect.DeepEqual(desired, live) {
+		return true
+	}
+
+	desiredString, ok := desired.(string)
+	if !ok {
+		return false
+	}
+
+	liveString, ok := live.(string)
+	if !ok {
+		return false
+	}
+
+	desiredQuantity, err := resource.ParseQuantity(desiredString)
+	if err != nil {
+		return false
+	}
+
+	liveQuantity, err := resource.ParseQuantity(liveString)
+	if err != nil {
+		return false
+	}
+
+	return desiredQuantity.Cmp(liveQuantity) == 0
+}
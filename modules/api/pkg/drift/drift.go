@@ -0,0 +1,189 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drift
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"sigs.k8s.io/yaml"
+
+	"k8s.io/dashboard/client"
+	"k8s.io/dashboard/errors"
+)
+
+// Baseline is the desired state the live object is compared against.
+type Baseline string
+
+// List of baselines.
+const (
+	// BaselineLastApplied is the configuration stored by "kubectl apply" in the
+	// kubectl.kubernetes.io/last-applied-configuration annotation.
+	BaselineLastApplied Baseline = "lastApplied"
+	// BaselineServerSideApply is the set of fields owned by server-side apply managers. Values
+	// applied by them are not stored, so only fields set by other managers are reported.
+	BaselineServerSideApply Baseline = "serverSideApply"
+	// BaselineManifest is a manifest supplied by the user.
+	BaselineManifest Baseline = "manifest"
+)
+
+// ChangeType describes how a field of the live object differs from the baseline.
+type ChangeType string
+
+// List of change types.
+const (
+	// ChangeTypeAdded means that the field is set only on the live object.
+	ChangeTypeAdded ChangeType = "added"
+	// ChangeTypeRemoved means that the field is set only in the baseline.
+	ChangeTypeRemoved ChangeType = "removed"
+	// ChangeTypeChanged means that the field has a different value on the live object.
+	ChangeTypeChanged ChangeType = "changed"
+)
+
+// FieldChange is a single field of the live object that differs from the baseline.
+type FieldChange struct {
+	// Path of the field in the managed fields format, e.g. ".spec.template.spec.containers[name="web"].image".
+	Path string     `json:"path"`
+	Type ChangeType `json:"type"`
+
+	// Desired is the value from the baseline, Live is the value of the live object.
+	Desired interface{} `json:"desired,omitempty"`
+	Live    interface{} `json:"live,omitempty"`
+
+	// Manager is the field manager owning the live field, when known.
+	Manager string `json:"manager,omitempty"`
+}
+
+// Drift is the difference between a live object and its baseline.
+type Drift struct {
+	APIVersion string        `json:"apiVersion"`
+	Kind       string        `json:"kind"`
+	Namespace  string        `json:"namespace,omitempty"`
+	Name       string        `json:"name"`
+	Baseline   Baseline      `json:"baseline"`
+	Drifted    bool          `json:"drifted"`
+	Changes    []FieldChange `json:"changes"`
+}
+
+// GetDrift compares the live object against its last-applied configuration or, for objects
+// managed by server-side apply, against the fields owned by apply managers.
+func GetDrift(verber client.ResourceVerber, kind, namespace, name string) (*Drift, error) {
+	live, err := getObject(verber, kind, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	result := compareWithBaseline(live)
+	if result == nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("%s %s has neither %s annotation nor server-side apply managers, "+
+			"compare it against a manifest instead", live.GetKind(), name, v1.LastAppliedConfigAnnotation))
+	}
+
+	return result, nil
+}
+
+// GetManifestDrift compares the live object against a YAML or JSON manifest.
+func GetManifestDrift(verber client.ResourceVerber, kind, namespace, name string, manifest []byte) (*Drift, error) {
+	live, err := getObject(verber, kind, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	desired, err := parseManifest(manifest)
+	if err != nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("invalid manifest: %s", err))
+	}
+
+	if desiredKind, _ := desired["kind"].(string); len(desiredKind) > 0 && desiredKind != live.GetKind() {
+		return nil, errors.NewBadRequest(fmt.Sprintf("manifest kind %s does not match %s", desiredKind, live.GetKind()))
+	}
+
+	return compare(live, desired, BaselineManifest), nil
+}
+
+// compareWithBaseline returns nil when the object has no baseline to compare against.
+func compareWithBaseline(live *unstructured.Unstructured) *Drift {
+	if lastApplied, exists := live.GetAnnotations()[v1.LastAppliedConfigAnnotation]; exists {
+		desired, err := parseManifest([]byte(lastApplied))
+		if err == nil {
+			return compare(live, desired, BaselineLastApplied)
+		}
+	}
+
+	if hasApplyManagers(live) {
+		return compareOwnership(live)
+	}
+
+	return nil
+}
+
+func compare(live *unstructured.Unstructured, desired map[string]interface{}, baseline Baseline) *Drift {
+	c := newComparer(live)
+	c.compare(desired, live.Object, nil)
+	return newDrift(live, baseline, c.changes)
+}
+
+func compareOwnership(live *unstructured.Unstructured) *Drift {
+	return newDrift(live, BaselineServerSideApply, newComparer(live).unappliedFields())
+}
+
+func newDrift(live *unstructured.Unstructured, baseline Baseline, changes []FieldChange) *Drift {
+	return &Drift{
+		APIVersion: live.GetAPIVersion(),
+		Kind:       live.GetKind(),
+		Namespace:  live.GetNamespace(),
+		Name:       live.GetName(),
+		Baseline:   baseline,
+		Drifted:    len(changes) > 0,
+		Changes:    changes,
+	}
+}
+
+func getObject(verber client.ResourceVerber, kind, namespace, name string) (*unstructured.Unstructured, error) {
+	object, err := verber.Get(kind, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if result, ok := object.(*unstructured.Unstructured); ok {
+		return result, nil
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unstructured.Unstructured{Object: content}, nil
+}
+
+// parseManifest decodes YAML or JSON. Numbers are decoded as int64 where possible, the same as in
+// live objects.
+func parseManifest(manifest []byte) (map[string]interface{}, error) {
+	data, err := yaml.YAMLToJSON(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]interface{})
+	if err := utiljson.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
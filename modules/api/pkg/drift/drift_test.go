@@ -0,0 +1,280 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drift
+
+import (
+	"reflect"
+	"testing"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func newTestObject(t *testing.T, manifest string) *unstructured.Unstructured {
+	t.Helper()
+
+	// Numbers are decoded as int64, the same as by the API machinery.
+	content, err := parseManifest([]byte(manifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &unstructured.Unstructured{Object: content}
+}
+
+// clientSideApplied is a deployment applied with "kubectl apply" and later edited by hand.
+const clientSideApplied = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: default
+  annotations:
+    kubectl.kubernetes.io/last-applied-configuration: |
+      {"apiVersion":"apps/v1","kind":"Deployment","metadata":{"annotations":{},"labels":{"app":"web"},"name":"web","namespace":"default"},"spec":{"replicas":2,"template":{"spec":{"containers":[{"name":"web","image":"nginx:1.25","resources":{"limits":{"cpu":"1000m"}},"env":[{"name":"MODE","value":"prod"}]}]}}}}
+  labels:
+    app: web
+    team: platform
+  managedFields:
+  - manager: kubectl-client-side-apply
+    operation: Update
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:metadata:
+        f:labels:
+          .: {}
+          f:app: {}
+      f:spec:
+        f:template:
+          f:spec:
+            f:containers:
+              k:{"name":"web"}:
+                .: {}
+                f:name: {}
+                f:resources: {}
+  - manager: kubectl-edit
+    operation: Update
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:metadata:
+        f:labels:
+          f:team: {}
+      f:spec:
+        f:replicas: {}
+        f:template:
+          f:spec:
+            f:containers:
+              k:{"name":"web"}:
+                f:image: {}
+spec:
+  replicas: 3
+  progressDeadlineSeconds: 600
+  template:
+    spec:
+      containers:
+      - name: web
+        image: nginx:1.26
+        imagePullPolicy: IfNotPresent
+        resources:
+          limits:
+            cpu: "1"
+status:
+  replicas: 3
+`
+
+// serverSideApplied is a deployment applied with server-side apply and later edited by hand.
+const serverSideApplied = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: api
+  namespace: default
+  labels:
+    app: api
+    team: platform
+  managedFields:
+  - manager: argocd-controller
+    operation: Apply
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:metadata:
+        f:labels:
+          f:app: {}
+      f:spec:
+        f:replicas: {}
+  - manager: kubectl-label
+    operation: Update
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:metadata:
+        f:labels:
+          f:team: {}
+  - manager: kube-controller-manager
+    operation: Update
+    subresource: status
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:status:
+        f:replicas: {}
+spec:
+  replicas: 2
+status:
+  replicas: 2
+`
+
+func TestCompareWithLastApplied(t *testing.T) {
+	actual := compareWithBaseline(newTestObject(t, clientSideApplied))
+	if actual == nil {
+		t.Fatal("compareWithBaseline() returned nil for applied object")
+	}
+
+	expected := []FieldChange{
+		{Path: ".metadata.labels.team", Type: ChangeTypeAdded, Live: "platform", Manager: "kubectl-edit"},
+		{Path: ".spec.replicas", Type: ChangeTypeChanged, Desired: int64(2), Live: int64(3), Manager: "kubectl-edit"},
+		{
+			Path:    `.spec.template.spec.containers[name="web"].env`,
+			Type:    ChangeTypeRemoved,
+			Desired: []interface{}{map[string]interface{}{"name": "MODE", "value": "prod"}},
+		},
+		{
+			Path:    `.spec.template.spec.containers[name="web"].image`,
+			Type:    ChangeTypeChanged,
+			Desired: "nginx:1.25",
+			Live:    "nginx:1.26",
+			Manager: "kubectl-edit",
+		},
+	}
+
+	if actual.Baseline != BaselineLastApplied || !actual.Drifted {
+		t.Errorf("expected drifted object compared against last-applied configuration, got %+v", actual)
+	}
+
+	if !reflect.DeepEqual(actual.Changes, expected) {
+		t.Errorf("compareWithBaseline() == \n%+v\nexpected \n%+v", actual.Changes, expected)
+	}
+}
+
+func TestCompareWithServerSideApply(t *testing.T) {
+	actual := compareWithBaseline(newTestObject(t, serverSideApplied))
+	if actual == nil {
+		t.Fatal("compareWithBaseline() returned nil for applied object")
+	}
+
+	expected := []FieldChange{
+		{Path: ".metadata.labels.team", Type: ChangeTypeChanged, Live: "platform", Manager: "kubectl-label"},
+	}
+
+	if actual.Baseline != BaselineServerSideApply || !reflect.DeepEqual(actual.Changes, expected) {
+		t.Errorf("compareWithBaseline() == %+v, expected changes %+v", actual, expected)
+	}
+}
+
+func TestCompareWithManifest(t *testing.T) {
+	live := newTestObject(t, serverSideApplied)
+	desired, err := parseManifest([]byte(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: api
+  labels:
+    app: api
+    team: platform
+spec:
+  replicas: 2
+  template: {}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if actual := compare(live, desired, BaselineManifest); actual.Drifted {
+		t.Errorf("expected no drift, got %+v", actual.Changes)
+	}
+
+	if compareWithBaseline(newTestObject(t, "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: manual")) != nil {
+		t.Error("compareWithBaseline() should return nil for objects without baseline")
+	}
+}
+
+func TestEqualScalars(t *testing.T) {
+	cases := []struct {
+		desired, live interface{}
+		expected      bool
+	}{
+		{"1000m", "1", true},
+		{"512Mi", "536870912", true},
+		{"nginx", "nginx", true},
+		{"nginx", "httpd", false},
+		{int64(80), int64(80), true},
+		{int64(80), "80", false},
+	}
+
+	for _, c := range cases {
+		if actual := equalScalars(c.desired, c.live); actual != c.expected {
+			t.Errorf("equalScalars(%v, %v) == %v, expected %v", c.desired, c.live, actual, c.expected)
+		}
+	}
+}
+
+type fakeResourceLister struct{}
+
+func (fakeResourceLister) ServerPreferredNamespacedResources() ([]*metaV1.APIResourceList, error) {
+	return []*metaV1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metaV1.APIResource{
+				{Name: "configmaps", Namespaced: true, Kind: "ConfigMap", Verbs: metaV1.Verbs{"get", "list"}},
+			},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metaV1.APIResource{
+				{Name: "deployments", Namespaced: true, Kind: "Deployment", Verbs: metaV1.Verbs{"get", "list"}},
+			},
+		},
+	}, nil
+}
+
+func TestGetNamespaceReport(t *testing.T) {
+	inSync := newTestObject(t, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+  namespace: default
+  annotations:
+    kubectl.kubernetes.io/last-applied-configuration: '{"apiVersion":"v1","kind":"ConfigMap","data":{"mode":"prod"},"metadata":{"name":"settings","namespace":"default"}}'
+data:
+  mode: prod
+`)
+	manual := newTestObject(t, "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: manual\n  namespace: default")
+
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		{Version: "v1", Resource: "configmaps"}:                 "ConfigMapList",
+		{Group: "apps", Version: "v1", Resource: "deployments"}: "DeploymentList",
+	}, inSync, manual, newTestObject(t, clientSideApplied), newTestObject(t, serverSideApplied))
+
+	report, err := getNamespaceReport(fakeResourceLister{}, client, "default")
+	if err != nil {
+		t.Fatalf("getNamespaceReport() returned error: %v", err)
+	}
+
+	if report.Checked != 3 || len(report.Drifted) != 2 {
+		t.Errorf("expected 3 checked and 2 drifted objects, got %+v", report)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drift
+
+import (
+	"bytes"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+)
+
+// fieldOwner is a set of fields of the main resource owned by a single field manager.
+type fieldOwner struct {
+	manager string
+	applied bool
+	fields  *fieldpath.Set
+}
+
+// fieldOwners parses managed fields of the object. Fields managed through subresources, e.g.
+// status or scale, are skipped.
+func fieldOwners(object *unstructured.Unstructured) []fieldOwner {
+	result := make([]fieldOwner, 0)
+	for _, entry := range object.GetManagedFields() {
+		if len(entry.Subresource) > 0 || entry.FieldsV1 == nil {
+			continue
+		}
+
+		fields := &fieldpath.Set{}
+		if err := fields.FromJSON(bytes.NewReader(entry.FieldsV1.Raw)); err != nil {
+			klog.V(3).InfoS("could not parse managed fields", "manager", entry.Manager, "error", err)
+			continue
+		}
+
+		result = append(result, fieldOwner{
+			manager: entry.Manager,
+			applied: entry.Operation == metaV1.ManagedFieldsOperationApply,
+			fields:  fields,
+		})
+	}
+
+	return result
+}
+
+func hasApplyManagers(object *unstructured.Unstructured) bool {
+	for _, owner := range fieldOwners(object) {
+		if owner.applied {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ownsAny returns true when the set contains the path or any of its children.
+func ownsAny(set *fieldpath.Set, path fieldpath.Path) bool {
+	for i, element := range path {
+		if i == len(path)-1 && set.Members.Has(element) {
+			return true
+		}
+
+		child, exists := set.Children.Get(element)
+		if !exists {
+			return false
+		}
+
+		set = child
+	}
+
+	return !set.Empty()
+}
@@ -0,0 +1,86 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drift
+
+import (
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+)
+
+// NamespaceReport lists drifted objects of a namespace.
+type NamespaceReport struct {
+	Namespace string `json:"namespace"`
+
+	// Checked is the number of objects with a baseline, i.e. applied objects.
+	Checked int     `json:"checked"`
+	Drifted []Drift `json:"drifted"`
+
+	// Errors list resources that could not be checked, e.g. because of missing permissions.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// GetNamespaceReport checks all applied objects of the namespace the user can read. Objects
+// owned by controllers are skipped.
+func GetNamespaceReport(cfg *rest.Config, namespace string) (*NamespaceReport, error) {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return getNamespaceReport(discoveryClient, dynamicClient, namespace)
+}
+
+func getNamespaceReport(lister common.NamespacedResourceLister, client dynamic.Interface, namespace string) (*NamespaceReport, error) {
+	report := &NamespaceReport{Namespace: namespace, Drifted: make([]Drift, 0)}
+
+	listable := func(_ schema.GroupVersionResource, resource metaV1.APIResource) bool {
+		return sets.New(resource.Verbs...).Has("list")
+	}
+
+	errs, err := common.ListNamespacedObjects(lister, client, namespace, listable,
+		func(_ schema.GroupVersionResource, object *unstructured.Unstructured) {
+			if metaV1.GetControllerOfNoCopy(object) != nil {
+				return
+			}
+
+			result := compareWithBaseline(object)
+			if result == nil {
+				return
+			}
+
+			report.Checked++
+			if result.Drifted {
+				report.Drifted = append(report.Drifted, *result)
+			}
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	report.Errors = errs
+	return report, nil
+}
@@ -0,0 +1,346 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	dashboarderrors "k8s.io/dashboard/errors"
+)
+
+const (
+	// BundleContentType is the content type of namespace bundle archives.
+	BundleContentType = "application/gzip"
+
+	// bundleVersion is the version of the bundle layout.
+	bundleVersion = 1
+
+	// bundleIndexFile is the name of the archive file describing the bundle.
+	bundleIndexFile = "bundle.yaml"
+
+	// bundleResourcesDir is the archive directory containing manifests.
+	bundleResourcesDir = "resources"
+
+	// MaxBundleSize limits the size of an uploaded archive, both compressed and decompressed.
+	MaxBundleSize = 64 << 20
+
+	// maxBundleFileSize limits the size of a single file read from an uploaded archive.
+	maxBundleFileSize = 10 << 20
+
+	// maxBundleEntries limits the number of entries of an uploaded archive.
+	maxBundleEntries = 10000
+)
+
+// errBundleTooLarge is returned when more than MaxBundleSize bytes are decompressed.
+var errBundleTooLarge = fmt.Errorf("bundle archive exceeds %d bytes", MaxBundleSize)
+
+// installOrder is the order in which kinds are created on import, so that objects referenced by
+// others, e.g. config maps mounted by deployments, exist first. Other kinds are created last.
+var installOrder = []string{
+	"NetworkPolicy",
+	"ResourceQuota",
+	"LimitRange",
+	"PodDisruptionBudget",
+	"ServiceAccount",
+	"Secret",
+	"ConfigMap",
+	"PersistentVolumeClaim",
+	"Role",
+	"RoleBinding",
+	"Service",
+	"DaemonSet",
+	"Pod",
+	"ReplicationController",
+	"ReplicaSet",
+	"Deployment",
+	"HorizontalPodAutoscaler",
+	"StatefulSet",
+	"Job",
+	"CronJob",
+	"Ingress",
+}
+
+// BundleIndex describes the content of a namespace bundle. It is stored in the archive next to
+// the manifests.
+type BundleIndex struct {
+	Version         int          `json:"version"`
+	SourceNamespace string       `json:"sourceNamespace"`
+	CreatedAt       string       `json:"createdAt"`
+	Items           []BundleItem `json:"items"`
+
+	// Errors list resources that could not be exported, e.g. because of missing permissions.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// BundleItem is a single manifest in the bundle.
+type BundleItem struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	File       string `json:"file"`
+}
+
+// bundle is the in-memory representation of a namespace bundle archive.
+type bundle struct {
+	index   *BundleIndex
+	objects []*unstructured.Unstructured
+}
+
+func newBundle(namespace string) *bundle {
+	return &bundle{
+		index: &BundleIndex{
+			Version:         bundleVersion,
+			SourceNamespace: namespace,
+			CreatedAt:       time.Now().UTC().Format(time.RFC3339),
+			Items:           make([]BundleItem, 0),
+		},
+		objects: make([]*unstructured.Unstructured, 0),
+	}
+}
+
+// add adds the object to the bundle. File is derived from the resource name, e.g.
+// "resources/deployments.apps/web.yaml".
+func (in *bundle) add(resource string, object *unstructured.Unstructured) {
+	in.objects = append(in.objects, object)
+	in.index.Items = append(in.index.Items, BundleItem{
+		APIVersion: object.GetAPIVersion(),
+		Kind:       object.GetKind(),
+		Name:       object.GetName(),
+		File:       path.Join(bundleResourcesDir, resource, object.GetName()+".yaml"),
+	})
+}
+
+// sort orders objects in the install order.
+func (in *bundle) sort() {
+	indexes := make([]int, len(in.objects))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return installPriority(in.objects[indexes[i]].GetKind()) < installPriority(in.objects[indexes[j]].GetKind())
+	})
+
+	objects := make([]*unstructured.Unstructured, 0, len(in.objects))
+	items := make([]BundleItem, 0, len(in.index.Items))
+	for _, i := range indexes {
+		objects = append(objects, in.objects[i])
+		items = append(items, in.index.Items[i])
+	}
+
+	in.objects = objects
+	in.index.Items = items
+}
+
+func installPriority(kind string) int {
+	for i, candidate := range installOrder {
+		if candidate == kind {
+			return i
+		}
+	}
+
+	return len(installOrder)
+}
+
+// write writes the bundle as a gzip compressed tar archive.
+func (in *bundle) write() ([]byte, error) {
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	index, err := yaml.Marshal(in.index)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writeArchiveFile(tarWriter, bundleIndexFile, index); err != nil {
+		return nil, err
+	}
+
+	for i, object := range in.objects {
+		data, err := yaml.Marshal(object.Object)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := writeArchiveFile(tarWriter, in.index.Items[i].File, data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := gzipWriter.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeArchiveFile(writer *tar.Writer, name string, data []byte) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	}
+
+	if err := writer.WriteHeader(header); err != nil {
+		return err
+	}
+
+	_, err := writer.Write(data)
+	return err
+}
+
+// budgetReader fails with errBundleTooLarge when reading more than the remaining bytes, so that
+// archives are not decompressed without bound.
+type budgetReader struct {
+	reader    io.Reader
+	remaining int64
+}
+
+func (in *budgetReader) Read(p []byte) (int, error) {
+	if in.remaining <= 0 {
+		return 0, errBundleTooLarge
+	}
+
+	if int64(len(p)) > in.remaining {
+		p = p[:in.remaining]
+	}
+
+	n, err := in.reader.Read(p)
+	in.remaining -= int64(n)
+	return n, err
+}
+
+// readBundle reads a bundle archive. Manifests are returned in the order of the bundle index.
+// Files written after the index which it does not reference are skipped.
+func readBundle(reader io.Reader) (*bundle, error) {
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return nil, invalidBundleError(err)
+	}
+	defer gzipReader.Close()
+
+	var index *BundleIndex
+	var referenced map[string]bool
+	files := make(map[string][]byte)
+	tarReader := tar.NewReader(&budgetReader{reader: gzipReader, remaining: MaxBundleSize})
+	for entries := 0; ; entries++ {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, invalidBundleError(err)
+		}
+
+		if entries == maxBundleEntries {
+			return nil, dashboarderrors.NewBadRequest(fmt.Sprintf("bundle archive has more than %d entries", maxBundleEntries))
+		}
+
+		name := path.Clean(header.Name)
+		if header.Typeflag != tar.TypeReg || (referenced != nil && !referenced[name]) {
+			continue
+		}
+
+		if header.Size > maxBundleFileSize {
+			return nil, dashboarderrors.NewBadRequest(fmt.Sprintf("file %s in bundle archive is too large", header.Name))
+		}
+
+		data, err := io.ReadAll(io.LimitReader(tarReader, maxBundleFileSize))
+		if err != nil {
+			return nil, invalidBundleError(err)
+		}
+
+		if name != bundleIndexFile {
+			files[name] = data
+			continue
+		}
+
+		if index, err = readBundleIndex(data); err != nil {
+			return nil, err
+		}
+
+		referenced = make(map[string]bool, len(index.Items))
+		for _, item := range index.Items {
+			referenced[path.Clean(item.File)] = true
+		}
+
+		// Drop files read before the index, which it does not reference.
+		for file := range files {
+			if !referenced[file] {
+				delete(files, file)
+			}
+		}
+	}
+
+	if index == nil {
+		return nil, dashboarderrors.NewBadRequest(fmt.Sprintf("bundle archive does not contain %s", bundleIndexFile))
+	}
+
+	result := &bundle{index: index, objects: make([]*unstructured.Unstructured, 0, len(index.Items))}
+	for _, item := range index.Items {
+		data, exists := files[path.Clean(item.File)]
+		if !exists {
+			return nil, dashboarderrors.NewBadRequest(fmt.Sprintf("bundle archive does not contain %s", item.File))
+		}
+
+		object := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal(data, &object.Object); err != nil {
+			return nil, dashboarderrors.NewBadRequest(fmt.Sprintf("invalid manifest %s: %s", item.File, err))
+		}
+
+		result.objects = append(result.objects, object)
+	}
+
+	return result, nil
+}
+
+func readBundleIndex(data []byte) (*BundleIndex, error) {
+	index := new(BundleIndex)
+	if err := yaml.Unmarshal(data, index); err != nil {
+		return nil, dashboarderrors.NewBadRequest(fmt.Sprintf("invalid %s: %s", bundleIndexFile, err))
+	}
+
+	if index.Version != bundleVersion {
+		return nil, dashboarderrors.NewBadRequest(fmt.Sprintf("unsupported bundle version %d", index.Version))
+	}
+
+	return index, nil
+}
+
+func invalidBundleError(err error) error {
+	if errors.Is(err, errBundleTooLarge) {
+		return dashboarderrors.NewBadRequest(err.Error())
+	}
+
+	return dashboarderrors.NewBadRequest(fmt.Sprintf("invalid bundle archive: %s", err))
+}
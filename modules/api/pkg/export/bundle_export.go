@@ -0,0 +1,123 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+)
+
+// excludedGroups lists API groups whose resources are never exported.
+var excludedGroups = sets.New("metrics.k8s.io")
+
+// bundleVerbs are verbs a resource has to support to be exported and imported.
+var bundleVerbs = []string{"list", "create"}
+
+// defaultServiceAccountName is the name of the service account created in every namespace.
+const defaultServiceAccountName = "default"
+
+// rootCAConfigMapName is the name of the config map published in every namespace.
+const rootCAConfigMapName = "kube-root-ca.crt"
+
+// BundleExportOptions configures namespace bundle export.
+type BundleExportOptions struct {
+	// IncludeSecrets adds secrets to the bundle. Service account tokens are never exported.
+	IncludeSecrets bool `json:"includeSecrets"`
+
+	// StripDefaults removes fields with values equal to defaults declared in the OpenAPI schema.
+	StripDefaults bool `json:"stripDefaults"`
+}
+
+// ExportNamespaceBundle collects all namespaced objects the user can read into a gzip compressed
+// tar archive of cleaned manifests. Objects owned by controllers are skipped, as they are
+// recreated by their owners. Resources that cannot be read are listed in the bundle index.
+func ExportNamespaceBundle(cfg *rest.Config, namespace string, opts BundleExportOptions) ([]byte, error) {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var schemas schemaResolver
+	if opts.StripDefaults {
+		schemas = newOpenAPIV3Resolver(discoveryClient.OpenAPIV3())
+	}
+
+	return exportNamespaceBundle(discoveryClient, dynamicClient, schemas, namespace, opts)
+}
+
+func exportNamespaceBundle(lister common.NamespacedResourceLister, client dynamic.Interface, schemas schemaResolver,
+	namespace string, opts BundleExportOptions) ([]byte, error) {
+	b := newBundle(namespace)
+	exporter := &exporter{schemas: schemas}
+
+	errs, err := common.ListNamespacedObjects(lister, client, namespace, isBundleResource,
+		func(gvr schema.GroupVersionResource, object *unstructured.Unstructured) {
+			if !shouldExport(gvr.GroupResource(), object, opts) {
+				return
+			}
+
+			cleanObject(object)
+			exporter.stripDefaults(object)
+			b.add(gvr.GroupResource().String(), object)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	b.index.Errors = errs
+	b.sort()
+	return b.write()
+}
+
+func isBundleResource(gvr schema.GroupVersionResource, resource metaV1.APIResource) bool {
+	if excludedGroups.Has(gvr.Group) {
+		return false
+	}
+
+	verbs := sets.New(resource.Verbs...)
+	return verbs.HasAll(bundleVerbs...)
+}
+
+// shouldExport skips objects managed by controllers or created automatically in every namespace.
+func shouldExport(gr schema.GroupResource, object *unstructured.Unstructured, opts BundleExportOptions) bool {
+	if metaV1.GetControllerOfNoCopy(object) != nil {
+		return false
+	}
+
+	switch gr.String() {
+	case "secrets":
+		secretType, _, _ := unstructured.NestedString(object.Object, "type")
+		return opts.IncludeSecrets && secretType != string(v1.SecretTypeServiceAccountToken)
+	case "serviceaccounts":
+		return object.GetName() != defaultServiceAccountName
+	case "configmaps":
+		return object.GetName() != rootCAConfigMapName
+	}
+
+	return true
+}
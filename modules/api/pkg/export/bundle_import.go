@@ -0,0 +1,279 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/errors"
+)
+
+// namespaceResource is the resource of namespaces, created on import when requested.
+var namespaceResource = schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+
+// BundleImportOptions configures namespace bundle import.
+type BundleImportOptions struct {
+	// Prefix and Suffix are added to names of all imported objects. References between bundle
+	// objects are updated accordingly.
+	Prefix string `json:"prefix"`
+	Suffix string `json:"suffix"`
+
+	// CreateNamespace creates the target namespace when it does not exist.
+	CreateNamespace bool `json:"createNamespace"`
+
+	// DryRun validates objects on the server without persisting them.
+	DryRun bool `json:"dryRun"`
+}
+
+// ImportStatus is the outcome of the import of a single object.
+type ImportStatus string
+
+// List of import statuses.
+const (
+	// ImportStatusCreated means that the object was created.
+	ImportStatusCreated ImportStatus = "created"
+	// ImportStatusDryRun means that the object would be created.
+	ImportStatusDryRun ImportStatus = "dryRun"
+	// ImportStatusSkipped means that an object with the same name already exists.
+	ImportStatusSkipped ImportStatus = "skipped"
+	// ImportStatusFailed means that the object could not be created, see the item error for details.
+	ImportStatusFailed ImportStatus = "failed"
+)
+
+// ImportItemResult is the outcome of the import of a single object.
+type ImportItemResult struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+
+	// SourceName is the name of the object in the bundle, Name is the name after import.
+	SourceName string `json:"sourceName"`
+	Name       string `json:"name"`
+
+	Status ImportStatus `json:"status"`
+	Error  string       `json:"error,omitempty"`
+}
+
+// ImportReport is the outcome of the import of a namespace bundle.
+type ImportReport struct {
+	SourceNamespace  string             `json:"sourceNamespace"`
+	Namespace        string             `json:"namespace"`
+	NamespaceCreated bool               `json:"namespaceCreated"`
+	DryRun           bool               `json:"dryRun"`
+	Created          int                `json:"created"`
+	Skipped          int                `json:"skipped"`
+	Failed           int                `json:"failed"`
+	Items            []ImportItemResult `json:"items"`
+}
+
+// ImportNamespaceBundle recreates objects of a bundle archive in the target namespace. Existing
+// objects are not modified. Failure of a single object does not stop the import.
+func ImportNamespaceBundle(cfg *rest.Config, namespace string, archive io.Reader,
+	opts BundleImportOptions) (*ImportReport, error) {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+	return importNamespaceBundle(dynamicClient, mapper, namespace, archive, opts)
+}
+
+func importNamespaceBundle(client dynamic.Interface, mapper meta.RESTMapper, namespace string, archive io.Reader,
+	opts BundleImportOptions) (*ImportReport, error) {
+	if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+		return nil, errors.NewBadRequest(fmt.Sprintf("invalid namespace %q: %s", namespace, strings.Join(errs, ", ")))
+	}
+
+	b, err := readBundle(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ImportReport{
+		SourceNamespace: b.index.SourceNamespace,
+		Namespace:       namespace,
+		DryRun:          opts.DryRun,
+		Items:           make([]ImportItemResult, 0, len(b.objects)),
+	}
+
+	namespaceExists, err := ensureNamespace(client, namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+	report.NamespaceCreated = !namespaceExists && !opts.DryRun
+
+	klog.V(2).InfoS("importing namespace bundle", "source", b.index.SourceNamespace, "namespace", namespace,
+		"objects", len(b.objects), "dryRun", opts.DryRun)
+
+	importer := &importer{
+		client:          client,
+		mapper:          mapper,
+		renamer:         newRenamer(b.index.SourceNamespace, namespace, opts.Prefix, opts.Suffix, b.objects),
+		dryRun:          opts.DryRun,
+		namespaceExists: namespaceExists,
+	}
+
+	for _, object := range b.objects {
+		result := importer.importObject(object)
+		switch result.Status {
+		case ImportStatusCreated, ImportStatusDryRun:
+			report.Created++
+		case ImportStatusSkipped:
+			report.Skipped++
+		case ImportStatusFailed:
+			report.Failed++
+		}
+
+		report.Items = append(report.Items, result)
+	}
+
+	return report, nil
+}
+
+// ensureNamespace returns whether the namespace exists and creates it when requested. Missing
+// namespace is an error otherwise.
+func ensureNamespace(client dynamic.Interface, namespace string, opts BundleImportOptions) (bool, error) {
+	_, err := client.Resource(namespaceResource).Get(context.TODO(), namespace, metaV1.GetOptions{})
+	if err == nil {
+		return true, nil
+	}
+
+	if !k8serrors.IsNotFound(err) {
+		return false, err
+	}
+
+	if !opts.CreateNamespace {
+		return false, errors.NewBadRequest(fmt.Sprintf("namespace %s does not exist", namespace))
+	}
+
+	if opts.DryRun {
+		return false, nil
+	}
+
+	object := &unstructured.Unstructured{}
+	object.SetAPIVersion("v1")
+	object.SetKind("Namespace")
+	object.SetName(namespace)
+	_, err = client.Resource(namespaceResource).Create(context.TODO(), object, metaV1.CreateOptions{})
+	return false, err
+}
+
+type importer struct {
+	client  dynamic.Interface
+	mapper  meta.RESTMapper
+	renamer *renamer
+	dryRun  bool
+
+	// namespaceExists is false on dry run into a namespace that would be created. Objects can
+	// not be validated by the server then.
+	namespaceExists bool
+}
+
+func (in *importer) importObject(object *unstructured.Unstructured) ImportItemResult {
+	result := ImportItemResult{
+		APIVersion: object.GetAPIVersion(),
+		Kind:       object.GetKind(),
+		SourceName: object.GetName(),
+		Name:       in.renamer.newName(object.GetName()),
+	}
+
+	if err := in.create(object); err != nil {
+		if k8serrors.IsAlreadyExists(err) {
+			result.Status = ImportStatusSkipped
+			result.Error = err.Error()
+			return result
+		}
+
+		result.Status = ImportStatusFailed
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Status = ImportStatusCreated
+	if in.dryRun {
+		result.Status = ImportStatusDryRun
+	}
+
+	return result
+}
+
+func (in *importer) create(object *unstructured.Unstructured) error {
+	gvk := object.GroupVersionKind()
+	mapping, err := in.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return err
+	}
+
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return fmt.Errorf("%s is not namespaced", gvk.Kind)
+	}
+
+	// Objects are cleaned again, bundles can be written by hand or by older versions.
+	object = object.DeepCopy()
+	cleanObject(object)
+	in.renamer.rewrite(object)
+	if err := validateName(gvk.Kind, object.GetName()); err != nil {
+		return err
+	}
+
+	resource := in.client.Resource(mapping.Resource).Namespace(object.GetNamespace())
+	if in.dryRun && !in.namespaceExists {
+		return nil
+	}
+
+	opts := metaV1.CreateOptions{}
+	if in.dryRun {
+		opts.DryRun = []string{metaV1.DryRunAll}
+	}
+
+	_, err = resource.Create(context.TODO(), object, opts)
+	return err
+}
+
+// validateName checks the name after prefix and suffix are added. Services require DNS labels,
+// other kinds DNS subdomains.
+func validateName(kind, name string) error {
+	errs := validation.IsDNS1123Subdomain(name)
+	if kind == "Service" {
+		errs = validation.IsDNS1035Label(name)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid name %q: %s", name, strings.Join(errs, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,549 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+var (
+	deploymentGVR     = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	configMapGVR      = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	secretGVR         = schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+	serviceAccountGVR = schema.GroupVersionResource{Version: "v1", Resource: "serviceaccounts"}
+	podGVR            = schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	eventGVR          = schema.GroupVersionResource{Version: "v1", Resource: "events"}
+	roleBindingGVR    = schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings"}
+	serviceGVR        = schema.GroupVersionResource{Version: "v1", Resource: "services"}
+	claimGVR          = schema.GroupVersionResource{Version: "v1", Resource: "persistentvolumeclaims"}
+)
+
+var bundleVerbsAll = metaV1.Verbs{"create", "delete", "get", "list", "patch", "update", "watch"}
+
+type fakeResourceLister struct {
+	lists []*metaV1.APIResourceList
+}
+
+func (in *fakeResourceLister) ServerPreferredNamespacedResources() ([]*metaV1.APIResourceList, error) {
+	return in.lists, nil
+}
+
+func newFakeResourceLister() *fakeResourceLister {
+	return &fakeResourceLister{lists: []*metaV1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metaV1.APIResource{
+				{Name: "configmaps", Namespaced: true, Kind: "ConfigMap", Verbs: bundleVerbsAll},
+				{Name: "secrets", Namespaced: true, Kind: "Secret", Verbs: bundleVerbsAll},
+				{Name: "serviceaccounts", Namespaced: true, Kind: "ServiceAccount", Verbs: bundleVerbsAll},
+				{Name: "pods", Namespaced: true, Kind: "Pod", Verbs: bundleVerbsAll},
+				{Name: "events", Namespaced: true, Kind: "Event", Verbs: bundleVerbsAll},
+				{Name: "services", Namespaced: true, Kind: "Service", Verbs: bundleVerbsAll},
+				{Name: "persistentvolumeclaims", Namespaced: true, Kind: "PersistentVolumeClaim", Verbs: bundleVerbsAll},
+			},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metaV1.APIResource{
+				{Name: "deployments", Namespaced: true, Kind: "Deployment", Verbs: bundleVerbsAll},
+			},
+		},
+		{
+			GroupVersion: "rbac.authorization.k8s.io/v1",
+			APIResources: []metaV1.APIResource{
+				{Name: "rolebindings", Namespaced: true, Kind: "RoleBinding", Verbs: bundleVerbsAll},
+			},
+		},
+	}}
+}
+
+func newFakeBundleClient(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		deploymentGVR:     "DeploymentList",
+		configMapGVR:      "ConfigMapList",
+		secretGVR:         "SecretList",
+		serviceAccountGVR: "ServiceAccountList",
+		podGVR:            "PodList",
+		eventGVR:          "EventList",
+		roleBindingGVR:    "RoleBindingList",
+		serviceGVR:        "ServiceList",
+		claimGVR:          "PersistentVolumeClaimList",
+		namespaceResource: "NamespaceList",
+	}, objects...)
+}
+
+func newFakeBundleMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	for _, gvk := range []schema.GroupVersionKind{
+		{Version: "v1", Kind: "ConfigMap"},
+		{Version: "v1", Kind: "Secret"},
+		{Version: "v1", Kind: "ServiceAccount"},
+		{Version: "v1", Kind: "Pod"},
+		{Version: "v1", Kind: "Service"},
+		{Version: "v1", Kind: "PersistentVolumeClaim"},
+		{Group: "apps", Version: "v1", Kind: "Deployment"},
+		{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "RoleBinding"},
+	} {
+		mapper.Add(gvk, meta.RESTScopeNamespace)
+	}
+
+	mapper.Add(schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"}, meta.RESTScopeRoot)
+	return mapper
+}
+
+func newTestNamespace(t *testing.T, name string) *unstructured.Unstructured {
+	namespace := newTestObject(t, "apiVersion: v1\nkind: Namespace")
+	namespace.SetName(name)
+	return namespace
+}
+
+func newSourceObjects(t *testing.T) []runtime.Object {
+	return []runtime.Object{
+		newTestNamespace(t, "source"),
+		newTestObject(t, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: source
+  uid: 0b6c4d2e
+spec:
+  template:
+    spec:
+      serviceAccountName: web
+      volumes:
+      - name: config
+        configMap:
+          name: web-config
+      - name: shared
+        configMap:
+          name: shared-config
+      containers:
+      - name: web
+        envFrom:
+        - secretRef:
+            name: web-secret
+status:
+  replicas: 1
+`),
+		newTestObject(t, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web-5d8f
+  namespace: source
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: ReplicaSet
+    name: web-5d8f
+    uid: 1c2d3e4f
+    controller: true
+`),
+		newTestObject(t, "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: web-config\n  namespace: source"),
+		newTestObject(t, "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: kube-root-ca.crt\n  namespace: source"),
+		newTestObject(t, "apiVersion: v1\nkind: Secret\nmetadata:\n  name: web-secret\n  namespace: source\ntype: Opaque"),
+		newTestObject(t, "apiVersion: v1\nkind: Secret\nmetadata:\n  name: web-token\n  namespace: source\ntype: kubernetes.io/service-account-token"),
+		newTestObject(t, "apiVersion: v1\nkind: ServiceAccount\nmetadata:\n  name: web\n  namespace: source"),
+		newTestObject(t, "apiVersion: v1\nkind: ServiceAccount\nmetadata:\n  name: default\n  namespace: source"),
+		newTestObject(t, "apiVersion: v1\nkind: Event\nmetadata:\n  name: web.17a\n  namespace: source"),
+		newTestObject(t, `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  name: web-view
+  namespace: source
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: view
+subjects:
+- kind: ServiceAccount
+  name: web
+  namespace: source
+- kind: ServiceAccount
+  name: monitoring
+  namespace: monitoring
+`),
+	}
+}
+
+func exportTestBundle(t *testing.T, opts BundleExportOptions) []byte {
+	t.Helper()
+
+	archive, err := exportNamespaceBundle(newFakeResourceLister(), newFakeBundleClient(newSourceObjects(t)...), nil,
+		"source", opts)
+	if err != nil {
+		t.Fatalf("exportNamespaceBundle() returned error: %v", err)
+	}
+
+	return archive
+}
+
+func bundleNames(b *bundle) []string {
+	result := make([]string, 0, len(b.index.Items))
+	for _, item := range b.index.Items {
+		result = append(result, item.Kind+"/"+item.Name)
+	}
+
+	return result
+}
+
+func TestExportNamespaceBundle(t *testing.T) {
+	cases := []struct {
+		opts     BundleExportOptions
+		expected []string
+	}{
+		{
+			BundleExportOptions{},
+			[]string{"ServiceAccount/web", "ConfigMap/web-config", "RoleBinding/web-view", "Deployment/web"},
+		},
+		{
+			BundleExportOptions{IncludeSecrets: true},
+			[]string{"ServiceAccount/web", "Secret/web-secret", "ConfigMap/web-config", "RoleBinding/web-view",
+				"Deployment/web"},
+		},
+	}
+
+	for _, c := range cases {
+		b, err := readBundle(bytes.NewReader(exportTestBundle(t, c.opts)))
+		if err != nil {
+			t.Fatalf("readBundle() returned error: %v", err)
+		}
+
+		// Objects are ordered by install order, not by discovery.
+		if actual := bundleNames(b); !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("exportNamespaceBundle(%+v) exported %v, expected %v", c.opts, actual, c.expected)
+		}
+
+		if b.index.SourceNamespace != "source" {
+			t.Errorf("expected source namespace %q, got %q", "source", b.index.SourceNamespace)
+		}
+
+		for _, object := range b.objects {
+			if len(object.GetUID()) > 0 || object.Object["status"] != nil {
+				t.Errorf("expected cleaned manifest, got %v", object.Object)
+			}
+		}
+	}
+}
+
+// newTestArchive writes a bundle archive with an index referencing a single config map, followed by
+// unreferenced files of given sizes.
+func newTestArchive(t *testing.T, extraFiles int, extraFileSize int) []byte {
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	index := []byte("version: 1\nitems:\n- apiVersion: v1\n  kind: ConfigMap\n  name: web\n  file: resources/configmaps/web.yaml\n")
+	manifest := []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: web\n")
+	if err := writeArchiveFile(tarWriter, bundleIndexFile, index); err != nil {
+		t.Fatalf("writeArchiveFile() returned error: %v", err)
+	}
+
+	if err := writeArchiveFile(tarWriter, "resources/configmaps/web.yaml", manifest); err != nil {
+		t.Fatalf("writeArchiveFile() returned error: %v", err)
+	}
+
+	extra := make([]byte, extraFileSize)
+	for i := 0; i < extraFiles; i++ {
+		if err := writeArchiveFile(tarWriter, fmt.Sprintf("extra/%d", i), extra); err != nil {
+			t.Fatalf("writeArchiveFile() returned error: %v", err)
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		t.Fatalf("failed to close archive: %v", err)
+	}
+
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatalf("failed to close archive: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestReadBundleLimits(t *testing.T) {
+	cases := []struct {
+		info          string
+		extraFiles    int
+		extraFileSize int
+		expectedError bool
+	}{
+		{"unreferenced files are skipped", 1, maxBundleFileSize + 1, false},
+		{"too many entries", maxBundleEntries, 0, true},
+		{"too large decompressed archive", MaxBundleSize/maxBundleFileSize + 1, maxBundleFileSize, true},
+	}
+
+	for _, c := range cases {
+		b, err := readBundle(bytes.NewReader(newTestArchive(t, c.extraFiles, c.extraFileSize)))
+		if !c.expectedError {
+			if err != nil || len(b.objects) != 1 {
+				t.Errorf("%s: readBundle() == %v, %v, expected single object", c.info, b, err)
+			}
+			continue
+		}
+
+		if !k8serrors.IsBadRequest(err) {
+			t.Errorf("%s: readBundle() should return bad request error, got %v", c.info, err)
+		}
+	}
+}
+
+func TestImportNamespaceBundle(t *testing.T) {
+	archive := exportTestBundle(t, BundleExportOptions{IncludeSecrets: true})
+	existing := newTestObject(t, "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: copy-web-config\n  namespace: target")
+	client := newFakeBundleClient(newTestNamespace(t, "target"), existing)
+
+	report, err := importNamespaceBundle(client, newFakeBundleMapper(), "target", bytes.NewReader(archive),
+		BundleImportOptions{Prefix: "copy-"})
+	if err != nil {
+		t.Fatalf("importNamespaceBundle() returned error: %v", err)
+	}
+
+	if report.Created != 4 || report.Skipped != 1 || report.Failed != 0 {
+		t.Errorf("expected 4 created, 1 skipped and 0 failed objects, got %+v", report)
+	}
+
+	deployment, err := client.Resource(deploymentGVR).Namespace("target").Get(context.TODO(), "copy-web", metaV1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected imported deployment, got error: %v", err)
+	}
+
+	podSpec, _, _ := unstructured.NestedMap(deployment.Object, "spec", "template", "spec")
+	expectedPodSpec := newTestObject(t, `
+serviceAccountName: copy-web
+volumes:
+- name: config
+  configMap:
+    name: copy-web-config
+- name: shared
+  configMap:
+    name: shared-config
+containers:
+- name: web
+  envFrom:
+  - secretRef:
+      name: copy-web-secret
+`).Object
+	if !reflect.DeepEqual(podSpec, expectedPodSpec) {
+		t.Errorf("expected references to be renamed, got %v", podSpec)
+	}
+
+	binding, err := client.Resource(roleBindingGVR).Namespace("target").Get(context.TODO(), "copy-web-view", metaV1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected imported role binding, got error: %v", err)
+	}
+
+	subjects, _, _ := unstructured.NestedSlice(binding.Object, "subjects")
+	expectedSubjects := []interface{}{
+		map[string]interface{}{"kind": "ServiceAccount", "name": "copy-web", "namespace": "target"},
+		map[string]interface{}{"kind": "ServiceAccount", "name": "monitoring", "namespace": "monitoring"},
+	}
+	if !reflect.DeepEqual(subjects, expectedSubjects) {
+		t.Errorf("expected subjects %v, got %v", expectedSubjects, subjects)
+	}
+
+	if name, _, _ := unstructured.NestedString(binding.Object, "roleRef", "name"); name != "view" {
+		t.Errorf("expected cluster role reference to be kept, got %q", name)
+	}
+}
+
+func TestImportNamespaceBundleAllocatedFields(t *testing.T) {
+	source := []runtime.Object{
+		newTestNamespace(t, "source"),
+		newTestObject(t, `
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+  namespace: source
+spec:
+  type: LoadBalancer
+  clusterIP: 10.0.0.10
+  clusterIPs:
+  - 10.0.0.10
+  externalTrafficPolicy: Local
+  healthCheckNodePort: 31000
+  ports:
+  - name: http
+    port: 80
+    nodePort: 30080
+`),
+		newTestObject(t, `
+apiVersion: v1
+kind: PersistentVolumeClaim
+metadata:
+  name: data
+  namespace: source
+  annotations:
+    backup: daily
+    pv.kubernetes.io/bind-completed: "yes"
+    pv.kubernetes.io/bound-by-controller: "yes"
+spec:
+  accessModes:
+  - ReadWriteOnce
+  volumeName: pvc-0b6c4d2e
+`),
+	}
+
+	archive, err := exportNamespaceBundle(newFakeResourceLister(), newFakeBundleClient(source...), nil, "source",
+		BundleExportOptions{})
+	if err != nil {
+		t.Fatalf("exportNamespaceBundle() returned error: %v", err)
+	}
+
+	client := newFakeBundleClient(newTestNamespace(t, "source"))
+	report, err := importNamespaceBundle(client, newFakeBundleMapper(), "source", bytes.NewReader(archive),
+		BundleImportOptions{Suffix: "-copy"})
+	if err != nil {
+		t.Fatalf("importNamespaceBundle() returned error: %v", err)
+	}
+
+	if report.Created != 2 || report.Failed != 0 {
+		t.Fatalf("expected 2 created objects, got %+v", report)
+	}
+
+	service, err := client.Resource(serviceGVR).Namespace("source").Get(context.TODO(), "web-copy", metaV1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected imported service, got error: %v", err)
+	}
+
+	expectedSpec := newTestObject(t, `
+type: LoadBalancer
+externalTrafficPolicy: Local
+ports:
+- name: http
+  port: 80
+`).Object
+	if spec, _, _ := unstructured.NestedMap(service.Object, "spec"); !reflect.DeepEqual(spec, expectedSpec) {
+		t.Errorf("expected allocated IPs and node ports to be removed, got %v", spec)
+	}
+
+	claim, err := client.Resource(claimGVR).Namespace("source").Get(context.TODO(), "data-copy", metaV1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected imported claim, got error: %v", err)
+	}
+
+	if _, found, _ := unstructured.NestedString(claim.Object, "spec", "volumeName"); found {
+		t.Errorf("expected bound volume to be removed, got %v", claim.Object["spec"])
+	}
+
+	if annotations := claim.GetAnnotations(); !reflect.DeepEqual(annotations, map[string]string{"backup": "daily"}) {
+		t.Errorf("expected binding annotations to be removed, got %v", annotations)
+	}
+}
+
+func TestImportNamespaceBundleDryRun(t *testing.T) {
+	archive := exportTestBundle(t, BundleExportOptions{})
+	client := newFakeBundleClient(newTestNamespace(t, "target"))
+
+	// The fake client ignores create options, so creates are handled as a server would handle a
+	// dry run.
+	client.PrependReactor("create", "*", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return true, action.(clienttesting.CreateAction).GetObject(), nil
+	})
+
+	report, err := importNamespaceBundle(client, newFakeBundleMapper(), "target", bytes.NewReader(archive),
+		BundleImportOptions{Suffix: "-copy", DryRun: true})
+	if err != nil {
+		t.Fatalf("importNamespaceBundle() returned error: %v", err)
+	}
+
+	if !report.DryRun || report.Created != 4 {
+		t.Errorf("expected dry run report with 4 objects, got %+v", report)
+	}
+
+	for _, item := range report.Items {
+		if item.Status != ImportStatusDryRun || item.Name != item.SourceName+"-copy" {
+			t.Errorf("unexpected dry run item %+v", item)
+		}
+	}
+
+	// Objects can not be validated by the server when the namespace would be created.
+	client = newFakeBundleClient()
+	report, err = importNamespaceBundle(client, newFakeBundleMapper(), "target", bytes.NewReader(archive),
+		BundleImportOptions{CreateNamespace: true, DryRun: true})
+	if err != nil {
+		t.Fatalf("importNamespaceBundle() returned error: %v", err)
+	}
+
+	if report.NamespaceCreated || report.Created != 4 {
+		t.Errorf("expected dry run report with 4 objects, got %+v", report)
+	}
+
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "create" {
+			t.Errorf("dry run should not create objects, got %v", action)
+		}
+	}
+}
+
+func TestImportNamespaceBundleNamespace(t *testing.T) {
+	archive := exportTestBundle(t, BundleExportOptions{})
+
+	_, err := importNamespaceBundle(newFakeBundleClient(), newFakeBundleMapper(), "target", bytes.NewReader(archive),
+		BundleImportOptions{})
+	if err == nil {
+		t.Error("importNamespaceBundle() should fail when namespace does not exist")
+	}
+
+	client := newFakeBundleClient()
+	report, err := importNamespaceBundle(client, newFakeBundleMapper(), "target", bytes.NewReader(archive),
+		BundleImportOptions{CreateNamespace: true})
+	if err != nil {
+		t.Fatalf("importNamespaceBundle() returned error: %v", err)
+	}
+
+	if !report.NamespaceCreated || report.Created != 4 {
+		t.Errorf("expected namespace and 4 objects to be created, got %+v", report)
+	}
+
+	if _, err := client.Resource(namespaceResource).Get(context.TODO(), "target", metaV1.GetOptions{}); err != nil {
+		t.Errorf("expected namespace to be created, got error: %v", err)
+	}
+}
+
+func TestValidateName(t *testing.T) {
+	cases := []struct {
+		kind, name string
+		valid      bool
+	}{
+		{"ConfigMap", "web.config", true},
+		{"Service", "web.config", false},
+		{"Service", "copy-web", true},
+		{"Deployment", "Web", false},
+	}
+
+	for _, c := range cases {
+		if err := validateName(c.kind, c.name); (err == nil) != c.valid {
+			t.Errorf("validateName(%q, %q) == %v, expected valid: %v", c.kind, c.name, err, c.valid)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// serverManagedMetadata lists metadata fields populated by the API server.
+var serverManagedMetadata = []string{
+	"managedFields",
+	"resourceVersion",
+	"uid",
+	"selfLink",
+	"creationTimestamp",
+	"generation",
+	"deletionTimestamp",
+	"deletionGracePeriodSeconds",
+	"ownerReferences",
+}
+
+// serverManagedAnnotations lists annotations set by the API server, controllers or kubectl.
+var serverManagedAnnotations = []string{
+	"kubectl.kubernetes.io/last-applied-configuration",
+	"deployment.kubernetes.io/revision",
+}
+
+// persistentVolumeClaimBindingAnnotations are annotations set when a claim is bound or provisioned.
+var persistentVolumeClaimBindingAnnotations = []string{
+	"pv.kubernetes.io/bind-completed",
+	"pv.kubernetes.io/bound-by-controller",
+	"volume.beta.kubernetes.io/storage-provisioner",
+	"volume.kubernetes.io/storage-provisioner",
+	"volume.kubernetes.io/selected-node",
+}
+
+// jobGeneratedLabels are labels added to pods of jobs with generated selectors.
+var jobGeneratedLabels = []string{
+	"controller-uid",
+	"batch.kubernetes.io/controller-uid",
+	"job-name",
+	"batch.kubernetes.io/job-name",
+}
+
+// cleanObject removes status and fields populated by the server, so that the object can be
+// applied to a cluster again.
+func cleanObject(object *unstructured.Unstructured) {
+	for _, field := range serverManagedMetadata {
+		unstructured.RemoveNestedField(object.Object, "metadata", field)
+	}
+
+	annotations := object.GetAnnotations()
+	for _, annotation := range serverManagedAnnotations {
+		delete(annotations, annotation)
+	}
+
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	object.SetAnnotations(annotations)
+
+	unstructured.RemoveNestedField(object.Object, "status")
+
+	switch object.GroupVersionKind().GroupKind().String() {
+	case "Service":
+		cleanService(object)
+	case "PersistentVolumeClaim":
+		cleanPersistentVolumeClaim(object)
+	case "Job.batch":
+		cleanJob(object)
+	}
+}
+
+// cleanService removes allocated cluster IPs and node ports, which would collide with the source
+// service in the same cluster. Headless services keep the "None" cluster IP.
+func cleanService(object *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(object.Object, "spec", "healthCheckNodePort")
+	if ports, found, _ := unstructured.NestedSlice(object.Object, "spec", "ports"); found {
+		for _, port := range ports {
+			if port, ok := port.(map[string]interface{}); ok {
+				delete(port, "nodePort")
+			}
+		}
+		_ = unstructured.SetNestedSlice(object.Object, ports, "spec", "ports")
+	}
+
+	if clusterIP, _, _ := unstructured.NestedString(object.Object, "spec", "clusterIP"); clusterIP == "None" {
+		return
+	}
+
+	unstructured.RemoveNestedField(object.Object, "spec", "clusterIP")
+	unstructured.RemoveNestedField(object.Object, "spec", "clusterIPs")
+}
+
+// cleanPersistentVolumeClaim removes the bound volume, so that the claim is bound or provisioned
+// again instead of staying pending against a volume bound to the source claim.
+func cleanPersistentVolumeClaim(object *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(object.Object, "spec", "volumeName")
+
+	annotations := object.GetAnnotations()
+	for _, annotation := range persistentVolumeClaimBindingAnnotations {
+		delete(annotations, annotation)
+	}
+
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	object.SetAnnotations(annotations)
+}
+
+// cleanJob removes the generated selector, as it is rejected on create.
+func cleanJob(object *unstructured.Unstructured) {
+	if manualSelector, _, _ := unstructured.NestedBool(object.Object, "spec", "manualSelector"); manualSelector {
+		return
+	}
+
+	unstructured.RemoveNestedField(object.Object, "spec", "selector")
+	for _, label := range jobGeneratedLabels {
+		unstructured.RemoveNestedField(object.Object, "spec", "template", "metadata", "labels", label)
+	}
+
+	if labels, _, _ := unstructured.NestedMap(object.Object, "spec", "template", "metadata", "labels"); len(labels) == 0 {
+		unstructured.RemoveNestedField(object.Object, "spec", "template", "metadata", "labels")
+	}
+}
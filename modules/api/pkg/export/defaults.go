@@ -0,0 +1,228 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/openapi"
+	"k8s.io/kube-openapi/pkg/spec3"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+)
+
+const (
+	// gvkExtension is the OpenAPI extension listing group, version and kind of a schema.
+	gvkExtension = "x-kubernetes-group-version-kind"
+
+	// componentsRefPrefix is the prefix of references to schemas in OpenAPI v3 documents.
+	componentsRefPrefix = "#/components/schemas/"
+
+	// maxSchemaDepth protects against cyclic references, e.g. in JSONSchemaProps.
+	maxSchemaDepth = 64
+)
+
+// schemaResolver returns OpenAPI schemas of objects.
+type schemaResolver interface {
+	// schemaFor returns the schema of given kind and all schemas it can reference. Nil schema is
+	// returned when the kind is not published.
+	schemaFor(gvk schema.GroupVersionKind) (*spec.Schema, map[string]*spec.Schema, error)
+}
+
+// openAPIV3Resolver resolves schemas from OpenAPI v3 documents served by the API server. Documents
+// are cached per group version.
+type openAPIV3Resolver struct {
+	client    openapi.Client
+	paths     map[string]openapi.GroupVersion
+	documents map[string]*spec3.OpenAPI
+}
+
+func newOpenAPIV3Resolver(client openapi.Client) *openAPIV3Resolver {
+	return &openAPIV3Resolver{client: client, documents: make(map[string]*spec3.OpenAPI)}
+}
+
+func (in *openAPIV3Resolver) schemaFor(gvk schema.GroupVersionKind) (*spec.Schema, map[string]*spec.Schema, error) {
+	document, err := in.documentFor(gvk.GroupVersion())
+	if err != nil || document == nil || document.Components == nil {
+		return nil, nil, err
+	}
+
+	schemas := document.Components.Schemas
+	for _, candidate := range schemas {
+		if hasGroupVersionKind(candidate, gvk) {
+			return candidate, schemas, nil
+		}
+	}
+
+	return nil, schemas, nil
+}
+
+func (in *openAPIV3Resolver) documentFor(gv schema.GroupVersion) (*spec3.OpenAPI, error) {
+	path := "apis/" + gv.String()
+	if len(gv.Group) == 0 {
+		path = "api/" + gv.Version
+	}
+
+	if document, exists := in.documents[path]; exists {
+		return document, nil
+	}
+
+	if in.paths == nil {
+		paths, err := in.client.Paths()
+		if err != nil {
+			return nil, err
+		}
+		in.paths = paths
+	}
+
+	groupVersion, exists := in.paths[path]
+	if !exists {
+		in.documents[path] = nil
+		return nil, nil
+	}
+
+	data, err := groupVersion.Schema("application/json")
+	if err != nil {
+		return nil, err
+	}
+
+	document := new(spec3.OpenAPI)
+	if err := json.Unmarshal(data, document); err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI document of %s: %w", gv.String(), err)
+	}
+
+	in.documents[path] = document
+	return document, nil
+}
+
+func hasGroupVersionKind(s *spec.Schema, gvk schema.GroupVersionKind) bool {
+	gvks, ok := s.Extensions[gvkExtension].([]interface{})
+	if !ok {
+		return false
+	}
+
+	for _, item := range gvks {
+		value, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if value["group"] == gvk.Group && value["version"] == gvk.Version && value["kind"] == gvk.Kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// defaultsStripper removes values equal to defaults declared in the schema.
+type defaultsStripper struct {
+	schemas map[string]*spec.Schema
+}
+
+// strip removes defaulted fields from given object in place.
+func (in *defaultsStripper) strip(object map[string]interface{}, s *spec.Schema) {
+	in.stripValue(object, s, 0)
+}
+
+func (in *defaultsStripper) stripValue(value interface{}, s *spec.Schema, depth int) {
+	if s == nil || depth > maxSchemaDepth {
+		return
+	}
+
+	s = in.resolve(s)
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, field := range typed {
+			fieldSchema := in.fieldSchema(s, key)
+			if fieldSchema == nil {
+				continue
+			}
+
+			if isDefault(field, fieldSchema) {
+				delete(typed, key)
+				continue
+			}
+
+			in.stripValue(field, fieldSchema, depth+1)
+		}
+	case []interface{}:
+		if s.Items == nil || s.Items.Schema == nil {
+			return
+		}
+
+		for _, item := range typed {
+			in.stripValue(item, s.Items.Schema, depth+1)
+		}
+	}
+}
+
+// fieldSchema returns the schema of the object field. The default of the field is kept on the
+// returned schema even if its type is defined through a reference.
+func (in *defaultsStripper) fieldSchema(s *spec.Schema, key string) *spec.Schema {
+	if property, exists := s.Properties[key]; exists {
+		return &property
+	}
+
+	if s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil {
+		return s.AdditionalProperties.Schema
+	}
+
+	return nil
+}
+
+// resolve follows references, including single element "allOf" wrappers used by OpenAPI v3
+// documents to reference types next to field defaults.
+func (in *defaultsStripper) resolve(s *spec.Schema) *spec.Schema {
+	for i := 0; i < maxSchemaDepth; i++ {
+		switch {
+		case len(s.Ref.String()) > 0:
+			resolved, exists := in.schemas[strings.TrimPrefix(s.Ref.String(), componentsRefPrefix)]
+			if !exists {
+				return s
+			}
+			s = resolved
+		case len(s.AllOf) == 1 && len(s.Properties) == 0:
+			s = &s.AllOf[0]
+		default:
+			return s
+		}
+	}
+
+	return s
+}
+
+func isDefault(value interface{}, s *spec.Schema) bool {
+	if s.Default == nil {
+		return false
+	}
+
+	// Values are compared in their JSON form, as numbers are decoded differently in objects and
+	// schemas.
+	valueData, err := json.Marshal(value)
+	if err != nil {
+		return false
+	}
+
+	defaultData, err := json.Marshal(s.Default)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(valueData, defaultData)
+}
@@ -0,0 +1,211 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+
+	"k8s.io/dashboard/api/pkg/bulk"
+	"k8s.io/dashboard/client"
+	"k8s.io/dashboard/errors"
+)
+
+// Format is the encoding of exported objects.
+type Format string
+
+// List of supported export formats.
+const (
+	FormatYAML Format = "yaml"
+	FormatJSON Format = "json"
+)
+
+// documentSeparator separates objects in multi-document YAML.
+const documentSeparator = "---\n"
+
+// ParseFormat parses the export format. YAML is used by default.
+func ParseFormat(format string) (Format, error) {
+	switch Format(format) {
+	case "", FormatYAML:
+		return FormatYAML, nil
+	case FormatJSON:
+		return FormatJSON, nil
+	default:
+		return "", errors.NewBadRequest(fmt.Sprintf("unsupported export format %q", format))
+	}
+}
+
+// ContentType returns the MIME type of the format.
+func (in Format) ContentType() string {
+	if in == FormatJSON {
+		return "application/json"
+	}
+
+	return "application/yaml"
+}
+
+// ExportSpec describes objects to export.
+type ExportSpec struct {
+	// Items are references to exported objects, the same as used by bulk actions.
+	Items []bulk.ObjectReference `json:"items"`
+
+	// Format is either "yaml" (default) or "json".
+	Format Format `json:"format"`
+
+	// StripDefaults removes fields with values equal to defaults declared in the OpenAPI schema.
+	StripDefaults bool `json:"stripDefaults"`
+}
+
+// ExportResource returns a single object without status and server-managed fields.
+func ExportResource(verber client.ResourceVerber, cfg *rest.Config, ref bulk.ObjectReference, format Format,
+	stripDefaults bool) ([]byte, error) {
+	objects, err := newExporter(verber, cfg, stripDefaults).export([]bulk.ObjectReference{ref})
+	if err != nil {
+		return nil, err
+	}
+
+	return encode(objects[0].Object, format)
+}
+
+// ExportResources returns multiple objects without status and server-managed fields, either as a
+// multi-document YAML or as a JSON list.
+func ExportResources(verber client.ResourceVerber, cfg *rest.Config, spec *ExportSpec) ([]byte, error) {
+	if len(spec.Items) == 0 {
+		return nil, errors.NewBadRequest("at least one item is required")
+	}
+
+	objects, err := newExporter(verber, cfg, spec.StripDefaults).export(spec.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeList(objects, spec.Format)
+}
+
+type exporter struct {
+	verber  client.ResourceVerber
+	schemas schemaResolver
+}
+
+func newExporter(verber client.ResourceVerber, cfg *rest.Config, stripDefaults bool) *exporter {
+	result := &exporter{verber: verber}
+	if !stripDefaults {
+		return result
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		klog.V(3).InfoS("could not create discovery client, defaults will not be stripped", "error", err)
+		return result
+	}
+
+	result.schemas = newOpenAPIV3Resolver(discoveryClient.OpenAPIV3())
+	return result
+}
+
+func (in *exporter) export(refs []bulk.ObjectReference) ([]*unstructured.Unstructured, error) {
+	result := make([]*unstructured.Unstructured, 0, len(refs))
+	for _, ref := range refs {
+		raw, err := in.verber.Get(ref.Kind, ref.Namespace, ref.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		object, err := toUnstructured(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		cleanObject(object)
+		in.stripDefaults(object)
+		result = append(result, object)
+	}
+
+	return result, nil
+}
+
+// stripDefaults removes defaulted fields when the schema of the object is available. Objects are
+// exported with defaults when it is not.
+func (in *exporter) stripDefaults(object *unstructured.Unstructured) {
+	if in.schemas == nil {
+		return
+	}
+
+	s, schemas, err := in.schemas.schemaFor(object.GroupVersionKind())
+	if err != nil || s == nil {
+		klog.V(3).InfoS("could not get schema, defaults will not be stripped", "gvk", object.GroupVersionKind(), "error", err)
+		return
+	}
+
+	stripper := &defaultsStripper{schemas: schemas}
+	stripper.strip(object.Object, s)
+}
+
+func toUnstructured(object runtime.Object) (*unstructured.Unstructured, error) {
+	if result, ok := object.(*unstructured.Unstructured); ok {
+		return result.DeepCopy(), nil
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unstructured.Unstructured{Object: content}, nil
+}
+
+func encode(object map[string]interface{}, format Format) ([]byte, error) {
+	if format == FormatJSON {
+		return json.MarshalIndent(object, "", "  ")
+	}
+
+	return yaml.Marshal(object)
+}
+
+func encodeList(objects []*unstructured.Unstructured, format Format) ([]byte, error) {
+	if format == FormatJSON {
+		items := make([]interface{}, 0, len(objects))
+		for _, object := range objects {
+			items = append(items, object.Object)
+		}
+
+		return encode(map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "List",
+			"items":      items,
+		}, format)
+	}
+
+	var buf bytes.Buffer
+	for _, object := range objects {
+		data, err := encode(object.Object, format)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.WriteString(documentSeparator)
+		buf.Write(data)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,283 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"sigs.k8s.io/yaml"
+
+	"k8s.io/dashboard/api/pkg/bulk"
+	"k8s.io/dashboard/errors"
+)
+
+type fakeVerber struct {
+	objects map[string]*unstructured.Unstructured
+}
+
+func (in *fakeVerber) Get(kind string, namespace string, name string) (runtime.Object, error) {
+	if object, exists := in.objects[kind+"/"+namespace+"/"+name]; exists {
+		return object, nil
+	}
+
+	return nil, errors.NewNotFound(name)
+}
+
+func (in *fakeVerber) Update(*unstructured.Unstructured) error { return nil }
+
+func (in *fakeVerber) Patch(string, string, string, k8stypes.PatchType, []byte) (runtime.Object, error) {
+	return nil, nil
+}
+
+func (in *fakeVerber) Delete(string, string, string, string, bool) error { return nil }
+
+func (in *fakeVerber) UpdateResource(schema.GroupVersionResource, *unstructured.Unstructured, string) error {
+	return nil
+}
+
+func (in *fakeVerber) PatchResource(schema.GroupVersionResource, string, string, string, k8stypes.PatchType, []byte) (runtime.Object, error) {
+	return nil, nil
+}
+
+func (in *fakeVerber) GetResource(schema.GroupVersionResource, string, string, string) (runtime.Object, error) {
+	return nil, nil
+}
+
+func (in *fakeVerber) DeleteResource(schema.GroupVersionResource, string, string, string, bool) error {
+	return nil
+}
+
+type fakeSchemaResolver struct {
+	schemas map[string]*spec.Schema
+}
+
+func (in *fakeSchemaResolver) schemaFor(gvk schema.GroupVersionKind) (*spec.Schema, map[string]*spec.Schema, error) {
+	for _, s := range in.schemas {
+		if hasGroupVersionKind(s, gvk) {
+			return s, in.schemas, nil
+		}
+	}
+
+	return nil, in.schemas, nil
+}
+
+func newTestObject(t *testing.T, manifest string) *unstructured.Unstructured {
+	t.Helper()
+
+	object := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal([]byte(manifest), &object.Object); err != nil {
+		t.Fatal(err)
+	}
+
+	return object
+}
+
+const liveService = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+  namespace: default
+  uid: 0b6c4d2e
+  resourceVersion: "42"
+  creationTimestamp: "2024-01-02T03:04:05Z"
+  annotations:
+    kubectl.kubernetes.io/last-applied-configuration: "{}"
+  managedFields:
+  - manager: kubectl
+spec:
+  clusterIP: 10.0.0.10
+  clusterIPs:
+  - 10.0.0.10
+  ports:
+  - port: 80
+    protocol: TCP
+status:
+  loadBalancer: {}
+`
+
+const liveHeadlessService = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: db
+  namespace: default
+  annotations:
+    team: data
+spec:
+  clusterIP: None
+`
+
+func TestCleanObject(t *testing.T) {
+	cases := []struct {
+		live     string
+		expected string
+	}{
+		{
+			liveService,
+			`
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+  namespace: default
+spec:
+  ports:
+  - port: 80
+    protocol: TCP
+`,
+		},
+		{
+			liveHeadlessService,
+			liveHeadlessService,
+		},
+		{
+			`
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: pi
+spec:
+  selector:
+    matchLabels:
+      batch.kubernetes.io/controller-uid: 0b6c4d2e
+  template:
+    metadata:
+      labels:
+        batch.kubernetes.io/controller-uid: 0b6c4d2e
+        batch.kubernetes.io/job-name: pi
+        controller-uid: 0b6c4d2e
+        job-name: pi
+`,
+			`
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: pi
+spec:
+  template:
+    metadata: {}
+`,
+		},
+	}
+
+	for _, c := range cases {
+		actual := newTestObject(t, c.live)
+		cleanObject(actual)
+		expected := newTestObject(t, c.expected)
+
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("cleanObject() == \n%#v\nexpected \n%#v\n", actual.Object, expected.Object)
+		}
+	}
+}
+
+func TestStripDefaults(t *testing.T) {
+	schemas := map[string]*spec.Schema{}
+	if err := json.Unmarshal([]byte(`{
+  "io.k8s.api.core.v1.Service": {
+    "x-kubernetes-group-version-kind": [{"group": "", "version": "v1", "kind": "Service"}],
+    "properties": {
+      "spec": {"allOf": [{"$ref": "#/components/schemas/io.k8s.api.core.v1.ServiceSpec"}]}
+    }
+  },
+  "io.k8s.api.core.v1.ServiceSpec": {
+    "properties": {
+      "ports": {"type": "array", "items": {"allOf": [{"$ref": "#/components/schemas/io.k8s.api.core.v1.ServicePort"}]}},
+      "type": {"type": "string", "default": "ClusterIP"}
+    }
+  },
+  "io.k8s.api.core.v1.ServicePort": {
+    "properties": {
+      "port": {"type": "integer"},
+      "protocol": {"type": "string", "default": "TCP"}
+    }
+  }
+}`), &schemas); err != nil {
+		t.Fatal(err)
+	}
+
+	service := newTestObject(t, liveService)
+	unstructured.SetNestedField(service.Object, "ClusterIP", "spec", "type")
+	verber := &fakeVerber{objects: map[string]*unstructured.Unstructured{"service/default/web": service}}
+	exporter := &exporter{verber: verber, schemas: &fakeSchemaResolver{schemas: schemas}}
+
+	objects, err := exporter.export([]bulk.ObjectReference{{Kind: "service", Namespace: "default", Name: "web"}})
+	if err != nil {
+		t.Fatalf("export() returned error: %v", err)
+	}
+
+	expected := newTestObject(t, `
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+  namespace: default
+spec:
+  ports:
+  - port: 80
+`)
+	if !reflect.DeepEqual(objects[0], expected) {
+		t.Errorf("export() == \n%#v\nexpected \n%#v\n", objects[0].Object, expected.Object)
+	}
+
+	if _, exists, _ := unstructured.NestedString(service.Object, "status", "loadBalancer"); exists {
+		t.Error("export() should not modify the object returned by the verber")
+	}
+}
+
+func TestEncodeList(t *testing.T) {
+	objects := []*unstructured.Unstructured{newTestObject(t, liveHeadlessService), newTestObject(t, liveHeadlessService)}
+
+	actual, err := encodeList(objects, FormatYAML)
+	if err != nil {
+		t.Fatalf("encodeList() returned error: %v", err)
+	}
+
+	document, _ := yaml.Marshal(objects[0].Object)
+	expected := documentSeparator + string(document) + documentSeparator + string(document)
+	if string(actual) != expected {
+		t.Errorf("encodeList() == \n%s\nexpected \n%s\n", actual, expected)
+	}
+
+	actual, err = encodeList(objects, FormatJSON)
+	if err != nil {
+		t.Fatalf("encodeList() returned error: %v", err)
+	}
+
+	list := &unstructured.UnstructuredList{}
+	if err := list.UnmarshalJSON(actual); err != nil || len(list.Items) != 2 {
+		t.Errorf("encodeList() should return JSON list with 2 items, got %s (error: %v)", actual, err)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for value, expected := range map[string]Format{"": FormatYAML, "yaml": FormatYAML, "json": FormatJSON} {
+		if actual, err := ParseFormat(value); err != nil || actual != expected {
+			t.Errorf("ParseFormat(%q) == %q, %v, expected %q", value, actual, err, expected)
+		}
+	}
+
+	if _, err := ParseFormat("xml"); err == nil {
+		t.Error("ParseFormat() should return error for unsupported format")
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// podSpecPaths are paths to pod specs of workload kinds.
+var podSpecPaths = map[string][]string{
+	"Pod":                   {"spec"},
+	"Deployment":            {"spec", "template", "spec"},
+	"ReplicaSet":            {"spec", "template", "spec"},
+	"ReplicationController": {"spec", "template", "spec"},
+	"StatefulSet":           {"spec", "template", "spec"},
+	"DaemonSet":             {"spec", "template", "spec"},
+	"Job":                   {"spec", "template", "spec"},
+	"CronJob":               {"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+// renamer rewrites namespaces and names of imported objects, together with references between
+// objects of the bundle, e.g. config maps mounted by deployments or service accounts bound to
+// roles.
+type renamer struct {
+	sourceNamespace string
+	targetNamespace string
+	prefix          string
+	suffix          string
+
+	// names maps kinds to names of bundle objects of that kind.
+	names map[string]map[string]bool
+}
+
+func newRenamer(sourceNamespace, targetNamespace, prefix, suffix string, objects []*unstructured.Unstructured) *renamer {
+	result := &renamer{
+		sourceNamespace: sourceNamespace,
+		targetNamespace: targetNamespace,
+		prefix:          prefix,
+		suffix:          suffix,
+		names:           make(map[string]map[string]bool),
+	}
+
+	for _, object := range objects {
+		if _, exists := result.names[object.GetKind()]; !exists {
+			result.names[object.GetKind()] = make(map[string]bool)
+		}
+		result.names[object.GetKind()][object.GetName()] = true
+	}
+
+	return result
+}
+
+// newName returns the name of the object after import.
+func (in *renamer) newName(name string) string {
+	return in.prefix + name + in.suffix
+}
+
+// rewrite moves the object to the target namespace and renames it together with references to
+// other bundle objects. References to objects outside of the bundle are kept.
+func (in *renamer) rewrite(object *unstructured.Unstructured) {
+	object.SetNamespace(in.targetNamespace)
+	object.SetName(in.newName(object.GetName()))
+
+	if path, exists := podSpecPaths[object.GetKind()]; exists {
+		if podSpec, ok := nestedMap(object.Object, path...); ok {
+			in.rewritePodSpec(podSpec)
+		}
+	}
+
+	switch object.GetKind() {
+	case "StatefulSet":
+		in.rename(object.Object, "Service", "spec", "serviceName")
+	case "Ingress":
+		in.rewriteIngress(object.Object)
+	case "RoleBinding":
+		in.rewriteRoleBinding(object.Object)
+	case "HorizontalPodAutoscaler":
+		if kind, ok := nestedString(object.Object, "spec", "scaleTargetRef", "kind"); ok {
+			in.rename(object.Object, kind, "spec", "scaleTargetRef", "name")
+		}
+	}
+}
+
+func (in *renamer) rewritePodSpec(podSpec map[string]interface{}) {
+	in.rename(podSpec, "ServiceAccount", "serviceAccountName")
+	in.rename(podSpec, "ServiceAccount", "serviceAccount")
+	forEach(podSpec, func(item map[string]interface{}) { in.rename(item, "Secret", "name") }, "imagePullSecrets")
+
+	forEach(podSpec, func(volume map[string]interface{}) {
+		in.rename(volume, "ConfigMap", "configMap", "name")
+		in.rename(volume, "Secret", "secret", "secretName")
+		in.rename(volume, "PersistentVolumeClaim", "persistentVolumeClaim", "claimName")
+		forEach(volume, func(source map[string]interface{}) {
+			in.rename(source, "ConfigMap", "configMap", "name")
+			in.rename(source, "Secret", "secret", "name")
+		}, "projected", "sources")
+	}, "volumes")
+
+	for _, containers := range []string{"initContainers", "containers", "ephemeralContainers"} {
+		forEach(podSpec, func(container map[string]interface{}) {
+			forEach(container, func(envFrom map[string]interface{}) {
+				in.rename(envFrom, "ConfigMap", "configMapRef", "name")
+				in.rename(envFrom, "Secret", "secretRef", "name")
+			}, "envFrom")
+			forEach(container, func(env map[string]interface{}) {
+				in.rename(env, "ConfigMap", "valueFrom", "configMapKeyRef", "name")
+				in.rename(env, "Secret", "valueFrom", "secretKeyRef", "name")
+			}, "env")
+		}, containers)
+	}
+}
+
+func (in *renamer) rewriteIngress(object map[string]interface{}) {
+	in.rename(object, "Service", "spec", "defaultBackend", "service", "name")
+	forEach(object, func(tls map[string]interface{}) { in.rename(tls, "Secret", "secretName") }, "spec", "tls")
+	forEach(object, func(rule map[string]interface{}) {
+		forEach(rule, func(path map[string]interface{}) {
+			in.rename(path, "Service", "backend", "service", "name")
+		}, "http", "paths")
+	}, "spec", "rules")
+}
+
+// rewriteRoleBinding renames bound roles and service accounts. Service accounts of the source
+// namespace are moved to the target namespace.
+func (in *renamer) rewriteRoleBinding(object map[string]interface{}) {
+	if kind, _ := nestedString(object, "roleRef", "kind"); kind == "Role" {
+		in.rename(object, "Role", "roleRef", "name")
+	}
+
+	forEach(object, func(subject map[string]interface{}) {
+		if kind, _ := nestedString(subject, "kind"); kind != "ServiceAccount" {
+			return
+		}
+
+		if namespace, _ := nestedString(subject, "namespace"); namespace != in.sourceNamespace {
+			return
+		}
+
+		subject["namespace"] = in.targetNamespace
+		in.rename(subject, "ServiceAccount", "name")
+	}, "subjects")
+}
+
+// rename replaces the name at given path if it references a bundle object of given kind.
+func (in *renamer) rename(object map[string]interface{}, kind string, path ...string) {
+	name, ok := nestedString(object, path...)
+	if !ok || !in.names[kind][name] {
+		return
+	}
+
+	_ = unstructured.SetNestedField(object, in.newName(name), path...)
+}
+
+// nestedString returns the string at given path. Unlike unstructured.NestedString it does not
+// fail on values of other types.
+func nestedString(object map[string]interface{}, path ...string) (string, bool) {
+	value, found, err := unstructured.NestedFieldNoCopy(object, path...)
+	if !found || err != nil {
+		return "", false
+	}
+
+	result, ok := value.(string)
+	return result, ok
+}
+
+func nestedMap(object map[string]interface{}, path ...string) (map[string]interface{}, bool) {
+	value, found, err := unstructured.NestedFieldNoCopy(object, path...)
+	if !found || err != nil {
+		return nil, false
+	}
+
+	result, ok := value.(map[string]interface{})
+	return result, ok
+}
+
+// forEach calls fn for every object in the list at given path.
+func forEach(object map[string]interface{}, fn func(map[string]interface{}), path ...string) {
+	value, found, err := unstructured.NestedFieldNoCopy(object, path...)
+	if !found || err != nil {
+		return
+	}
+
+	items, ok := value.([]interface{})
+	if !ok {
+		return
+	}
+
+	for _, item := range items {
+		if itemMap, ok := item.(map[string]interface{}); ok {
+			fn(itemMap)
+		}
+	}
+}
@@ -0,0 +1,361 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/errors"
+)
+
+const (
+	// DefaultDepth is the number of relationship hops followed from the root object by default.
+	DefaultDepth = 2
+
+	// MaxDepth is the maximum number of relationship hops followed from the root object.
+	MaxDepth = 5
+
+	// maxNodes limits the size of the graph, e.g. for services selecting thousands of pods.
+	maxNodes = 500
+)
+
+// EdgeType is the kind of relationship between two objects.
+type EdgeType string
+
+// List of edge types. Edges point from the object holding the reference to the referenced
+// object, e.g. from a pod to its owner or from a service to pods it selects.
+const (
+	EdgeTypeOwner        EdgeType = "owner"
+	EdgeTypeSelector     EdgeType = "selector"
+	EdgeTypeScaleTarget  EdgeType = "scaleTarget"
+	EdgeTypeVolume       EdgeType = "volume"
+	EdgeTypeReference    EdgeType = "reference"
+	EdgeTypeStorageClass EdgeType = "storageClass"
+	EdgeTypeBackend      EdgeType = "backend"
+	EdgeTypeEndpoints    EdgeType = "endpoints"
+	EdgeTypeRoleRef      EdgeType = "roleRef"
+	EdgeTypeSubject      EdgeType = "subject"
+)
+
+// Node is a single object of the graph.
+type Node struct {
+	ID         string `json:"id"`
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+
+	// Depth is the number of hops from the root object.
+	Depth int `json:"depth"`
+
+	// Missing is true for referenced objects that do not exist. Only objects closer to the root
+	// than the requested depth are checked.
+	Missing bool `json:"missing,omitempty"`
+}
+
+// Edge is a relationship between two nodes.
+type Edge struct {
+	From string   `json:"from"`
+	To   string   `json:"to"`
+	Type EdgeType `json:"type"`
+}
+
+// Graph is the set of objects related to the root object.
+type Graph struct {
+	Root  string `json:"root"`
+	Depth int    `json:"depth"`
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+
+	// Truncated is true when the graph was limited in size.
+	Truncated bool `json:"truncated,omitempty"`
+
+	// Errors list relationships that could not be resolved, e.g. because of missing permissions.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// objectRef identifies an object of the graph. Version is optional.
+type objectRef struct {
+	schema.GroupKind
+	Version   string
+	Namespace string
+	Name      string
+}
+
+func newObjectRef(kind schema.GroupKind, namespace, name string) objectRef {
+	return objectRef{GroupKind: kind, Namespace: namespace, Name: name}
+}
+
+// id returns the node ID, e.g. "Deployment.apps/default/web" or "StorageClass.storage.k8s.io/standard".
+func (in objectRef) id() string {
+	parts := []string{in.GroupKind.String()}
+	if len(in.Namespace) > 0 {
+		parts = append(parts, in.Namespace)
+	}
+
+	return strings.Join(append(parts, in.Name), "/")
+}
+
+// link is a relationship found while expanding an object. Incoming links point from the found
+// object to the expanded one.
+type link struct {
+	ref      objectRef
+	edgeType EdgeType
+	incoming bool
+}
+
+// GetGraph returns objects related to the given object up to given depth. Kind is the same as
+// used by the "_raw" endpoints, e.g. "deployment" or "resource.group" for custom resources.
+func GetGraph(cfg *rest.Config, kind, namespace, name string, depth int) (*Graph, error) {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+	return getGraph(dynamicClient, mapper, kind, namespace, name, depth)
+}
+
+func getGraph(client dynamic.Interface, mapper meta.RESTMapper, kind, namespace, name string, depth int) (*Graph, error) {
+	if depth < 1 || depth > MaxDepth {
+		return nil, errors.NewBadRequest(fmt.Sprintf("depth must be between 1 and %d", MaxDepth))
+	}
+
+	gvr, err := mapper.ResourceFor(schema.ParseGroupResource(kind).WithVersion(""))
+	if err != nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("unknown kind %q: %s", kind, err))
+	}
+
+	gvk, err := mapper.KindFor(gvr)
+	if err != nil {
+		return nil, err
+	}
+
+	root := objectRef{GroupKind: gvk.GroupKind(), Version: gvk.Version, Namespace: namespace, Name: name}
+	b := newBuilder(client, mapper, root, depth)
+	if err := b.build(); err != nil {
+		return nil, err
+	}
+
+	return b.graph, nil
+}
+
+type builder struct {
+	client   dynamic.Interface
+	mapper   meta.RESTMapper
+	maxDepth int
+	root     objectRef
+
+	graph *Graph
+	nodes map[string]int
+	edges map[Edge]bool
+
+	// lists caches listed objects by resource and namespace.
+	lists map[string][]unstructured.Unstructured
+}
+
+func newBuilder(client dynamic.Interface, mapper meta.RESTMapper, root objectRef, maxDepth int) *builder {
+	return &builder{
+		client:   client,
+		mapper:   mapper,
+		maxDepth: maxDepth,
+		root:     root,
+		graph:    &Graph{Root: root.id(), Depth: maxDepth, Nodes: make([]Node, 0), Edges: make([]Edge, 0)},
+		nodes:    make(map[string]int),
+		edges:    make(map[Edge]bool),
+		lists:    make(map[string][]unstructured.Unstructured),
+	}
+}
+
+// build walks relationships breadth-first, so that every node has the lowest possible depth.
+func (in *builder) build() error {
+	rootObject, err := in.get(in.root)
+	if err != nil {
+		return err
+	}
+
+	in.addNode(in.root, 0)
+	in.setAPIVersion(in.root, rootObject)
+
+	queue := []*unstructured.Unstructured{rootObject}
+	depths := []int{0}
+	for len(queue) > 0 {
+		object, depth := queue[0], depths[0]
+		queue, depths = queue[1:], depths[1:]
+
+		from := refOf(object)
+		for _, l := range in.links(object) {
+			if _, exists := in.nodes[l.ref.id()]; !exists {
+				if len(in.graph.Nodes) >= maxNodes {
+					in.graph.Truncated = true
+					continue
+				}
+
+				in.addNode(l.ref, depth+1)
+				if depth+1 < in.maxDepth && !isSubject(l.ref) {
+					next, err := in.get(l.ref)
+					if err != nil {
+						in.markMissing(l.ref, err)
+					} else {
+						in.setAPIVersion(l.ref, next)
+						queue = append(queue, next)
+						depths = append(depths, depth+1)
+					}
+				}
+			}
+
+			in.addEdge(from, l)
+		}
+	}
+
+	return nil
+}
+
+func (in *builder) addNode(ref objectRef, depth int) {
+	node := Node{
+		ID:        ref.id(),
+		Kind:      ref.Kind,
+		Namespace: ref.Namespace,
+		Name:      ref.Name,
+		Depth:     depth,
+	}
+
+	if len(ref.Version) > 0 {
+		node.APIVersion = ref.GroupKind.WithVersion(ref.Version).GroupVersion().String()
+	}
+
+	in.nodes[node.ID] = len(in.graph.Nodes)
+	in.graph.Nodes = append(in.graph.Nodes, node)
+}
+
+func (in *builder) setAPIVersion(ref objectRef, object *unstructured.Unstructured) {
+	in.graph.Nodes[in.nodes[ref.id()]].APIVersion = object.GetAPIVersion()
+}
+
+func (in *builder) markMissing(ref objectRef, err error) {
+	if k8serrors.IsNotFound(err) {
+		in.graph.Nodes[in.nodes[ref.id()]].Missing = true
+		return
+	}
+
+	in.addError(fmt.Errorf("%s: %w", ref.id(), err))
+}
+
+func (in *builder) addEdge(from objectRef, l link) {
+	edge := Edge{From: from.id(), To: l.ref.id(), Type: l.edgeType}
+	if l.incoming {
+		edge.From, edge.To = edge.To, edge.From
+	}
+
+	if _, exists := in.nodes[l.ref.id()]; !exists || in.edges[edge] {
+		return
+	}
+
+	in.edges[edge] = true
+	in.graph.Edges = append(in.graph.Edges, edge)
+}
+
+func (in *builder) addError(err error) {
+	klog.V(3).InfoS("could not resolve relationship", "error", err)
+	in.graph.Errors = append(in.graph.Errors, err.Error())
+}
+
+func (in *builder) get(ref objectRef) (*unstructured.Unstructured, error) {
+	gvr, err := in.resourceFor(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return in.client.Resource(gvr).Namespace(ref.Namespace).Get(context.TODO(), ref.Name, metaV1.GetOptions{})
+}
+
+func (in *builder) resourceFor(ref objectRef) (schema.GroupVersionResource, error) {
+	if gvr, exists := knownResources[ref.GroupKind]; exists {
+		return gvr, nil
+	}
+
+	versions := make([]string, 0, 1)
+	if len(ref.Version) > 0 {
+		versions = append(versions, ref.Version)
+	}
+
+	mapping, err := in.mapper.RESTMapping(ref.GroupKind, versions...)
+	if err != nil {
+		return schema.GroupVersionResource{}, err
+	}
+
+	return mapping.Resource, nil
+}
+
+// list returns objects of the resource in the namespace, or in all namespaces when it is empty.
+// Errors are recorded in the graph and an empty list is returned.
+func (in *builder) list(gvr schema.GroupVersionResource, namespace string) []unstructured.Unstructured {
+	key := gvr.String() + "/" + namespace
+	if items, exists := in.lists[key]; exists {
+		return items
+	}
+
+	result, err := in.client.Resource(gvr).Namespace(namespace).List(context.TODO(), metaV1.ListOptions{})
+	if err != nil {
+		in.addError(fmt.Errorf("%s: %w", gvr.GroupResource(), err))
+		in.lists[key] = nil
+		return nil
+	}
+
+	in.lists[key] = result.Items
+	return result.Items
+}
+
+func refOf(object *unstructured.Unstructured) objectRef {
+	gvk := object.GroupVersionKind()
+	return objectRef{GroupKind: gvk.GroupKind(), Version: gvk.Version, Namespace: object.GetNamespace(), Name: object.GetName()}
+}
+
+// listTyped lists objects of the resource converted to the typed object.
+func listTyped[T any](b *builder, gvr schema.GroupVersionResource, namespace string) []*T {
+	items := b.list(gvr, namespace)
+	result := make([]*T, 0, len(items))
+	for i := range items {
+		typed := new(T)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(items[i].Object, typed); err != nil {
+			b.addError(fmt.Errorf("%s/%s: %w", gvr.GroupResource(), items[i].GetName(), err))
+			continue
+		}
+
+		result = append(result, typed)
+	}
+
+	return result
+}
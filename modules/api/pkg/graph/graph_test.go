@@ -0,0 +1,293 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"sigs.k8s.io/yaml"
+)
+
+const testObjects = `
+- apiVersion: apps/v1
+  kind: Deployment
+  metadata: {name: web, namespace: default, uid: d1}
+- apiVersion: apps/v1
+  kind: ReplicaSet
+  metadata:
+    name: web-1
+    namespace: default
+    uid: r1
+    ownerReferences: [{apiVersion: apps/v1, kind: Deployment, name: web, uid: d1, controller: true}]
+- apiVersion: v1
+  kind: Pod
+  metadata:
+    name: web-1-a
+    namespace: default
+    labels: {app: web}
+    ownerReferences: [{apiVersion: apps/v1, kind: ReplicaSet, name: web-1, uid: r1, controller: true}]
+  spec:
+    serviceAccountName: web
+    volumes:
+    - {name: data, persistentVolumeClaim: {claimName: data}}
+    - {name: config, configMap: {name: web-config}}
+    containers:
+    - name: web
+      envFrom: [{secretRef: {name: missing}}]
+- apiVersion: v1
+  kind: Pod
+  metadata: {name: other, namespace: default, labels: {app: other}}
+- apiVersion: v1
+  kind: Service
+  metadata: {name: web, namespace: default}
+  spec: {selector: {app: web}}
+- apiVersion: v1
+  kind: Endpoints
+  metadata: {name: web, namespace: default}
+  subsets: [{addresses: [{ip: 10.0.0.1, targetRef: {kind: Pod, namespace: default, name: web-1-a}}]}]
+- apiVersion: networking.k8s.io/v1
+  kind: Ingress
+  metadata: {name: web, namespace: default}
+  spec:
+    rules: [{http: {paths: [{path: /, pathType: Prefix, backend: {service: {name: web, port: {number: 80}}}}]}}]
+- apiVersion: autoscaling/v2
+  kind: HorizontalPodAutoscaler
+  metadata: {name: web, namespace: default}
+  spec: {scaleTargetRef: {apiVersion: apps/v1, kind: Deployment, name: web}, maxReplicas: 3}
+- apiVersion: v1
+  kind: ConfigMap
+  metadata: {name: web-config, namespace: default}
+- apiVersion: v1
+  kind: ServiceAccount
+  metadata: {name: web, namespace: default}
+- apiVersion: v1
+  kind: PersistentVolumeClaim
+  metadata: {name: data, namespace: default}
+  spec: {volumeName: pv-1, storageClassName: standard}
+- apiVersion: v1
+  kind: PersistentVolume
+  metadata: {name: pv-1}
+  spec: {storageClassName: standard, claimRef: {namespace: default, name: data}}
+- apiVersion: storage.k8s.io/v1
+  kind: StorageClass
+  metadata: {name: standard}
+- apiVersion: rbac.authorization.k8s.io/v1
+  kind: RoleBinding
+  metadata: {name: web, namespace: default}
+  roleRef: {apiGroup: rbac.authorization.k8s.io, kind: ClusterRole, name: view}
+  subjects:
+  - {kind: ServiceAccount, name: web, namespace: default}
+  - {apiGroup: rbac.authorization.k8s.io, kind: User, name: jane}
+`
+
+func newTestClient(t *testing.T) *dynamicfake.FakeDynamicClient {
+	t.Helper()
+
+	items := make([]map[string]interface{}, 0)
+	if err := yaml.Unmarshal([]byte(testObjects), &items); err != nil {
+		t.Fatal(err)
+	}
+
+	objects := make([]runtime.Object, 0, len(items))
+	for _, item := range items {
+		objects = append(objects, &unstructured.Unstructured{Object: item})
+	}
+
+	listKinds := make(map[schema.GroupVersionResource]string)
+	for kind, gvr := range knownResources {
+		listKinds[gvr] = kind.Kind + "List"
+	}
+
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, objects...)
+}
+
+func newTestMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	for kind, gvr := range knownResources {
+		scope := meta.RESTScopeNamespace
+		if kind == persistentVolumeKind || kind == storageClassKind || kind == clusterRoleKind || kind == clusterRoleBindingKind {
+			scope = meta.RESTScopeRoot
+		}
+
+		mapper.Add(gvr.GroupVersion().WithKind(kind.Kind), scope)
+	}
+
+	return mapper
+}
+
+func getTestGraph(t *testing.T, kind, namespace, name string, depth int) *Graph {
+	t.Helper()
+
+	graph, err := getGraph(newTestClient(t), newTestMapper(), kind, namespace, name, depth)
+	if err != nil {
+		t.Fatalf("getGraph() returned error: %v", err)
+	}
+
+	return graph
+}
+
+func nodesOf(graph *Graph) map[string]Node {
+	result := make(map[string]Node)
+	for _, node := range graph.Nodes {
+		result[node.ID] = node
+	}
+
+	return result
+}
+
+func hasEdge(graph *Graph, from, to string, edgeType EdgeType) bool {
+	for _, edge := range graph.Edges {
+		if edge.From == from && edge.To == to && edge.Type == edgeType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestGetGraphPod(t *testing.T) {
+	graph := getTestGraph(t, "pod", "default", "web-1-a", 1)
+
+	if graph.Root != "Pod/default/web-1-a" {
+		t.Errorf("unexpected root %q", graph.Root)
+	}
+
+	expected := []Edge{
+		{From: "Pod/default/web-1-a", To: "ReplicaSet.apps/default/web-1", Type: EdgeTypeOwner},
+		{From: "Pod/default/web-1-a", To: "PersistentVolumeClaim/default/data", Type: EdgeTypeVolume},
+		{From: "Pod/default/web-1-a", To: "ConfigMap/default/web-config", Type: EdgeTypeVolume},
+		{From: "Pod/default/web-1-a", To: "Secret/default/missing", Type: EdgeTypeReference},
+		{From: "Pod/default/web-1-a", To: "ServiceAccount/default/web", Type: EdgeTypeReference},
+		{From: "Service/default/web", To: "Pod/default/web-1-a", Type: EdgeTypeSelector},
+	}
+
+	for _, edge := range expected {
+		if !hasEdge(graph, edge.From, edge.To, edge.Type) {
+			t.Errorf("expected edge %+v, got %+v", edge, graph.Edges)
+		}
+	}
+
+	if len(graph.Edges) != len(expected) || len(graph.Nodes) != len(expected)+1 {
+		t.Errorf("expected %d edges and %d nodes, got %+v", len(expected), len(expected)+1, graph)
+	}
+}
+
+func TestGetGraphDepth(t *testing.T) {
+	graph := getTestGraph(t, "deployment", "default", "web", 3)
+	nodes := nodesOf(graph)
+
+	expectedDepths := map[string]int{
+		"Deployment.apps/default/web":                     0,
+		"ReplicaSet.apps/default/web-1":                   1,
+		"HorizontalPodAutoscaler.autoscaling/default/web": 1,
+		"Pod/default/web-1-a":                             2,
+		"Service/default/web":                             3,
+		"PersistentVolumeClaim/default/data":              3,
+		"Secret/default/missing":                          3,
+		"ServiceAccount/default/web":                      3,
+	}
+
+	for id, depth := range expectedDepths {
+		if node, exists := nodes[id]; !exists || node.Depth != depth {
+			t.Errorf("expected node %s at depth %d, got %+v", id, depth, node)
+		}
+	}
+
+	if _, exists := nodes["PersistentVolume/pv-1"]; exists {
+		t.Error("nodes deeper than requested depth should not be returned")
+	}
+
+	if nodes["Secret/default/missing"].Missing {
+		t.Error("nodes at the requested depth should not be checked")
+	}
+
+	if !hasEdge(graph, "HorizontalPodAutoscaler.autoscaling/default/web", "Deployment.apps/default/web", EdgeTypeScaleTarget) {
+		t.Errorf("expected scale target edge, got %+v", graph.Edges)
+	}
+
+	if nodes["Deployment.apps/default/web"].APIVersion != "apps/v1" {
+		t.Errorf("expected API version of the root node, got %+v", nodes["Deployment.apps/default/web"])
+	}
+}
+
+func TestGetGraphRelations(t *testing.T) {
+	cases := []struct {
+		kind, namespace, name string
+		expected              []Edge
+	}{
+		{
+			"ingress", "default", "web",
+			[]Edge{
+				{From: "Ingress.networking.k8s.io/default/web", To: "Service/default/web", Type: EdgeTypeBackend},
+				{From: "Service/default/web", To: "Endpoints/default/web", Type: EdgeTypeEndpoints},
+				{From: "Service/default/web", To: "Pod/default/web-1-a", Type: EdgeTypeSelector},
+			},
+		},
+		{
+			"persistentvolumeclaim", "default", "data",
+			[]Edge{
+				{From: "PersistentVolumeClaim/default/data", To: "PersistentVolume/pv-1", Type: EdgeTypeVolume},
+				{From: "PersistentVolume/pv-1", To: "StorageClass.storage.k8s.io/standard", Type: EdgeTypeStorageClass},
+				{From: "Pod/default/web-1-a", To: "PersistentVolumeClaim/default/data", Type: EdgeTypeVolume},
+			},
+		},
+		{
+			"serviceaccount", "default", "web",
+			[]Edge{
+				{From: "RoleBinding.rbac.authorization.k8s.io/default/web", To: "ServiceAccount/default/web", Type: EdgeTypeSubject},
+				{From: "RoleBinding.rbac.authorization.k8s.io/default/web", To: "ClusterRole.rbac.authorization.k8s.io/view", Type: EdgeTypeRoleRef},
+				{From: "RoleBinding.rbac.authorization.k8s.io/default/web", To: "User.rbac.authorization.k8s.io/jane", Type: EdgeTypeSubject},
+				{From: "Pod/default/web-1-a", To: "ServiceAccount/default/web", Type: EdgeTypeReference},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		graph := getTestGraph(t, c.kind, c.namespace, c.name, 2)
+		for _, edge := range c.expected {
+			if !hasEdge(graph, edge.From, edge.To, edge.Type) {
+				t.Errorf("getGraph(%s) should have edge %+v, got %+v", c.kind, edge, graph.Edges)
+			}
+		}
+	}
+}
+
+func TestGetGraphMissing(t *testing.T) {
+	graph := getTestGraph(t, "pod", "default", "web-1-a", 2)
+	nodes := nodesOf(graph)
+
+	if !nodes["Secret/default/missing"].Missing || nodes["ConfigMap/default/web-config"].Missing {
+		t.Errorf("expected only the referenced secret to be missing, got %+v", graph.Nodes)
+	}
+}
+
+func TestGetGraphErrors(t *testing.T) {
+	if _, err := getGraph(newTestClient(t), newTestMapper(), "pod", "default", "web-1-a", MaxDepth+1); err == nil {
+		t.Error("getGraph() should fail for depth greater than maximum")
+	}
+
+	if _, err := getGraph(newTestClient(t), newTestMapper(), "pod", "default", "unknown", 1); err == nil {
+		t.Error("getGraph() should fail for missing root object")
+	}
+
+	if _, err := getGraph(newTestClient(t), newTestMapper(), "unknown", "default", "web", 1); err == nil {
+		t.Error("getGraph() should fail for unknown kind")
+	}
+}
@@ -0,0 +1,631 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"fmt"
+
+	autoscaling "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networking "k8s.io/api/networking/v1"
+	policy "k8s.io/api/policy/v1"
+	rbac "k8s.io/api/rbac/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Group kinds of objects with known relationships.
+var (
+	podKind                   = schema.GroupKind{Kind: "Pod"}
+	serviceKind               = schema.GroupKind{Kind: "Service"}
+	endpointsKind             = schema.GroupKind{Kind: "Endpoints"}
+	configMapKind             = schema.GroupKind{Kind: "ConfigMap"}
+	secretKind                = schema.GroupKind{Kind: "Secret"}
+	serviceAccountKind        = schema.GroupKind{Kind: "ServiceAccount"}
+	persistentVolumeClaimKind = schema.GroupKind{Kind: "PersistentVolumeClaim"}
+	persistentVolumeKind      = schema.GroupKind{Kind: "PersistentVolume"}
+	replicationControllerKind = schema.GroupKind{Kind: "ReplicationController"}
+	deploymentKind            = schema.GroupKind{Group: "apps", Kind: "Deployment"}
+	replicaSetKind            = schema.GroupKind{Group: "apps", Kind: "ReplicaSet"}
+	statefulSetKind           = schema.GroupKind{Group: "apps", Kind: "StatefulSet"}
+	daemonSetKind             = schema.GroupKind{Group: "apps", Kind: "DaemonSet"}
+	jobKind                   = schema.GroupKind{Group: "batch", Kind: "Job"}
+	cronJobKind               = schema.GroupKind{Group: "batch", Kind: "CronJob"}
+	ingressKind               = schema.GroupKind{Group: "networking.k8s.io", Kind: "Ingress"}
+	networkPolicyKind         = schema.GroupKind{Group: "networking.k8s.io", Kind: "NetworkPolicy"}
+	podDisruptionBudgetKind   = schema.GroupKind{Group: "policy", Kind: "PodDisruptionBudget"}
+	hpaKind                   = schema.GroupKind{Group: "autoscaling", Kind: "HorizontalPodAutoscaler"}
+	roleKind                  = schema.GroupKind{Group: rbac.GroupName, Kind: "Role"}
+	roleBindingKind           = schema.GroupKind{Group: rbac.GroupName, Kind: "RoleBinding"}
+	clusterRoleKind           = schema.GroupKind{Group: rbac.GroupName, Kind: "ClusterRole"}
+	clusterRoleBindingKind    = schema.GroupKind{Group: rbac.GroupName, Kind: "ClusterRoleBinding"}
+	storageClassKind          = schema.GroupKind{Group: "storage.k8s.io", Kind: "StorageClass"}
+	endpointSliceKind         = schema.GroupKind{Group: "discovery.k8s.io", Kind: "EndpointSlice"}
+)
+
+// knownResources maps group kinds with known relationships to resources used to get and list
+// them. Other kinds are resolved through the REST mapper.
+var knownResources = map[schema.GroupKind]schema.GroupVersionResource{
+	podKind:                   {Version: "v1", Resource: "pods"},
+	serviceKind:               {Version: "v1", Resource: "services"},
+	endpointsKind:             {Version: "v1", Resource: "endpoints"},
+	configMapKind:             {Version: "v1", Resource: "configmaps"},
+	secretKind:                {Version: "v1", Resource: "secrets"},
+	serviceAccountKind:        {Version: "v1", Resource: "serviceaccounts"},
+	persistentVolumeClaimKind: {Version: "v1", Resource: "persistentvolumeclaims"},
+	persistentVolumeKind:      {Version: "v1", Resource: "persistentvolumes"},
+	replicationControllerKind: {Version: "v1", Resource: "replicationcontrollers"},
+	deploymentKind:            {Group: "apps", Version: "v1", Resource: "deployments"},
+	replicaSetKind:            {Group: "apps", Version: "v1", Resource: "replicasets"},
+	statefulSetKind:           {Group: "apps", Version: "v1", Resource: "statefulsets"},
+	daemonSetKind:             {Group: "apps", Version: "v1", Resource: "daemonsets"},
+	jobKind:                   {Group: "batch", Version: "v1", Resource: "jobs"},
+	cronJobKind:               {Group: "batch", Version: "v1", Resource: "cronjobs"},
+	ingressKind:               {Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"},
+	networkPolicyKind:         {Group: "networking.k8s.io", Version: "v1", Resource: "networkpolicies"},
+	podDisruptionBudgetKind:   {Group: "policy", Version: "v1", Resource: "poddisruptionbudgets"},
+	hpaKind:                   {Group: "autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"},
+	roleKind:                  {Group: rbac.GroupName, Version: "v1", Resource: "roles"},
+	roleBindingKind:           {Group: rbac.GroupName, Version: "v1", Resource: "rolebindings"},
+	clusterRoleKind:           {Group: rbac.GroupName, Version: "v1", Resource: "clusterroles"},
+	clusterRoleBindingKind:    {Group: rbac.GroupName, Version: "v1", Resource: "clusterrolebindings"},
+	storageClassKind:          {Group: "storage.k8s.io", Version: "v1", Resource: "storageclasses"},
+	endpointSliceKind:         {Group: "discovery.k8s.io", Version: "v1", Resource: "endpointslices"},
+}
+
+// childKinds lists kinds of objects owned by controllers.
+var childKinds = map[schema.GroupKind][]schema.GroupKind{
+	deploymentKind:            {replicaSetKind},
+	replicaSetKind:            {podKind},
+	statefulSetKind:           {podKind},
+	daemonSetKind:             {podKind},
+	replicationControllerKind: {podKind},
+	jobKind:                   {podKind},
+	cronJobKind:               {jobKind},
+}
+
+// scalableKinds can be targeted by horizontal pod autoscalers.
+var scalableKinds = map[schema.GroupKind]bool{
+	deploymentKind:            true,
+	replicaSetKind:            true,
+	statefulSetKind:           true,
+	replicationControllerKind: true,
+}
+
+// isSubject returns true for RBAC users and groups, which are not API objects.
+func isSubject(ref objectRef) bool {
+	return ref.Group == rbac.GroupName && (ref.Kind == rbac.UserKind || ref.Kind == rbac.GroupKind)
+}
+
+// links returns relationships of the object.
+func (in *builder) links(object *unstructured.Unstructured) []link {
+	result := ownerLinks(object)
+	result = append(result, in.childLinks(object)...)
+
+	kind := object.GroupVersionKind().GroupKind()
+	if scalableKinds[kind] {
+		result = append(result, in.scaleTargetLinks(object)...)
+	}
+
+	var err error
+	switch kind {
+	case podKind:
+		result, err = withLinks(result, object, in.podLinks)
+	case serviceKind:
+		result, err = withLinks(result, object, in.serviceLinks)
+	case endpointsKind:
+		result, err = withLinks(result, object, in.endpointsLinks)
+	case endpointSliceKind:
+		result, err = withLinks(result, object, in.endpointSliceLinks)
+	case ingressKind:
+		result, err = withLinks(result, object, ingressLinks)
+	case networkPolicyKind:
+		result, err = withLinks(result, object, in.networkPolicyLinks)
+	case podDisruptionBudgetKind:
+		result, err = withLinks(result, object, in.podDisruptionBudgetLinks)
+	case hpaKind:
+		result, err = withLinks(result, object, hpaLinks)
+	case persistentVolumeClaimKind:
+		result, err = withLinks(result, object, in.persistentVolumeClaimLinks)
+	case persistentVolumeKind:
+		result, err = withLinks(result, object, persistentVolumeLinks)
+	case storageClassKind:
+		result = append(result, in.storageClassLinks(object)...)
+	case configMapKind, secretKind:
+		result = append(result, in.podReferenceLinks(object)...)
+	case serviceAccountKind:
+		result = append(result, in.podReferenceLinks(object)...)
+		result = append(result, in.subjectLinks(object)...)
+	case roleBindingKind:
+		result, err = withLinks(result, object, roleBindingLinks)
+	case clusterRoleBindingKind:
+		result, err = withLinks(result, object, clusterRoleBindingLinks)
+	case roleKind, clusterRoleKind:
+		result = append(result, in.roleLinks(object)...)
+	}
+
+	if err != nil {
+		in.addError(fmt.Errorf("%s: %w", refOf(object).id(), err))
+	}
+
+	return result
+}
+
+// withLinks converts the object to the typed object expected by fn and appends links it returns.
+func withLinks[T any](result []link, object *unstructured.Unstructured, fn func(*T) []link) ([]link, error) {
+	typed := new(T)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, typed); err != nil {
+		return result, err
+	}
+
+	return append(result, fn(typed)...), nil
+}
+
+func ownerLinks(object *unstructured.Unstructured) []link {
+	result := make([]link, 0)
+	for _, owner := range object.GetOwnerReferences() {
+		gv, err := schema.ParseGroupVersion(owner.APIVersion)
+		if err != nil {
+			continue
+		}
+
+		ref := newObjectRef(gv.WithKind(owner.Kind).GroupKind(), object.GetNamespace(), owner.Name)
+		ref.Version = gv.Version
+		result = append(result, link{ref: ref, edgeType: EdgeTypeOwner})
+	}
+
+	return result
+}
+
+// childLinks returns objects owned by the controller.
+func (in *builder) childLinks(object *unstructured.Unstructured) []link {
+	result := make([]link, 0)
+	for _, childKind := range childKinds[object.GroupVersionKind().GroupKind()] {
+		for _, child := range in.list(knownResources[childKind], object.GetNamespace()) {
+			for _, owner := range child.GetOwnerReferences() {
+				if owner.UID == object.GetUID() {
+					ref := newObjectRef(childKind, child.GetNamespace(), child.GetName())
+					result = append(result, link{ref: ref, edgeType: EdgeTypeOwner, incoming: true})
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// scaleTargetLinks returns horizontal pod autoscalers targeting the object.
+func (in *builder) scaleTargetLinks(object *unstructured.Unstructured) []link {
+	result := make([]link, 0)
+	for _, hpa := range listTyped[autoscaling.HorizontalPodAutoscaler](in, knownResources[hpaKind], object.GetNamespace()) {
+		target := hpa.Spec.ScaleTargetRef
+		gv, _ := schema.ParseGroupVersion(target.APIVersion)
+		if target.Name == object.GetName() && gv.WithKind(target.Kind).GroupKind() == object.GroupVersionKind().GroupKind() {
+			ref := newObjectRef(hpaKind, hpa.Namespace, hpa.Name)
+			result = append(result, link{ref: ref, edgeType: EdgeTypeScaleTarget, incoming: true})
+		}
+	}
+
+	return result
+}
+
+func (in *builder) podLinks(pod *v1.Pod) []link {
+	result := podSpecLinks(pod.Namespace, &pod.Spec)
+	podLabels := labels.Set(pod.Labels)
+
+	for _, service := range listTyped[v1.Service](in, knownResources[serviceKind], pod.Namespace) {
+		if len(service.Spec.Selector) > 0 && labels.SelectorFromSet(service.Spec.Selector).Matches(podLabels) {
+			ref := newObjectRef(serviceKind, service.Namespace, service.Name)
+			result = append(result, link{ref: ref, edgeType: EdgeTypeSelector, incoming: true})
+		}
+	}
+
+	for _, networkPolicy := range listTyped[networking.NetworkPolicy](in, knownResources[networkPolicyKind], pod.Namespace) {
+		if matches(&networkPolicy.Spec.PodSelector, podLabels) {
+			ref := newObjectRef(networkPolicyKind, networkPolicy.Namespace, networkPolicy.Name)
+			result = append(result, link{ref: ref, edgeType: EdgeTypeSelector, incoming: true})
+		}
+	}
+
+	for _, pdb := range listTyped[policy.PodDisruptionBudget](in, knownResources[podDisruptionBudgetKind], pod.Namespace) {
+		if pdb.Spec.Selector != nil && matches(pdb.Spec.Selector, podLabels) {
+			ref := newObjectRef(podDisruptionBudgetKind, pdb.Namespace, pdb.Name)
+			result = append(result, link{ref: ref, edgeType: EdgeTypeSelector, incoming: true})
+		}
+	}
+
+	return result
+}
+
+// podSpecLinks returns volumes, config maps, secrets and the service account used by the pod.
+func podSpecLinks(namespace string, spec *v1.PodSpec) []link {
+	result := make([]link, 0)
+	add := func(kind schema.GroupKind, name string, edgeType EdgeType) {
+		if len(name) > 0 {
+			result = append(result, link{ref: newObjectRef(kind, namespace, name), edgeType: edgeType})
+		}
+	}
+
+	for _, volume := range spec.Volumes {
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			add(persistentVolumeClaimKind, volume.PersistentVolumeClaim.ClaimName, EdgeTypeVolume)
+		case volume.ConfigMap != nil:
+			add(configMapKind, volume.ConfigMap.Name, EdgeTypeVolume)
+		case volume.Secret != nil:
+			add(secretKind, volume.Secret.SecretName, EdgeTypeVolume)
+		case volume.Projected != nil:
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil {
+					add(configMapKind, source.ConfigMap.Name, EdgeTypeVolume)
+				}
+				if source.Secret != nil {
+					add(secretKind, source.Secret.Name, EdgeTypeVolume)
+				}
+			}
+		}
+	}
+
+	containers := append(append([]v1.Container{}, spec.InitContainers...), spec.Containers...)
+	for _, container := range spec.EphemeralContainers {
+		containers = append(containers, v1.Container(container.EphemeralContainerCommon))
+	}
+
+	for _, container := range containers {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef != nil {
+				add(configMapKind, envFrom.ConfigMapRef.Name, EdgeTypeReference)
+			}
+			if envFrom.SecretRef != nil {
+				add(secretKind, envFrom.SecretRef.Name, EdgeTypeReference)
+			}
+		}
+
+		for _, env := range container.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if env.ValueFrom.ConfigMapKeyRef != nil {
+				add(configMapKind, env.ValueFrom.ConfigMapKeyRef.Name, EdgeTypeReference)
+			}
+			if env.ValueFrom.SecretKeyRef != nil {
+				add(secretKind, env.ValueFrom.SecretKeyRef.Name, EdgeTypeReference)
+			}
+		}
+	}
+
+	for _, secret := range spec.ImagePullSecrets {
+		add(secretKind, secret.Name, EdgeTypeReference)
+	}
+
+	add(serviceAccountKind, spec.ServiceAccountName, EdgeTypeReference)
+	return result
+}
+
+// podReferenceLinks returns pods using the config map, secret or service account.
+func (in *builder) podReferenceLinks(object *unstructured.Unstructured) []link {
+	target := refOf(object)
+	target.Version = ""
+
+	result := make([]link, 0)
+	for _, pod := range listTyped[v1.Pod](in, knownResources[podKind], object.GetNamespace()) {
+		for _, l := range podSpecLinks(pod.Namespace, &pod.Spec) {
+			if l.ref == target {
+				ref := newObjectRef(podKind, pod.Namespace, pod.Name)
+				result = append(result, link{ref: ref, edgeType: l.edgeType, incoming: true})
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+func (in *builder) serviceLinks(service *v1.Service) []link {
+	result := make([]link, 0)
+	if len(service.Spec.Selector) > 0 {
+		selector := labels.SelectorFromSet(service.Spec.Selector)
+		for _, pod := range in.list(knownResources[podKind], service.Namespace) {
+			if selector.Matches(labels.Set(pod.GetLabels())) {
+				result = append(result, link{ref: newObjectRef(podKind, pod.GetNamespace(), pod.GetName()), edgeType: EdgeTypeSelector})
+			}
+		}
+	}
+
+	for _, endpoints := range in.list(knownResources[endpointsKind], service.Namespace) {
+		if endpoints.GetName() == service.Name {
+			ref := newObjectRef(endpointsKind, service.Namespace, service.Name)
+			result = append(result, link{ref: ref, edgeType: EdgeTypeEndpoints})
+		}
+	}
+
+	for _, slice := range in.list(knownResources[endpointSliceKind], service.Namespace) {
+		if slice.GetLabels()[discoveryv1.LabelServiceName] == service.Name {
+			ref := newObjectRef(endpointSliceKind, slice.GetNamespace(), slice.GetName())
+			result = append(result, link{ref: ref, edgeType: EdgeTypeEndpoints})
+		}
+	}
+
+	for _, ingress := range listTyped[networking.Ingress](in, knownResources[ingressKind], service.Namespace) {
+		for _, l := range ingressLinks(ingress) {
+			if l.ref == newObjectRef(serviceKind, service.Namespace, service.Name) {
+				ref := newObjectRef(ingressKind, ingress.Namespace, ingress.Name)
+				result = append(result, link{ref: ref, edgeType: EdgeTypeBackend, incoming: true})
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+func (in *builder) endpointsLinks(endpoints *v1.Endpoints) []link {
+	result := make([]link, 0)
+	for _, service := range in.list(knownResources[serviceKind], endpoints.Namespace) {
+		if service.GetName() == endpoints.Name {
+			ref := newObjectRef(serviceKind, endpoints.Namespace, endpoints.Name)
+			result = append(result, link{ref: ref, edgeType: EdgeTypeEndpoints, incoming: true})
+		}
+	}
+
+	for _, subset := range endpoints.Subsets {
+		for _, address := range append(append([]v1.EndpointAddress{}, subset.Addresses...), subset.NotReadyAddresses...) {
+			result = append(result, targetLinks(address.TargetRef)...)
+		}
+	}
+
+	return result
+}
+
+func (in *builder) endpointSliceLinks(slice *discoveryv1.EndpointSlice) []link {
+	result := make([]link, 0)
+	if name := slice.Labels[discoveryv1.LabelServiceName]; len(name) > 0 {
+		ref := newObjectRef(serviceKind, slice.Namespace, name)
+		result = append(result, link{ref: ref, edgeType: EdgeTypeEndpoints, incoming: true})
+	}
+
+	for _, endpoint := range slice.Endpoints {
+		result = append(result, targetLinks(endpoint.TargetRef)...)
+	}
+
+	return result
+}
+
+// targetLinks returns pods backing endpoints.
+func targetLinks(target *v1.ObjectReference) []link {
+	if target == nil || target.Kind != podKind.Kind {
+		return nil
+	}
+
+	return []link{{ref: newObjectRef(podKind, target.Namespace, target.Name), edgeType: EdgeTypeEndpoints}}
+}
+
+func ingressLinks(ingress *networking.Ingress) []link {
+	result := make([]link, 0)
+	addBackend := func(backend *networking.IngressBackend) {
+		if backend != nil && backend.Service != nil {
+			ref := newObjectRef(serviceKind, ingress.Namespace, backend.Service.Name)
+			result = append(result, link{ref: ref, edgeType: EdgeTypeBackend})
+		}
+	}
+
+	addBackend(ingress.Spec.DefaultBackend)
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+
+		for i := range rule.HTTP.Paths {
+			addBackend(&rule.HTTP.Paths[i].Backend)
+		}
+	}
+
+	for _, tls := range ingress.Spec.TLS {
+		if len(tls.SecretName) > 0 {
+			ref := newObjectRef(secretKind, ingress.Namespace, tls.SecretName)
+			result = append(result, link{ref: ref, edgeType: EdgeTypeReference})
+		}
+	}
+
+	return result
+}
+
+func (in *builder) networkPolicyLinks(networkPolicy *networking.NetworkPolicy) []link {
+	return in.selectedPodLinks(networkPolicy.Namespace, &networkPolicy.Spec.PodSelector)
+}
+
+func (in *builder) podDisruptionBudgetLinks(pdb *policy.PodDisruptionBudget) []link {
+	if pdb.Spec.Selector == nil {
+		return nil
+	}
+
+	return in.selectedPodLinks(pdb.Namespace, pdb.Spec.Selector)
+}
+
+func (in *builder) selectedPodLinks(namespace string, selector *metaV1.LabelSelector) []link {
+	result := make([]link, 0)
+	for _, pod := range in.list(knownResources[podKind], namespace) {
+		if matches(selector, labels.Set(pod.GetLabels())) {
+			result = append(result, link{ref: newObjectRef(podKind, pod.GetNamespace(), pod.GetName()), edgeType: EdgeTypeSelector})
+		}
+	}
+
+	return result
+}
+
+func hpaLinks(hpa *autoscaling.HorizontalPodAutoscaler) []link {
+	target := hpa.Spec.ScaleTargetRef
+	gv, err := schema.ParseGroupVersion(target.APIVersion)
+	if err != nil {
+		return nil
+	}
+
+	ref := newObjectRef(gv.WithKind(target.Kind).GroupKind(), hpa.Namespace, target.Name)
+	ref.Version = gv.Version
+	return []link{{ref: ref, edgeType: EdgeTypeScaleTarget}}
+}
+
+func (in *builder) persistentVolumeClaimLinks(claim *v1.PersistentVolumeClaim) []link {
+	result := make([]link, 0)
+	if len(claim.Spec.VolumeName) > 0 {
+		ref := newObjectRef(persistentVolumeKind, "", claim.Spec.VolumeName)
+		result = append(result, link{ref: ref, edgeType: EdgeTypeVolume})
+	}
+
+	if claim.Spec.StorageClassName != nil && len(*claim.Spec.StorageClassName) > 0 {
+		ref := newObjectRef(storageClassKind, "", *claim.Spec.StorageClassName)
+		result = append(result, link{ref: ref, edgeType: EdgeTypeStorageClass})
+	}
+
+	for _, pod := range listTyped[v1.Pod](in, knownResources[podKind], claim.Namespace) {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == claim.Name {
+				ref := newObjectRef(podKind, pod.Namespace, pod.Name)
+				result = append(result, link{ref: ref, edgeType: EdgeTypeVolume, incoming: true})
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+func persistentVolumeLinks(volume *v1.PersistentVolume) []link {
+	result := make([]link, 0)
+	if claim := volume.Spec.ClaimRef; claim != nil {
+		ref := newObjectRef(persistentVolumeClaimKind, claim.Namespace, claim.Name)
+		result = append(result, link{ref: ref, edgeType: EdgeTypeVolume, incoming: true})
+	}
+
+	if len(volume.Spec.StorageClassName) > 0 {
+		ref := newObjectRef(storageClassKind, "", volume.Spec.StorageClassName)
+		result = append(result, link{ref: ref, edgeType: EdgeTypeStorageClass})
+	}
+
+	return result
+}
+
+// storageClassLinks returns persistent volumes of the storage class.
+func (in *builder) storageClassLinks(object *unstructured.Unstructured) []link {
+	result := make([]link, 0)
+	for _, volume := range listTyped[v1.PersistentVolume](in, knownResources[persistentVolumeKind], "") {
+		if volume.Spec.StorageClassName == object.GetName() {
+			ref := newObjectRef(persistentVolumeKind, "", volume.Name)
+			result = append(result, link{ref: ref, edgeType: EdgeTypeStorageClass, incoming: true})
+		}
+	}
+
+	return result
+}
+
+func roleBindingLinks(binding *rbac.RoleBinding) []link {
+	return bindingLinks(binding.Namespace, binding.RoleRef, binding.Subjects)
+}
+
+func clusterRoleBindingLinks(binding *rbac.ClusterRoleBinding) []link {
+	return bindingLinks("", binding.RoleRef, binding.Subjects)
+}
+
+func bindingLinks(namespace string, roleRef rbac.RoleRef, subjects []rbac.Subject) []link {
+	roleNamespace := namespace
+	if roleRef.Kind == clusterRoleKind.Kind {
+		roleNamespace = ""
+	}
+
+	result := []link{{
+		ref:      newObjectRef(schema.GroupKind{Group: roleRef.APIGroup, Kind: roleRef.Kind}, roleNamespace, roleRef.Name),
+		edgeType: EdgeTypeRoleRef,
+	}}
+
+	for _, subject := range subjects {
+		var ref objectRef
+		if subject.Kind == rbac.ServiceAccountKind {
+			ref = newObjectRef(serviceAccountKind, subject.Namespace, subject.Name)
+		} else {
+			ref = newObjectRef(schema.GroupKind{Group: rbac.GroupName, Kind: subject.Kind}, "", subject.Name)
+		}
+
+		result = append(result, link{ref: ref, edgeType: EdgeTypeSubject})
+	}
+
+	return result
+}
+
+// subjectLinks returns role bindings and cluster role bindings of the service account.
+func (in *builder) subjectLinks(object *unstructured.Unstructured) []link {
+	target := newObjectRef(serviceAccountKind, object.GetNamespace(), object.GetName())
+	result := make([]link, 0)
+	for _, binding := range listTyped[rbac.RoleBinding](in, knownResources[roleBindingKind], object.GetNamespace()) {
+		if hasLink(roleBindingLinks(binding), target) {
+			ref := newObjectRef(roleBindingKind, binding.Namespace, binding.Name)
+			result = append(result, link{ref: ref, edgeType: EdgeTypeSubject, incoming: true})
+		}
+	}
+
+	for _, binding := range listTyped[rbac.ClusterRoleBinding](in, knownResources[clusterRoleBindingKind], "") {
+		if hasLink(clusterRoleBindingLinks(binding), target) {
+			ref := newObjectRef(clusterRoleBindingKind, "", binding.Name)
+			result = append(result, link{ref: ref, edgeType: EdgeTypeSubject, incoming: true})
+		}
+	}
+
+	return result
+}
+
+// roleLinks returns bindings of the role. Cluster roles can be bound in all namespaces.
+func (in *builder) roleLinks(object *unstructured.Unstructured) []link {
+	target := refOf(object)
+	target.Version = ""
+
+	result := make([]link, 0)
+	for _, binding := range listTyped[rbac.RoleBinding](in, knownResources[roleBindingKind], object.GetNamespace()) {
+		if hasLink(roleBindingLinks(binding), target) {
+			ref := newObjectRef(roleBindingKind, binding.Namespace, binding.Name)
+			result = append(result, link{ref: ref, edgeType: EdgeTypeRoleRef, incoming: true})
+		}
+	}
+
+	if target.GroupKind != clusterRoleKind {
+		return result
+	}
+
+	for _, binding := range listTyped[rbac.ClusterRoleBinding](in, knownResources[clusterRoleBindingKind], "") {
+		if hasLink(clusterRoleBindingLinks(binding), target) {
+			ref := newObjectRef(clusterRoleBindingKind, "", binding.Name)
+			result = append(result, link{ref: ref, edgeType: EdgeTypeRoleRef, incoming: true})
+		}
+	}
+
+	return result
+}
+
+func hasLink(links []link, target objectRef) bool {
+	for _, l := range links {
+		if l.ref == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matches(selector *metaV1.LabelSelector, set labels.Set) bool {
+	s, err := metaV1.LabelSelectorAsSelector(selector)
+	return err == nil && s.Matches(set)
+}
@@ -15,21 +15,33 @@
 package handler
 
 import (
+	"encoding/json"
 	"io"
+	"mime"
 	"net/http"
 	"strconv"
 	"strings"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/klog/v2"
 
 	"github.com/emicklei/go-restful/v3"
 	"golang.org/x/net/xsrftoken"
 	"k8s.io/client-go/tools/remotecommand"
 
+	"k8s.io/dashboard/api/pkg/args"
+	"k8s.io/dashboard/api/pkg/bulk"
+	"k8s.io/dashboard/api/pkg/drift"
+	"k8s.io/dashboard/api/pkg/export"
+	"k8s.io/dashboard/api/pkg/graph"
 	"k8s.io/dashboard/api/pkg/handler/parser"
 	"k8s.io/dashboard/api/pkg/integration"
+	"k8s.io/dashboard/api/pkg/ownership"
+	"k8s.io/dashboard/api/pkg/resource/admission"
 	"k8s.io/dashboard/api/pkg/resource/clusterrole"
 	"k8s.io/dashboard/api/pkg/resource/clusterrolebinding"
 	"k8s.io/dashboard/api/pkg/resource/common"
@@ -37,38 +49,52 @@ import (
 	"k8s.io/dashboard/api/pkg/resource/container"
 	"k8s.io/dashboard/api/pkg/resource/controller"
 	"k8s.io/dashboard/api/pkg/resource/cronjob"
+	"k8s.io/dashboard/api/pkg/resource/csidriver"
+	"k8s.io/dashboard/api/pkg/resource/csinode"
 	"k8s.io/dashboard/api/pkg/resource/customresourcedefinition"
 	"k8s.io/dashboard/api/pkg/resource/customresourcedefinition/types"
 	"k8s.io/dashboard/api/pkg/resource/daemonset"
 	"k8s.io/dashboard/api/pkg/resource/dataselect"
 	"k8s.io/dashboard/api/pkg/resource/deployment"
 	"k8s.io/dashboard/api/pkg/resource/event"
+	"k8s.io/dashboard/api/pkg/resource/gateway"
+	"k8s.io/dashboard/api/pkg/resource/helmrelease"
 	"k8s.io/dashboard/api/pkg/resource/horizontalpodautoscaler"
 	"k8s.io/dashboard/api/pkg/resource/ingress"
 	"k8s.io/dashboard/api/pkg/resource/ingressclass"
 	"k8s.io/dashboard/api/pkg/resource/job"
+	"k8s.io/dashboard/api/pkg/resource/lease"
+	"k8s.io/dashboard/api/pkg/resource/limitrange"
 	"k8s.io/dashboard/api/pkg/resource/logs"
 	ns "k8s.io/dashboard/api/pkg/resource/namespace"
 	"k8s.io/dashboard/api/pkg/resource/networkpolicy"
 	"k8s.io/dashboard/api/pkg/resource/node"
+	"k8s.io/dashboard/api/pkg/resource/overview"
 	"k8s.io/dashboard/api/pkg/resource/persistentvolume"
 	"k8s.io/dashboard/api/pkg/resource/persistentvolumeclaim"
 	"k8s.io/dashboard/api/pkg/resource/pod"
 	"k8s.io/dashboard/api/pkg/resource/poddisruptionbudget"
+	"k8s.io/dashboard/api/pkg/resource/priorityclass"
 	"k8s.io/dashboard/api/pkg/resource/replicaset"
 	"k8s.io/dashboard/api/pkg/resource/replicationcontroller"
+	"k8s.io/dashboard/api/pkg/resource/resourcequota"
 	"k8s.io/dashboard/api/pkg/resource/role"
 	"k8s.io/dashboard/api/pkg/resource/rolebinding"
+	"k8s.io/dashboard/api/pkg/resource/runtimeclass"
 	"k8s.io/dashboard/api/pkg/resource/secret"
 	"k8s.io/dashboard/api/pkg/resource/service"
 	"k8s.io/dashboard/api/pkg/resource/serviceaccount"
 	"k8s.io/dashboard/api/pkg/resource/statefulset"
 	"k8s.io/dashboard/api/pkg/resource/storageclass"
+	"k8s.io/dashboard/api/pkg/resource/volumeattachment"
+	"k8s.io/dashboard/api/pkg/resource/volumesnapshot"
 	"k8s.io/dashboard/api/pkg/scaling"
+	"k8s.io/dashboard/api/pkg/search"
 	"k8s.io/dashboard/api/pkg/validation"
 	"k8s.io/dashboard/client"
 	"k8s.io/dashboard/csrf"
 	"k8s.io/dashboard/errors"
+	commontypes "k8s.io/dashboard/types"
 )
 
 const (
@@ -79,6 +105,22 @@ const (
 	ResponseLogString = "Outgoing response to %s with %d status code"
 )
 
+// coreGroupPathParameter is the group path parameter used to address resources of the core group.
+const coreGroupPathParameter = "core"
+
+// exportYAMLContentType is the content type of resources exported as YAML.
+const exportYAMLContentType = "application/yaml"
+
+// bundleOctetStreamContentType is accepted next to gzip for uploaded namespace bundles.
+const bundleOctetStreamContentType = "application/octet-stream"
+
+// patchContentTypes are the content types accepted by the generic resource patch endpoints.
+var patchContentTypes = []string{
+	string(k8stypes.JSONPatchType),
+	string(k8stypes.MergePatchType),
+	string(k8stypes.StrategicMergePatchType),
+}
+
 // APIHandler is a representation of API handler. Structure contains clientapi and clientapi configuration.
 type APIHandler struct {
 	iManager integration.Manager
@@ -326,6 +368,24 @@ func CreateHTTPAPIHandler(iManager integration.Manager) (*restful.Container, err
 			Param(apiV1Ws.PathParameter("pod", "name of the Pod")).
 			Writes(persistentvolumeclaim.PersistentVolumeClaimList{}).
 			Returns(http.StatusOK, "OK", persistentvolumeclaim.PersistentVolumeClaimList{}))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/pod/{namespace}/{pod}/evict").To(apiHandler.handleEvictPod).
+			// docs
+			Doc("evicts Pod honoring PodDisruptionBudgets, responds with 429 and blocking budgets when refused").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the Pod")).
+			Param(apiV1Ws.PathParameter("pod", "name of the Pod")).
+			Reads(poddisruptionbudget.EvictionSpec{}).
+			Writes(poddisruptionbudget.EvictionResult{}).
+			Returns(http.StatusOK, "OK", poddisruptionbudget.EvictionResult{}).
+			Returns(http.StatusTooManyRequests, "Too Many Requests", poddisruptionbudget.EvictionResult{}))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/pod/{namespace}/evict").To(apiHandler.handleEvictPods).
+			// docs
+			Doc("evicts all Pods in a namespace matching the label selector, retrying evictions blocked by PodDisruptionBudgets").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the Pods")).
+			Reads(poddisruptionbudget.BulkEvictionSpec{}).
+			Writes(poddisruptionbudget.BulkEvictionResult{}).
+			Returns(http.StatusOK, "OK", poddisruptionbudget.BulkEvictionResult{}))
 
 	// Deployment
 	apiV1Ws.Route(
@@ -494,6 +554,23 @@ func CreateHTTPAPIHandler(iManager integration.Manager) (*restful.Container, err
 			Param(apiV1Ws.PathParameter("horizontalpodautoscaler", "name of the HorizontalPodAutoscaler")).
 			Writes(horizontalpodautoscaler.HorizontalPodAutoscalerDetail{}).
 			Returns(http.StatusOK, "OK", horizontalpodautoscaler.HorizontalPodAutoscalerDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/horizontalpodautoscaler/{namespace}").To(apiHandler.handleCreateHorizontalPodAutoscaler).
+			// docs
+			Doc("creates a HorizontalPodAutoscaler for any workload supporting the scale subresource").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the HorizontalPodAutoscaler")).
+			Reads(horizontalpodautoscaler.HorizontalPodAutoscalerSpec{}).
+			Writes(horizontalpodautoscaler.HorizontalPodAutoscalerDetail{}).
+			Returns(http.StatusCreated, "Created", horizontalpodautoscaler.HorizontalPodAutoscalerDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.PUT("/horizontalpodautoscaler/{namespace}/{horizontalpodautoscaler}").To(apiHandler.handleUpdateHorizontalPodAutoscaler).
+			// docs
+			Doc("updates scale target, replica bounds, metrics and behavior of HorizontalPodAutoscaler").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the HorizontalPodAutoscaler")).
+			Param(apiV1Ws.PathParameter("horizontalpodautoscaler", "name of the HorizontalPodAutoscaler")).
+			Reads(horizontalpodautoscaler.HorizontalPodAutoscalerSpec{}).
+			Writes(horizontalpodautoscaler.HorizontalPodAutoscalerDetail{}).
+			Returns(http.StatusOK, "OK", horizontalpodautoscaler.HorizontalPodAutoscalerDetail{}))
 
 	// Job
 	apiV1Ws.Route(
@@ -533,6 +610,23 @@ func CreateHTTPAPIHandler(iManager integration.Manager) (*restful.Container, err
 			Param(apiV1Ws.PathParameter("name", "name of the Job")).
 			Writes(common.EventList{}).
 			Returns(http.StatusOK, "OK", common.EventList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/job/{namespace}/{name}/index").To(apiHandler.handleGetJobIndexes).
+			// docs
+			Doc("returns statuses of completion indexes with their Pods for Indexed Job").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the Job")).
+			Param(apiV1Ws.PathParameter("name", "name of the Job")).
+			Writes(job.JobIndexList{}).
+			Returns(http.StatusOK, "OK", job.JobIndexList{}))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/job/{namespace}/{name}/retry").To(apiHandler.handleRetryJob).
+			// docs
+			Doc("runs Job again by creating its copy").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the Job")).
+			Param(apiV1Ws.PathParameter("name", "name of the Job")).
+			Reads(job.JobRetrySpec{}).
+			Writes(job.JobDetail{}).
+			Returns(http.StatusCreated, "Created", job.JobDetail{}))
 
 	// CronJob
 	apiV1Ws.Route(
@@ -554,6 +648,7 @@ func CreateHTTPAPIHandler(iManager integration.Manager) (*restful.Container, err
 			Doc("returns detailed information about CronJob").
 			Param(apiV1Ws.PathParameter("namespace", "namespace of the CronJob")).
 			Param(apiV1Ws.PathParameter("name", "name of the CronJob")).
+			Param(apiV1Ws.QueryParameter("runs", "number of next run times to compute (default: 5, max: 100)")).
 			Writes(cronjob.CronJobDetail{}).
 			Returns(http.StatusOK, "OK", cronjob.CronJobDetail{}))
 	apiV1Ws.Route(
@@ -580,6 +675,22 @@ func CreateHTTPAPIHandler(iManager integration.Manager) (*restful.Container, err
 			Param(apiV1Ws.PathParameter("namespace", "namespace of the CronJob")).
 			Param(apiV1Ws.PathParameter("name", "name of the CronJob")).
 			Returns(http.StatusOK, "OK", nil))
+	apiV1Ws.Route(
+		apiV1Ws.PUT("/cronjob/{namespace}/{name}/suspend").To(apiHandler.handleSuspendCronJob).
+			// docs
+			Doc("suspends scheduling of Jobs by CronJob").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the CronJob")).
+			Param(apiV1Ws.PathParameter("name", "name of the CronJob")).
+			Writes(cronjob.CronJobDetail{}).
+			Returns(http.StatusOK, "OK", cronjob.CronJobDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.PUT("/cronjob/{namespace}/{name}/resume").To(apiHandler.handleResumeCronJob).
+			// docs
+			Doc("resumes scheduling of Jobs by suspended CronJob").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the CronJob")).
+			Param(apiV1Ws.PathParameter("name", "name of the CronJob")).
+			Writes(cronjob.CronJobDetail{}).
+			Returns(http.StatusOK, "OK", cronjob.CronJobDetail{}))
 
 	// Namespace
 	apiV1Ws.Route(
@@ -655,6 +766,55 @@ func CreateHTTPAPIHandler(iManager integration.Manager) (*restful.Container, err
 			Writes(secret.Secret{}).
 			Returns(http.StatusOK, "OK", secret.Secret{}))
 
+	// Helm release
+	apiV1Ws.Route(
+		apiV1Ws.GET("/helmrelease").To(apiHandler.handleGetHelmReleaseList).
+			// docs
+			Doc("returns a list of Helm releases from all namespaces").
+			Writes(helmrelease.ReleaseList{}).
+			Returns(http.StatusOK, "OK", helmrelease.ReleaseList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/helmrelease/{namespace}").To(apiHandler.handleGetHelmReleaseList).
+			// docs
+			Doc("returns a list of Helm releases in a namespace").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the Helm release")).
+			Writes(helmrelease.ReleaseList{}).
+			Returns(http.StatusOK, "OK", helmrelease.ReleaseList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/helmrelease/{namespace}/{name}").To(apiHandler.handleGetHelmReleaseDetail).
+			// docs
+			Doc("returns detailed information about the latest revision of Helm release").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the Helm release")).
+			Param(apiV1Ws.PathParameter("name", "name of the Helm release")).
+			Writes(helmrelease.ReleaseDetail{}).
+			Returns(http.StatusOK, "OK", helmrelease.ReleaseDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/helmrelease/{namespace}/{name}/revision/{revision}").To(apiHandler.handleGetHelmReleaseDetail).
+			// docs
+			Doc("returns detailed information about a revision of Helm release").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the Helm release")).
+			Param(apiV1Ws.PathParameter("name", "name of the Helm release")).
+			Param(apiV1Ws.PathParameter("revision", "revision number of the Helm release")).
+			Writes(helmrelease.ReleaseDetail{}).
+			Returns(http.StatusOK, "OK", helmrelease.ReleaseDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.PUT("/helmrelease/{namespace}/{name}/rollback").To(apiHandler.handleHelmReleaseRollback).
+			// docs
+			Doc("rolls back the Helm release to the target revision").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the Helm release")).
+			Param(apiV1Ws.PathParameter("name", "name of the Helm release")).
+			Reads(helmrelease.RollbackSpec{}).
+			Writes(helmrelease.ReleaseDetail{}).
+			Returns(http.StatusOK, "OK", helmrelease.ReleaseDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.DELETE("/helmrelease/{namespace}/{name}").To(apiHandler.handleHelmReleaseUninstall).
+			// docs
+			Doc("uninstalls the Helm release").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the Helm release")).
+			Param(apiV1Ws.PathParameter("name", "name of the Helm release")).
+			Writes(helmrelease.UninstallResult{}).
+			Returns(http.StatusOK, "OK", helmrelease.UninstallResult{}))
+
 	// ConfigMap
 	apiV1Ws.Route(
 		apiV1Ws.GET("/configmap").To(apiHandler.handleGetConfigMapList).
@@ -724,6 +884,14 @@ func CreateHTTPAPIHandler(iManager integration.Manager) (*restful.Container, err
 			Param(apiV1Ws.PathParameter("service", "name of the Service")).
 			Writes(ingress.IngressList{}).
 			Returns(http.StatusOK, "OK", ingress.IngressList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/service/{namespace}/{service}/route").To(apiHandler.handleGetServiceRouteList).
+			// docs
+			Doc("returns a list of HTTPRoutes and GRPCRoutes with backends referencing Service").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the Service")).
+			Param(apiV1Ws.PathParameter("service", "name of the Service")).
+			Writes(gateway.RouteList{}).
+			Returns(http.StatusOK, "OK", gateway.RouteList{}))
 
 	// ServiceAccount
 	apiV1Ws.Route(
@@ -895,10 +1063,82 @@ func CreateHTTPAPIHandler(iManager integration.Manager) (*restful.Container, err
 	apiV1Ws.Route(
 		apiV1Ws.PUT("/node/{name}/drain").To(apiHandler.handleNodeDrain).
 			// docs
-			Doc("drains Node").
+			Doc("starts draining Node in the background and returns the drain operation").
 			Param(apiV1Ws.PathParameter("name", "name of the Node")).
 			Reads(node.NodeDrainSpec{}).
-			Returns(http.StatusOK, "OK", nil))
+			Writes(node.NodeDrainStatus{}).
+			Returns(http.StatusAccepted, "Accepted", node.NodeDrainStatus{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/node/{name}/drain/{id}").To(apiHandler.handleGetNodeDrain).
+			// docs
+			Doc("returns the state of a Node drain operation").
+			Param(apiV1Ws.PathParameter("name", "name of the Node")).
+			Param(apiV1Ws.PathParameter("id", "ID of the drain operation")).
+			Writes(node.NodeDrainStatus{}).
+			Returns(http.StatusOK, "OK", node.NodeDrainStatus{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/node/{name}/drain/{id}/progress").To(apiHandler.handleWatchNodeDrain).
+			// docs
+			Doc("streams progress events of a Node drain operation as newline-delimited JSON until it finishes").
+			Param(apiV1Ws.PathParameter("name", "name of the Node")).
+			Param(apiV1Ws.PathParameter("id", "ID of the drain operation")).
+			Writes(node.DrainEvent{}).
+			Returns(http.StatusOK, "OK", node.DrainEvent{}))
+	apiV1Ws.Route(
+		apiV1Ws.DELETE("/node/{name}/drain/{id}").To(apiHandler.handleCancelNodeDrain).
+			// docs
+			Doc("cancels a Node drain operation").
+			Param(apiV1Ws.PathParameter("name", "name of the Node")).
+			Param(apiV1Ws.PathParameter("id", "ID of the drain operation")).
+			Writes(node.NodeDrainStatus{}).
+			Returns(http.StatusOK, "OK", node.NodeDrainStatus{}))
+	apiV1Ws.Route(
+		apiV1Ws.PUT("/node/{name}/cordon").To(apiHandler.handleNodeCordon).
+			// docs
+			Doc("marks Node as unschedulable").
+			Param(apiV1Ws.PathParameter("name", "name of the Node")).
+			Writes(node.NodeMaintenanceStatus{}).
+			Returns(http.StatusOK, "OK", node.NodeMaintenanceStatus{}))
+	apiV1Ws.Route(
+		apiV1Ws.PUT("/node/{name}/uncordon").To(apiHandler.handleNodeUncordon).
+			// docs
+			Doc("marks Node as schedulable").
+			Param(apiV1Ws.PathParameter("name", "name of the Node")).
+			Writes(node.NodeMaintenanceStatus{}).
+			Returns(http.StatusOK, "OK", node.NodeMaintenanceStatus{}))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/node/{name}/taint").To(apiHandler.handleAddNodeTaint).
+			// docs
+			Doc("adds a taint to Node").
+			Param(apiV1Ws.PathParameter("name", "name of the Node")).
+			Reads(node.TaintSpec{}).
+			Writes(node.NodeMaintenanceStatus{}).
+			Returns(http.StatusOK, "OK", node.NodeMaintenanceStatus{}))
+	apiV1Ws.Route(
+		apiV1Ws.PUT("/node/{name}/taint").To(apiHandler.handleUpdateNodeTaint).
+			// docs
+			Doc("updates the value of a Node taint with the same key and effect").
+			Param(apiV1Ws.PathParameter("name", "name of the Node")).
+			Reads(node.TaintSpec{}).
+			Writes(node.NodeMaintenanceStatus{}).
+			Returns(http.StatusOK, "OK", node.NodeMaintenanceStatus{}))
+	apiV1Ws.Route(
+		apiV1Ws.DELETE("/node/{name}/taint").To(apiHandler.handleRemoveNodeTaint).
+			// docs
+			Doc("removes taints with the key from Node").
+			Param(apiV1Ws.PathParameter("name", "name of the Node")).
+			Param(apiV1Ws.QueryParameter("key", "key of the taint").Required(true)).
+			Param(apiV1Ws.QueryParameter("effect", "effect of the taint, all effects when empty")).
+			Writes(node.NodeMaintenanceStatus{}).
+			Returns(http.StatusOK, "OK", node.NodeMaintenanceStatus{}))
+	apiV1Ws.Route(
+		apiV1Ws.PUT("/node/{name}/label").To(apiHandler.handleUpdateNodeLabels).
+			// docs
+			Doc("sets and removes Node labels").
+			Param(apiV1Ws.PathParameter("name", "name of the Node")).
+			Reads(node.NodeLabelsSpec{}).
+			Writes(node.NodeMaintenanceStatus{}).
+			Returns(http.StatusOK, "OK", node.NodeMaintenanceStatus{}))
 
 	// Verber (namespaced)
 	apiV1Ws.Route(
@@ -929,6 +1169,17 @@ func CreateHTTPAPIHandler(iManager integration.Manager) (*restful.Container, err
 			Param(apiV1Ws.PathParameter("name", "name of the resource")).
 			Reads(JSON("")).
 			Returns(http.StatusNoContent, "", nil))
+	apiV1Ws.Route(
+		apiV1Ws.PATCH("/_raw/{kind}/namespace/{namespace}/name/{name}").To(apiHandler.handlePatchResource).
+			// docs
+			Doc("patches a resource in a namespace").
+			Consumes(patchContentTypes...).
+			Param(apiV1Ws.PathParameter("kind", "kind of the resource")).
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Reads(JSON("")).
+			Writes(unstructured.Unstructured{}).
+			Returns(http.StatusOK, "OK", unstructured.Unstructured{}))
 
 	// Verber (non-namespaced)
 	apiV1Ws.Route(
@@ -956,141 +1207,499 @@ func CreateHTTPAPIHandler(iManager integration.Manager) (*restful.Container, err
 			Param(apiV1Ws.PathParameter("name", "name of the resource")).
 			Reads(JSON("")).
 			Returns(http.StatusNoContent, "", nil))
-
-	// Generic resource scaling
 	apiV1Ws.Route(
-		apiV1Ws.PUT("/scale/{kind}/{namespace}/{name}").To(apiHandler.handleScaleResource).
+		apiV1Ws.PATCH("/_raw/{kind}/name/{name}").To(apiHandler.handlePatchResource).
 			// docs
-			Doc("scales a namespaced resource").
+			Doc("patches a non-namespaced resource").
+			Consumes(patchContentTypes...).
 			Param(apiV1Ws.PathParameter("kind", "kind of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Reads(JSON("")).
+			Writes(unstructured.Unstructured{}).
+			Returns(http.StatusOK, "OK", unstructured.Unstructured{}))
+
+	// Verber (namespaced, explicit group, version and resource)
+	apiV1Ws.Route(
+		apiV1Ws.DELETE("/_raw/gvr/{group}/{version}/{resource}/namespace/{namespace}/name/{name}").To(apiHandler.handleDeleteResourceByGVR).
+			// docs
+			Doc("deletes a resource from a namespace").
+			Param(apiV1Ws.PathParameter("group", "group of the resource, \"core\" for the core group")).
+			Param(apiV1Ws.PathParameter("version", "version of the resource")).
+			Param(apiV1Ws.PathParameter("resource", "plural name of the resource")).
 			Param(apiV1Ws.PathParameter("namespace", "namespace of the resource")).
 			Param(apiV1Ws.PathParameter("name", "name of the resource")).
-			Param(apiV1Ws.QueryParameter("scaleBy", "desired number of replicas")).
-			Writes(scaling.ReplicaCounts{}).
-			Returns(http.StatusOK, "OK", scaling.ReplicaCounts{}))
+			Param(apiV1Ws.QueryParameter("deleteNow", "override graceful delete options and enforce immediate deletion")).
+			Param(apiV1Ws.QueryParameter("propagation", "override default delete propagation policy")).
+			Returns(http.StatusNoContent, "", nil))
 	apiV1Ws.Route(
-		apiV1Ws.PUT("/scale/{kind}/{name}").To(apiHandler.handleScaleResource).
+		apiV1Ws.GET("/_raw/gvr/{group}/{version}/{resource}/namespace/{namespace}/name/{name}").To(apiHandler.handleGetResourceByGVR).
 			// docs
-			Doc("scales a non-namespaced resource").
-			Param(apiV1Ws.PathParameter("kind", "kind of the resource")).
+			Doc("returns a resource from a namespace").
+			Param(apiV1Ws.PathParameter("group", "group of the resource, \"core\" for the core group")).
+			Param(apiV1Ws.PathParameter("version", "version of the resource")).
+			Param(apiV1Ws.PathParameter("resource", "plural name of the resource")).
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the resource")).
 			Param(apiV1Ws.PathParameter("name", "name of the resource")).
-			Param(apiV1Ws.QueryParameter("scaleBy", "desired number of replicas")).
-			Writes(scaling.ReplicaCounts{}).
-			Returns(http.StatusOK, "OK", scaling.ReplicaCounts{}))
+			Writes(unstructured.Unstructured{}).
+			Returns(http.StatusOK, "OK", unstructured.Unstructured{}))
 	apiV1Ws.Route(
-		apiV1Ws.GET("/scale/{kind}/{namespace}/{name}").To(apiHandler.handleGetReplicaCount).
+		apiV1Ws.GET("/_raw/gvr/{group}/{version}/{resource}/namespace/{namespace}/name/{name}/{subresource}").To(apiHandler.handleGetResourceByGVR).
 			// docs
-			Doc("returns a number of replicas of namespaced resource").
-			Param(apiV1Ws.PathParameter("kind", "kind of the resource")).
+			Doc("returns a subresource of a resource from a namespace").
+			Param(apiV1Ws.PathParameter("group", "group of the resource, \"core\" for the core group")).
+			Param(apiV1Ws.PathParameter("version", "version of the resource")).
+			Param(apiV1Ws.PathParameter("resource", "plural name of the resource")).
 			Param(apiV1Ws.PathParameter("namespace", "namespace of the resource")).
 			Param(apiV1Ws.PathParameter("name", "name of the resource")).
-			Writes(scaling.ReplicaCounts{}).
-			Returns(http.StatusOK, "OK", scaling.ReplicaCounts{}))
+			Param(apiV1Ws.PathParameter("subresource", "subresource, one of status, scale or ephemeralcontainers")).
+			Writes(unstructured.Unstructured{}).
+			Returns(http.StatusOK, "OK", unstructured.Unstructured{}))
 	apiV1Ws.Route(
-		apiV1Ws.GET("/scale/{kind}/{name}").To(apiHandler.handleGetReplicaCount).
+		apiV1Ws.PUT("/_raw/gvr/{group}/{version}/{resource}/namespace/{namespace}/name/{name}").To(apiHandler.handlePutResourceByGVR).
 			// docs
-			Doc("returns a number of replicas of non-namespaced resource").
-			Param(apiV1Ws.PathParameter("kind", "kind of the resource")).
+			Doc("updates a resource in a namespace").
+			Param(apiV1Ws.PathParameter("group", "group of the resource, \"core\" for the core group")).
+			Param(apiV1Ws.PathParameter("version", "version of the resource")).
+			Param(apiV1Ws.PathParameter("resource", "plural name of the resource")).
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the resource")).
 			Param(apiV1Ws.PathParameter("name", "name of the resource")).
-			Writes(scaling.ReplicaCounts{}).
-			Returns(http.StatusOK, "OK", scaling.ReplicaCounts{}))
-
-	// ClusterRole
+			Reads(JSON("")).
+			Returns(http.StatusNoContent, "", nil))
 	apiV1Ws.Route(
-		apiV1Ws.GET("/clusterrole").To(apiHandler.handleGetClusterRoleList).
+		apiV1Ws.PUT("/_raw/gvr/{group}/{version}/{resource}/namespace/{namespace}/name/{name}/{subresource}").To(apiHandler.handlePutResourceByGVR).
 			// docs
-			Doc("returns a list of ClusterRoles").
-			Writes(clusterrole.ClusterRoleList{}).
-			Returns(http.StatusOK, "OK", clusterrole.ClusterRoleList{}))
+			Doc("updates a subresource of a resource in a namespace").
+			Param(apiV1Ws.PathParameter("group", "group of the resource, \"core\" for the core group")).
+			Param(apiV1Ws.PathParameter("version", "version of the resource")).
+			Param(apiV1Ws.PathParameter("resource", "plural name of the resource")).
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Param(apiV1Ws.PathParameter("subresource", "subresource, one of status, scale or ephemeralcontainers")).
+			Reads(JSON("")).
+			Returns(http.StatusNoContent, "", nil))
 	apiV1Ws.Route(
-		apiV1Ws.GET("/clusterrole/{name}").To(apiHandler.handleGetClusterRoleDetail).
+		apiV1Ws.PATCH("/_raw/gvr/{group}/{version}/{resource}/namespace/{namespace}/name/{name}").To(apiHandler.handlePatchResourceByGVR).
 			// docs
-			Doc("returns detailed information about ClusterRole").
-			Param(apiV1Ws.PathParameter("name", "name of the ClusterRole")).
-			Writes(clusterrole.ClusterRoleDetail{}).
-			Returns(http.StatusOK, "OK", clusterrole.ClusterRoleDetail{}))
+			Doc("patches a resource in a namespace").
+			Consumes(patchContentTypes...).
+			Param(apiV1Ws.PathParameter("group", "group of the resource, \"core\" for the core group")).
+			Param(apiV1Ws.PathParameter("version", "version of the resource")).
+			Param(apiV1Ws.PathParameter("resource", "plural name of the resource")).
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Reads(JSON("")).
+			Writes(unstructured.Unstructured{}).
+			Returns(http.StatusOK, "OK", unstructured.Unstructured{}))
+	apiV1Ws.Route(
+		apiV1Ws.PATCH("/_raw/gvr/{group}/{version}/{resource}/namespace/{namespace}/name/{name}/{subresource}").To(apiHandler.handlePatchResourceByGVR).
+			// docs
+			Doc("patches a subresource of a resource in a namespace").
+			Consumes(patchContentTypes...).
+			Param(apiV1Ws.PathParameter("group", "group of the resource, \"core\" for the core group")).
+			Param(apiV1Ws.PathParameter("version", "version of the resource")).
+			Param(apiV1Ws.PathParameter("resource", "plural name of the resource")).
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Param(apiV1Ws.PathParameter("subresource", "subresource, one of status, scale or ephemeralcontainers")).
+			Reads(JSON("")).
+			Writes(unstructured.Unstructured{}).
+			Returns(http.StatusOK, "OK", unstructured.Unstructured{}))
 
-	// ClusterRoleBinding
+	// Verber (non-namespaced, explicit group, version and resource)
 	apiV1Ws.Route(
-		apiV1Ws.GET("/clusterrolebinding").To(apiHandler.handleGetClusterRoleBindingList).
+		apiV1Ws.DELETE("/_raw/gvr/{group}/{version}/{resource}/name/{name}").To(apiHandler.handleDeleteResourceByGVR).
 			// docs
-			Doc("returns a list of ClusterRoleBindings").
-			Writes(clusterrolebinding.ClusterRoleBindingList{}).
-			Returns(http.StatusOK, "OK", clusterrolebinding.ClusterRoleBindingList{}))
+			Doc("deletes a non-namespaced resource").
+			Param(apiV1Ws.PathParameter("group", "group of the resource, \"core\" for the core group")).
+			Param(apiV1Ws.PathParameter("version", "version of the resource")).
+			Param(apiV1Ws.PathParameter("resource", "plural name of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Param(apiV1Ws.QueryParameter("deleteNow", "override graceful delete options and enforce immediate deletion")).
+			Param(apiV1Ws.QueryParameter("propagation", "override default delete propagation policy")).
+			Returns(http.StatusNoContent, "", nil))
 	apiV1Ws.Route(
-		apiV1Ws.GET("/clusterrolebinding/{name}").To(apiHandler.handleGetClusterRoleBindingDetail).
+		apiV1Ws.GET("/_raw/gvr/{group}/{version}/{resource}/name/{name}").To(apiHandler.handleGetResourceByGVR).
 			// docs
-			Doc("returns detailed information about ClusterRoleBinding").
-			Param(apiV1Ws.PathParameter("name", "name of the ClusterRoleBinding")).
-			Writes(clusterrolebinding.ClusterRoleBindingDetail{}).
-			Returns(http.StatusOK, "OK", clusterrolebinding.ClusterRoleBindingDetail{}))
-
-	// Role
+			Doc("returns a non-namespaced resource").
+			Param(apiV1Ws.PathParameter("group", "group of the resource, \"core\" for the core group")).
+			Param(apiV1Ws.PathParameter("version", "version of the resource")).
+			Param(apiV1Ws.PathParameter("resource", "plural name of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Writes(unstructured.Unstructured{}).
+			Returns(http.StatusOK, "OK", unstructured.Unstructured{}))
 	apiV1Ws.Route(
-		apiV1Ws.GET("/role").To(apiHandler.handleGetRoleList).
+		apiV1Ws.GET("/_raw/gvr/{group}/{version}/{resource}/name/{name}/{subresource}").To(apiHandler.handleGetResourceByGVR).
 			// docs
-			Doc("returns a list of Roles from all namespace").
-			Writes(role.RoleList{}).
-			Returns(http.StatusOK, "OK", role.RoleList{}))
+			Doc("returns a subresource of a non-namespaced resource").
+			Param(apiV1Ws.PathParameter("group", "group of the resource, \"core\" for the core group")).
+			Param(apiV1Ws.PathParameter("version", "version of the resource")).
+			Param(apiV1Ws.PathParameter("resource", "plural name of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Param(apiV1Ws.PathParameter("subresource", "subresource, one of status, scale or ephemeralcontainers")).
+			Writes(unstructured.Unstructured{}).
+			Returns(http.StatusOK, "OK", unstructured.Unstructured{}))
 	apiV1Ws.Route(
-		apiV1Ws.GET("/role/{namespace}").To(apiHandler.handleGetRoleList).
+		apiV1Ws.PUT("/_raw/gvr/{group}/{version}/{resource}/name/{name}").To(apiHandler.handlePutResourceByGVR).
 			// docs
-			Doc("returns a list of Roles in a namespace").
-			Param(apiV1Ws.PathParameter("namespace", "namespace of the Role")).
-			Writes(role.RoleList{}).
-			Returns(http.StatusOK, "OK", role.RoleList{}))
+			Doc("updates a non-namespaced resource").
+			Param(apiV1Ws.PathParameter("group", "group of the resource, \"core\" for the core group")).
+			Param(apiV1Ws.PathParameter("version", "version of the resource")).
+			Param(apiV1Ws.PathParameter("resource", "plural name of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Reads(JSON("")).
+			Returns(http.StatusNoContent, "", nil))
 	apiV1Ws.Route(
-		apiV1Ws.GET("/role/{namespace}/{name}").To(apiHandler.handleGetRoleDetail).
+		apiV1Ws.PUT("/_raw/gvr/{group}/{version}/{resource}/name/{name}/{subresource}").To(apiHandler.handlePutResourceByGVR).
 			// docs
-			Doc("returns detailed information about Role").
-			Param(apiV1Ws.PathParameter("namespace", "namespace of the Role")).
-			Param(apiV1Ws.PathParameter("name", "name of the Role")).
-			Writes(role.RoleDetail{}).
-			Returns(http.StatusOK, "OK", role.RoleDetail{}))
-
-	// RoleBinding
+			Doc("updates a subresource of a non-namespaced resource").
+			Param(apiV1Ws.PathParameter("group", "group of the resource, \"core\" for the core group")).
+			Param(apiV1Ws.PathParameter("version", "version of the resource")).
+			Param(apiV1Ws.PathParameter("resource", "plural name of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Param(apiV1Ws.PathParameter("subresource", "subresource, one of status, scale or ephemeralcontainers")).
+			Reads(JSON("")).
+			Returns(http.StatusNoContent, "", nil))
 	apiV1Ws.Route(
-		apiV1Ws.GET("/rolebinding").To(apiHandler.handleGetRoleBindingList).
+		apiV1Ws.PATCH("/_raw/gvr/{group}/{version}/{resource}/name/{name}").To(apiHandler.handlePatchResourceByGVR).
 			// docs
-			Doc("returns a list of RoleBindings from all namespace").
-			Writes(rolebinding.RoleBindingList{}).
-			Returns(http.StatusOK, "OK", rolebinding.RoleBindingList{}))
+			Doc("patches a non-namespaced resource").
+			Consumes(patchContentTypes...).
+			Param(apiV1Ws.PathParameter("group", "group of the resource, \"core\" for the core group")).
+			Param(apiV1Ws.PathParameter("version", "version of the resource")).
+			Param(apiV1Ws.PathParameter("resource", "plural name of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Reads(JSON("")).
+			Writes(unstructured.Unstructured{}).
+			Returns(http.StatusOK, "OK", unstructured.Unstructured{}))
 	apiV1Ws.Route(
-		apiV1Ws.GET("/rolebinding/{namespace}").To(apiHandler.handleGetRoleBindingList).
+		apiV1Ws.PATCH("/_raw/gvr/{group}/{version}/{resource}/name/{name}/{subresource}").To(apiHandler.handlePatchResourceByGVR).
 			// docs
-			Doc("returns a list of RoleBindings in a namespace").
-			Param(apiV1Ws.PathParameter("namespace", "namespace of the RoleBinding")).
-			Writes(rolebinding.RoleBindingList{}).
-			Returns(http.StatusOK, "OK", rolebinding.RoleBindingList{}))
+			Doc("patches a subresource of a non-namespaced resource").
+			Consumes(patchContentTypes...).
+			Param(apiV1Ws.PathParameter("group", "group of the resource, \"core\" for the core group")).
+			Param(apiV1Ws.PathParameter("version", "version of the resource")).
+			Param(apiV1Ws.PathParameter("resource", "plural name of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Param(apiV1Ws.PathParameter("subresource", "subresource, one of status, scale or ephemeralcontainers")).
+			Reads(JSON("")).
+			Writes(unstructured.Unstructured{}).
+			Returns(http.StatusOK, "OK", unstructured.Unstructured{}))
+
+	// Bulk actions
 	apiV1Ws.Route(
-		apiV1Ws.GET("/rolebinding/{namespace}/{name}").To(apiHandler.handleGetRoleBindingDetail).
+		apiV1Ws.POST("/bulk").To(apiHandler.handleBulkAction).
 			// docs
-			Doc("returns detailed information about RoleBinding").
-			Param(apiV1Ws.PathParameter("namespace", "namespace of the RoleBinding")).
-			Param(apiV1Ws.PathParameter("name", "name of the RoleBinding")).
-			Writes(rolebinding.RoleBindingDetail{}).
-			Returns(http.StatusOK, "OK", rolebinding.RoleBindingDetail{}))
+			Doc("performs an action on multiple resources selected by references or label selector").
+			Reads(bulk.ActionSpec{}).
+			Writes(bulk.ActionResult{}).
+			Returns(http.StatusOK, "OK", bulk.ActionResult{}))
 
-	// PersistentVolume
+	// Export
 	apiV1Ws.Route(
-		apiV1Ws.GET("/persistentvolume").To(apiHandler.handleGetPersistentVolumeList).
+		apiV1Ws.GET("/export/{kind}/namespace/{namespace}/name/{name}").To(apiHandler.handleExportResource).
 			// docs
-			Doc("returns a list of PersistentVolumes from all namespaces").
-			Writes(persistentvolume.PersistentVolumeList{}).
-			Returns(http.StatusOK, "OK", persistentvolume.PersistentVolumeList{}))
+			Doc("exports a resource from a namespace without status and server-managed fields").
+			Produces(restful.MIME_JSON, exportYAMLContentType).
+			Param(apiV1Ws.PathParameter("kind", "kind of the resource")).
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Param(apiV1Ws.QueryParameter("format", "export format, yaml (default) or json")).
+			Param(apiV1Ws.QueryParameter("stripDefaults", "remove fields with values equal to OpenAPI schema defaults")).
+			Returns(http.StatusOK, "OK", nil))
 	apiV1Ws.Route(
-		apiV1Ws.GET("/persistentvolume/{persistentvolume}").To(apiHandler.handleGetPersistentVolumeDetail).
+		apiV1Ws.GET("/export/{kind}/name/{name}").To(apiHandler.handleExportResource).
 			// docs
-			Doc("returns detailed information about PersistentVolume").
-			Param(apiV1Ws.PathParameter("persistentvolume", "name of the PersistentVolume")).
-			Writes(persistentvolume.PersistentVolumeDetail{}).
-			Returns(http.StatusOK, "OK", persistentvolume.PersistentVolumeDetail{}))
+			Doc("exports a non-namespaced resource without status and server-managed fields").
+			Produces(restful.MIME_JSON, exportYAMLContentType).
+			Param(apiV1Ws.PathParameter("kind", "kind of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Param(apiV1Ws.QueryParameter("format", "export format, yaml (default) or json")).
+			Param(apiV1Ws.QueryParameter("stripDefaults", "remove fields with values equal to OpenAPI schema defaults")).
+			Returns(http.StatusOK, "OK", nil))
 	apiV1Ws.Route(
-		apiV1Ws.GET("/persistentvolume/namespace/{namespace}/name/{persistentvolume}").To(apiHandler.handleGetPersistentVolumeDetail).
+		apiV1Ws.POST("/export").To(apiHandler.handleExportResources).
 			// docs
-			Doc("returns detailed information about PersistentVolume").
-			Param(apiV1Ws.PathParameter("persistentvolume", "name of the PersistentVolume")).
-			Writes(persistentvolume.PersistentVolumeDetail{}).
+			Doc("exports multiple resources as a multi-document YAML or a JSON list").
+			Produces(restful.MIME_JSON, exportYAMLContentType).
+			Reads(export.ExportSpec{}).
+			Returns(http.StatusOK, "OK", nil))
+
+	// Namespace bundle
+	apiV1Ws.Route(
+		apiV1Ws.GET("/bundle/{namespace}").To(apiHandler.handleExportNamespaceBundle).
+			// docs
+			Doc("exports all readable objects of a namespace as a gzip compressed tar archive of cleaned manifests").
+			Produces(export.BundleContentType).
+			Param(apiV1Ws.PathParameter("namespace", "namespace to export")).
+			Param(apiV1Ws.QueryParameter("includeSecrets", "include secrets other than service account tokens")).
+			Param(apiV1Ws.QueryParameter("stripDefaults", "remove fields with values equal to OpenAPI schema defaults")).
+			Returns(http.StatusOK, "OK", nil))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/bundle/{namespace}").To(apiHandler.handleImportNamespaceBundle).
+			// docs
+			Doc("imports a namespace bundle archive into the namespace").
+			Consumes(export.BundleContentType, bundleOctetStreamContentType).
+			Param(apiV1Ws.PathParameter("namespace", "namespace to import objects into")).
+			Param(apiV1Ws.QueryParameter("prefix", "prefix added to names of imported objects")).
+			Param(apiV1Ws.QueryParameter("suffix", "suffix added to names of imported objects")).
+			Param(apiV1Ws.QueryParameter("createNamespace", "create the namespace if it does not exist")).
+			Param(apiV1Ws.QueryParameter("dryRun", "validate objects without creating them")).
+			Writes(export.ImportReport{}).
+			Returns(http.StatusOK, "OK", export.ImportReport{}))
+
+	// Drift detection
+	apiV1Ws.Route(
+		apiV1Ws.GET("/drift/namespace/{namespace}").To(apiHandler.handleGetNamespaceDrift).
+			// docs
+			Doc("lists applied objects of a namespace that differ from their last-applied configuration").
+			Param(apiV1Ws.PathParameter("namespace", "namespace to check")).
+			Writes(drift.NamespaceReport{}).
+			Returns(http.StatusOK, "OK", drift.NamespaceReport{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/drift/{kind}/namespace/{namespace}/name/{name}").To(apiHandler.handleGetDrift).
+			// docs
+			Doc("compares a resource from a namespace against its last-applied configuration").
+			Param(apiV1Ws.PathParameter("kind", "kind of the resource")).
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Writes(drift.Drift{}).
+			Returns(http.StatusOK, "OK", drift.Drift{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/drift/{kind}/name/{name}").To(apiHandler.handleGetDrift).
+			// docs
+			Doc("compares a non-namespaced resource against its last-applied configuration").
+			Param(apiV1Ws.PathParameter("kind", "kind of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Writes(drift.Drift{}).
+			Returns(http.StatusOK, "OK", drift.Drift{}))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/drift/{kind}/namespace/{namespace}/name/{name}").To(apiHandler.handleGetManifestDrift).
+			// docs
+			Doc("compares a resource from a namespace against a YAML or JSON manifest").
+			Consumes(restful.MIME_JSON, exportYAMLContentType).
+			Param(apiV1Ws.PathParameter("kind", "kind of the resource")).
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Writes(drift.Drift{}).
+			Returns(http.StatusOK, "OK", drift.Drift{}))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/drift/{kind}/name/{name}").To(apiHandler.handleGetManifestDrift).
+			// docs
+			Doc("compares a non-namespaced resource against a YAML or JSON manifest").
+			Consumes(restful.MIME_JSON, exportYAMLContentType).
+			Param(apiV1Ws.PathParameter("kind", "kind of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Writes(drift.Drift{}).
+			Returns(http.StatusOK, "OK", drift.Drift{}))
+
+	// Field ownership
+	apiV1Ws.Route(
+		apiV1Ws.GET("/managedfields/{kind}/namespace/{namespace}/name/{name}").To(apiHandler.handleGetOwnership).
+			// docs
+			Doc("returns field managers owning each field of a resource from a namespace").
+			Param(apiV1Ws.PathParameter("kind", "kind of the resource")).
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Writes(ownership.Ownership{}).
+			Returns(http.StatusOK, "OK", ownership.Ownership{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/managedfields/{kind}/name/{name}").To(apiHandler.handleGetOwnership).
+			// docs
+			Doc("returns field managers owning each field of a non-namespaced resource").
+			Param(apiV1Ws.PathParameter("kind", "kind of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Writes(ownership.Ownership{}).
+			Returns(http.StatusOK, "OK", ownership.Ownership{}))
+
+	// Relationship graph
+	apiV1Ws.Route(
+		apiV1Ws.GET("/graph/{kind}/namespace/{namespace}/name/{name}").To(apiHandler.handleGetGraph).
+			// docs
+			Doc("returns objects related to a resource from a namespace as nodes and edges").
+			Param(apiV1Ws.PathParameter("kind", "kind of the resource")).
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Param(apiV1Ws.QueryParameter("depth", "number of relationship hops to follow, 2 by default")).
+			Writes(graph.Graph{}).
+			Returns(http.StatusOK, "OK", graph.Graph{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/graph/{kind}/name/{name}").To(apiHandler.handleGetGraph).
+			// docs
+			Doc("returns objects related to a non-namespaced resource as nodes and edges").
+			Param(apiV1Ws.PathParameter("kind", "kind of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Param(apiV1Ws.QueryParameter("depth", "number of relationship hops to follow, 2 by default")).
+			Writes(graph.Graph{}).
+			Returns(http.StatusOK, "OK", graph.Graph{}))
+
+	// Global search
+	apiV1Ws.Route(
+		apiV1Ws.GET("/search").To(apiHandler.handleSearch).
+			// docs
+			Doc("returns resources from all namespaces matching the query by name, labels, annotations or container images, grouped by kind").
+			Param(apiV1Ws.QueryParameter("query", "text to search for, case-insensitive")).
+			Param(apiV1Ws.QueryParameter("limit", "maximum number of results per kind, 10 by default")).
+			Writes(search.SearchResult{}).
+			Returns(http.StatusOK, "OK", search.SearchResult{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/search/{namespace}").To(apiHandler.handleSearch).
+			// docs
+			Doc("returns resources from namespaces matching the query by name, labels, annotations or container images, grouped by kind").
+			Param(apiV1Ws.PathParameter("namespace", "comma separated list of namespaces to search")).
+			Param(apiV1Ws.QueryParameter("query", "text to search for, case-insensitive")).
+			Param(apiV1Ws.QueryParameter("limit", "maximum number of results per kind, 10 by default")).
+			Writes(search.SearchResult{}).
+			Returns(http.StatusOK, "OK", search.SearchResult{}))
+
+	// Overview
+	apiV1Ws.Route(
+		apiV1Ws.GET("/overview").To(apiHandler.handleGetOverview).
+			// docs
+			Doc("returns resource counts, workload statuses, warning events, resource quota usage and node readiness of the cluster").
+			Writes(overview.Overview{}).
+			Returns(http.StatusOK, "OK", overview.Overview{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/overview/{namespace}").To(apiHandler.handleGetOverview).
+			// docs
+			Doc("returns resource counts, workload statuses, warning events and resource quota usage of namespaces").
+			Param(apiV1Ws.PathParameter("namespace", "comma separated list of namespaces")).
+			Writes(overview.Overview{}).
+			Returns(http.StatusOK, "OK", overview.Overview{}))
+
+	// Generic resource scaling
+	apiV1Ws.Route(
+		apiV1Ws.PUT("/scale/{kind}/{namespace}/{name}").To(apiHandler.handleScaleResource).
+			// docs
+			Doc("scales a namespaced resource").
+			Param(apiV1Ws.PathParameter("kind", "kind of the resource")).
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Param(apiV1Ws.QueryParameter("scaleBy", "desired number of replicas")).
+			Writes(scaling.ReplicaCounts{}).
+			Returns(http.StatusOK, "OK", scaling.ReplicaCounts{}))
+	apiV1Ws.Route(
+		apiV1Ws.PUT("/scale/{kind}/{name}").To(apiHandler.handleScaleResource).
+			// docs
+			Doc("scales a non-namespaced resource").
+			Param(apiV1Ws.PathParameter("kind", "kind of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Param(apiV1Ws.QueryParameter("scaleBy", "desired number of replicas")).
+			Writes(scaling.ReplicaCounts{}).
+			Returns(http.StatusOK, "OK", scaling.ReplicaCounts{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/scale/{kind}/{namespace}/{name}").To(apiHandler.handleGetReplicaCount).
+			// docs
+			Doc("returns a number of replicas of namespaced resource").
+			Param(apiV1Ws.PathParameter("kind", "kind of the resource")).
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Writes(scaling.ReplicaCounts{}).
+			Returns(http.StatusOK, "OK", scaling.ReplicaCounts{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/scale/{kind}/{name}").To(apiHandler.handleGetReplicaCount).
+			// docs
+			Doc("returns a number of replicas of non-namespaced resource").
+			Param(apiV1Ws.PathParameter("kind", "kind of the resource")).
+			Param(apiV1Ws.PathParameter("name", "name of the resource")).
+			Writes(scaling.ReplicaCounts{}).
+			Returns(http.StatusOK, "OK", scaling.ReplicaCounts{}))
+
+	// ClusterRole
+	apiV1Ws.Route(
+		apiV1Ws.GET("/clusterrole").To(apiHandler.handleGetClusterRoleList).
+			// docs
+			Doc("returns a list of ClusterRoles").
+			Writes(clusterrole.ClusterRoleList{}).
+			Returns(http.StatusOK, "OK", clusterrole.ClusterRoleList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/clusterrole/{name}").To(apiHandler.handleGetClusterRoleDetail).
+			// docs
+			Doc("returns detailed information about ClusterRole").
+			Param(apiV1Ws.PathParameter("name", "name of the ClusterRole")).
+			Writes(clusterrole.ClusterRoleDetail{}).
+			Returns(http.StatusOK, "OK", clusterrole.ClusterRoleDetail{}))
+
+	// ClusterRoleBinding
+	apiV1Ws.Route(
+		apiV1Ws.GET("/clusterrolebinding").To(apiHandler.handleGetClusterRoleBindingList).
+			// docs
+			Doc("returns a list of ClusterRoleBindings").
+			Writes(clusterrolebinding.ClusterRoleBindingList{}).
+			Returns(http.StatusOK, "OK", clusterrolebinding.ClusterRoleBindingList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/clusterrolebinding/{name}").To(apiHandler.handleGetClusterRoleBindingDetail).
+			// docs
+			Doc("returns detailed information about ClusterRoleBinding").
+			Param(apiV1Ws.PathParameter("name", "name of the ClusterRoleBinding")).
+			Writes(clusterrolebinding.ClusterRoleBindingDetail{}).
+			Returns(http.StatusOK, "OK", clusterrolebinding.ClusterRoleBindingDetail{}))
+
+	// Role
+	apiV1Ws.Route(
+		apiV1Ws.GET("/role").To(apiHandler.handleGetRoleList).
+			// docs
+			Doc("returns a list of Roles from all namespace").
+			Writes(role.RoleList{}).
+			Returns(http.StatusOK, "OK", role.RoleList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/role/{namespace}").To(apiHandler.handleGetRoleList).
+			// docs
+			Doc("returns a list of Roles in a namespace").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the Role")).
+			Writes(role.RoleList{}).
+			Returns(http.StatusOK, "OK", role.RoleList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/role/{namespace}/{name}").To(apiHandler.handleGetRoleDetail).
+			// docs
+			Doc("returns detailed information about Role").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the Role")).
+			Param(apiV1Ws.PathParameter("name", "name of the Role")).
+			Writes(role.RoleDetail{}).
+			Returns(http.StatusOK, "OK", role.RoleDetail{}))
+
+	// RoleBinding
+	apiV1Ws.Route(
+		apiV1Ws.GET("/rolebinding").To(apiHandler.handleGetRoleBindingList).
+			// docs
+			Doc("returns a list of RoleBindings from all namespace").
+			Writes(rolebinding.RoleBindingList{}).
+			Returns(http.StatusOK, "OK", rolebinding.RoleBindingList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/rolebinding/{namespace}").To(apiHandler.handleGetRoleBindingList).
+			// docs
+			Doc("returns a list of RoleBindings in a namespace").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the RoleBinding")).
+			Writes(rolebinding.RoleBindingList{}).
+			Returns(http.StatusOK, "OK", rolebinding.RoleBindingList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/rolebinding/{namespace}/{name}").To(apiHandler.handleGetRoleBindingDetail).
+			// docs
+			Doc("returns detailed information about RoleBinding").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the RoleBinding")).
+			Param(apiV1Ws.PathParameter("name", "name of the RoleBinding")).
+			Writes(rolebinding.RoleBindingDetail{}).
+			Returns(http.StatusOK, "OK", rolebinding.RoleBindingDetail{}))
+
+	// PersistentVolume
+	apiV1Ws.Route(
+		apiV1Ws.GET("/persistentvolume").To(apiHandler.handleGetPersistentVolumeList).
+			// docs
+			Doc("returns a list of PersistentVolumes from all namespaces").
+			Writes(persistentvolume.PersistentVolumeList{}).
+			Returns(http.StatusOK, "OK", persistentvolume.PersistentVolumeList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/persistentvolume/{persistentvolume}").To(apiHandler.handleGetPersistentVolumeDetail).
+			// docs
+			Doc("returns detailed information about PersistentVolume").
+			Param(apiV1Ws.PathParameter("persistentvolume", "name of the PersistentVolume")).
+			Writes(persistentvolume.PersistentVolumeDetail{}).
+			Returns(http.StatusOK, "OK", persistentvolume.PersistentVolumeDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/persistentvolume/namespace/{namespace}/name/{persistentvolume}").To(apiHandler.handleGetPersistentVolumeDetail).
+			// docs
+			Doc("returns detailed information about PersistentVolume").
+			Param(apiV1Ws.PathParameter("persistentvolume", "name of the PersistentVolume")).
+			Writes(persistentvolume.PersistentVolumeDetail{}).
 			Returns(http.StatusOK, "OK", persistentvolume.PersistentVolumeDetail{}))
 
 	// PersistentVolumeClaim
@@ -1118,6 +1727,94 @@ func CreateHTTPAPIHandler(iManager integration.Manager) (*restful.Container, err
 			Param(apiV1Ws.PathParameter("namespace", "namespace of the PersistentVolumeClaim")).
 			Writes(persistentvolumeclaim.PersistentVolumeClaimDetail{}).
 			Returns(http.StatusOK, "OK", persistentvolumeclaim.PersistentVolumeClaimDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.PUT("/persistentvolumeclaim/{namespace}/{name}/resize").
+			To(apiHandler.handleResizePersistentVolumeClaim).
+			// docs
+			Doc("expands the volume of PersistentVolumeClaim if its StorageClass allows volume expansion").
+			Param(apiV1Ws.PathParameter("name", "name of the PersistentVolumeClaim")).
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the PersistentVolumeClaim")).
+			Reads(persistentvolumeclaim.ResizeSpec{}).
+			Writes(persistentvolumeclaim.PersistentVolumeClaimDetail{}).
+			Returns(http.StatusOK, "OK", persistentvolumeclaim.PersistentVolumeClaimDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/persistentvolumeclaim/{namespace}/{name}/volumesnapshot").
+			To(apiHandler.handleGetPersistentVolumeClaimVolumeSnapshots).
+			// docs
+			Doc("returns a list of VolumeSnapshots of PersistentVolumeClaim").
+			Param(apiV1Ws.PathParameter("name", "name of the PersistentVolumeClaim")).
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the PersistentVolumeClaim")).
+			Writes(volumesnapshot.VolumeSnapshotList{}).
+			Returns(http.StatusOK, "OK", volumesnapshot.VolumeSnapshotList{}))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/persistentvolumeclaim/{namespace}/{name}/volumesnapshot").
+			To(apiHandler.handleCreateVolumeSnapshot).
+			// docs
+			Doc("creates a VolumeSnapshot of PersistentVolumeClaim").
+			Param(apiV1Ws.PathParameter("name", "name of the PersistentVolumeClaim")).
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the PersistentVolumeClaim")).
+			Reads(volumesnapshot.VolumeSnapshotSpec{}).
+			Writes(volumesnapshot.VolumeSnapshot{}).
+			Returns(http.StatusCreated, "Created", volumesnapshot.VolumeSnapshot{}))
+
+	// VolumeSnapshot
+	apiV1Ws.Route(
+		apiV1Ws.GET("/volumesnapshot/{namespace}").
+			To(apiHandler.handleGetVolumeSnapshotList).
+			// docs
+			Doc("returns a list of VolumeSnapshots from specified namespace").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the VolumeSnapshot")).
+			Writes(volumesnapshot.VolumeSnapshotList{}).
+			Returns(http.StatusOK, "OK", volumesnapshot.VolumeSnapshotList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/volumesnapshot/{namespace}/{name}").
+			To(apiHandler.handleGetVolumeSnapshotDetail).
+			// docs
+			Doc("returns detailed information about VolumeSnapshot").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the VolumeSnapshot")).
+			Param(apiV1Ws.PathParameter("name", "name of the VolumeSnapshot")).
+			Writes(volumesnapshot.VolumeSnapshot{}).
+			Returns(http.StatusOK, "OK", volumesnapshot.VolumeSnapshot{}))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/volumesnapshot/{namespace}/{name}/restore").
+			To(apiHandler.handleRestoreVolumeSnapshot).
+			// docs
+			Doc("restores VolumeSnapshot into a new PersistentVolumeClaim").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the VolumeSnapshot")).
+			Param(apiV1Ws.PathParameter("name", "name of the VolumeSnapshot")).
+			Reads(volumesnapshot.RestoreSpec{}).
+			Writes(persistentvolumeclaim.PersistentVolumeClaimDetail{}).
+			Returns(http.StatusCreated, "Created", persistentvolumeclaim.PersistentVolumeClaimDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/volumesnapshotclass").
+			To(apiHandler.handleGetVolumeSnapshotClassList).
+			// docs
+			Doc("returns a list of VolumeSnapshotClasses").
+			Writes(volumesnapshot.VolumeSnapshotClassList{}).
+			Returns(http.StatusOK, "OK", volumesnapshot.VolumeSnapshotClassList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/volumesnapshotclass/{name}").
+			To(apiHandler.handleGetVolumeSnapshotClassDetail).
+			// docs
+			Doc("returns detailed information about VolumeSnapshotClass").
+			Param(apiV1Ws.PathParameter("name", "name of the VolumeSnapshotClass")).
+			Writes(volumesnapshot.VolumeSnapshotClass{}).
+			Returns(http.StatusOK, "OK", volumesnapshot.VolumeSnapshotClass{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/volumesnapshotcontent").
+			To(apiHandler.handleGetVolumeSnapshotContentList).
+			// docs
+			Doc("returns a list of VolumeSnapshotContents").
+			Writes(volumesnapshot.VolumeSnapshotContentList{}).
+			Returns(http.StatusOK, "OK", volumesnapshot.VolumeSnapshotContentList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/volumesnapshotcontent/{name}").
+			To(apiHandler.handleGetVolumeSnapshotContentDetail).
+			// docs
+			Doc("returns detailed information about VolumeSnapshotContent").
+			Param(apiV1Ws.PathParameter("name", "name of the VolumeSnapshotContent")).
+			Writes(volumesnapshot.VolumeSnapshotContent{}).
+			Returns(http.StatusOK, "OK", volumesnapshot.VolumeSnapshotContent{}))
 
 	// PodDisruptionBudget
 	apiV1Ws.Route(
@@ -1180,6 +1877,36 @@ func CreateHTTPAPIHandler(iManager integration.Manager) (*restful.Container, err
 			Param(apiV1Ws.PathParameter("object", "name of the custom resource object")).
 			Writes(types.CustomResourceObjectDetail{}).
 			Returns(http.StatusOK, "OK", types.CustomResourceObjectDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/crd/{namespace}/{crd}/object").
+			To(apiHandler.handleCreateCustomResourceObject).
+			// docs
+			Doc("creates a custom resource object after validating it against the schema of CustomResourceDefinition").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the custom resource")).
+			Param(apiV1Ws.PathParameter("crd", "name of the CustomResourceDefinition")).
+			Reads(JSON("")).
+			Writes(types.CustomResourceObjectDetail{}).
+			Returns(http.StatusCreated, "Created", types.CustomResourceObjectDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.PUT("/crd/{namespace}/{crd}/{object}").
+			To(apiHandler.handleUpdateCustomResourceObject).
+			// docs
+			Doc("replaces a custom resource object after validating it against the schema of CustomResourceDefinition").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the custom resource")).
+			Param(apiV1Ws.PathParameter("crd", "name of the CustomResourceDefinition")).
+			Param(apiV1Ws.PathParameter("object", "name of the custom resource object")).
+			Reads(JSON("")).
+			Writes(types.CustomResourceObjectDetail{}).
+			Returns(http.StatusOK, "OK", types.CustomResourceObjectDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.DELETE("/crd/{namespace}/{crd}/{object}").
+			To(apiHandler.handleDeleteCustomResourceObject).
+			// docs
+			Doc("deletes a custom resource object").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the custom resource")).
+			Param(apiV1Ws.PathParameter("crd", "name of the CustomResourceDefinition")).
+			Param(apiV1Ws.PathParameter("object", "name of the custom resource object")).
+			Returns(http.StatusNoContent, "", nil))
 	apiV1Ws.Route(
 		apiV1Ws.GET("/crd/{namespace}/{crd}/{object}/event").
 			To(apiHandler.handleGetCustomResourceObjectEvents).
@@ -1233,23 +1960,410 @@ func CreateHTTPAPIHandler(iManager integration.Manager) (*restful.Container, err
 			Writes(ingressclass.IngressClass{}).
 			Returns(http.StatusOK, "OK", ingressclass.IngressClass{}))
 
-	// Logs
+	// PriorityClass
 	apiV1Ws.Route(
-		apiV1Ws.GET("/log/source/{namespace}/{resourceName}/{resourceType}").
-			To(apiHandler.handleLogSource).
+		apiV1Ws.GET("/priorityclass").
+			To(apiHandler.handleGetPriorityClassList).
 			// docs
-			Doc("returns log sources for a resource").
-			Param(apiV1Ws.PathParameter("namespace", "namespace of the resource")).
-			Param(apiV1Ws.PathParameter("resourceName", "name of the resource")).
-			Param(apiV1Ws.PathParameter("resourceType", "type of the resource")).
-			Writes(controller.LogSources{}).
-			Returns(http.StatusOK, "OK", controller.LogSources{}))
+			Doc("returns a list of PriorityClasses").
+			Writes(priorityclass.PriorityClassList{}).
+			Returns(http.StatusOK, "OK", priorityclass.PriorityClassList{}))
 	apiV1Ws.Route(
-		apiV1Ws.GET("/log/{namespace}/{pod}").
-			To(apiHandler.handleLogs).
+		apiV1Ws.GET("/priorityclass/{name}").
+			To(apiHandler.handleGetPriorityClassDetail).
 			// docs
-			Doc("returns logs from a Pod").
-			Param(apiV1Ws.PathParameter("namespace", "namespace of the Pod")).
+			Doc("returns detailed information about PriorityClass").
+			Param(apiV1Ws.PathParameter("name", "name of the PriorityClass")).
+			Writes(priorityclass.PriorityClassDetail{}).
+			Returns(http.StatusOK, "OK", priorityclass.PriorityClassDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/priorityclass/{name}/pod").
+			To(apiHandler.handleGetPriorityClassPods).
+			// docs
+			Doc("returns a list of Pods using PriorityClass").
+			Param(apiV1Ws.PathParameter("name", "name of the PriorityClass")).
+			Writes(pod.PodList{}).
+			Returns(http.StatusOK, "OK", pod.PodList{}))
+
+	// RuntimeClass
+	apiV1Ws.Route(
+		apiV1Ws.GET("/runtimeclass").
+			To(apiHandler.handleGetRuntimeClassList).
+			// docs
+			Doc("returns a list of RuntimeClasses").
+			Writes(runtimeclass.RuntimeClassList{}).
+			Returns(http.StatusOK, "OK", runtimeclass.RuntimeClassList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/runtimeclass/{name}").
+			To(apiHandler.handleGetRuntimeClassDetail).
+			// docs
+			Doc("returns detailed information about RuntimeClass").
+			Param(apiV1Ws.PathParameter("name", "name of the RuntimeClass")).
+			Writes(runtimeclass.RuntimeClassDetail{}).
+			Returns(http.StatusOK, "OK", runtimeclass.RuntimeClassDetail{}))
+
+	// Lease
+	apiV1Ws.Route(
+		apiV1Ws.GET("/lease").
+			To(apiHandler.handleGetLeaseList).
+			// docs
+			Doc("returns a list of Leases from all namespaces").
+			Writes(lease.LeaseList{}).
+			Returns(http.StatusOK, "OK", lease.LeaseList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/lease/{namespace}").
+			To(apiHandler.handleGetLeaseList).
+			// docs
+			Doc("returns a list of Leases in a namespace").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the Lease")).
+			Writes(lease.LeaseList{}).
+			Returns(http.StatusOK, "OK", lease.LeaseList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/lease/{namespace}/{name}").
+			To(apiHandler.handleGetLeaseDetail).
+			// docs
+			Doc("returns detailed information about Lease").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the Lease")).
+			Param(apiV1Ws.PathParameter("name", "name of the Lease")).
+			Writes(lease.LeaseDetail{}).
+			Returns(http.StatusOK, "OK", lease.LeaseDetail{}))
+
+	// CSIDriver
+	apiV1Ws.Route(
+		apiV1Ws.GET("/csidriver").
+			To(apiHandler.handleGetCSIDriverList).
+			// docs
+			Doc("returns a list of CSIDrivers").
+			Writes(csidriver.CSIDriverList{}).
+			Returns(http.StatusOK, "OK", csidriver.CSIDriverList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/csidriver/{name}").
+			To(apiHandler.handleGetCSIDriverDetail).
+			// docs
+			Doc("returns detailed information about CSIDriver").
+			Param(apiV1Ws.PathParameter("name", "name of the CSIDriver")).
+			Writes(csidriver.CSIDriverDetail{}).
+			Returns(http.StatusOK, "OK", csidriver.CSIDriverDetail{}))
+
+	// CSINode
+	apiV1Ws.Route(
+		apiV1Ws.GET("/csinode").
+			To(apiHandler.handleGetCSINodeList).
+			// docs
+			Doc("returns a list of CSINodes").
+			Writes(csinode.CSINodeList{}).
+			Returns(http.StatusOK, "OK", csinode.CSINodeList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/csinode/{name}").
+			To(apiHandler.handleGetCSINodeDetail).
+			// docs
+			Doc("returns detailed information about CSINode").
+			Param(apiV1Ws.PathParameter("name", "name of the CSINode")).
+			Writes(csinode.CSINodeDetail{}).
+			Returns(http.StatusOK, "OK", csinode.CSINodeDetail{}))
+
+	// VolumeAttachment
+	apiV1Ws.Route(
+		apiV1Ws.GET("/volumeattachment").
+			To(apiHandler.handleGetVolumeAttachmentList).
+			// docs
+			Doc("returns a list of VolumeAttachments").
+			Writes(volumeattachment.VolumeAttachmentList{}).
+			Returns(http.StatusOK, "OK", volumeattachment.VolumeAttachmentList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/volumeattachment/{name}").
+			To(apiHandler.handleGetVolumeAttachmentDetail).
+			// docs
+			Doc("returns detailed information about VolumeAttachment").
+			Param(apiV1Ws.PathParameter("name", "name of the VolumeAttachment")).
+			Writes(volumeattachment.VolumeAttachmentDetail{}).
+			Returns(http.StatusOK, "OK", volumeattachment.VolumeAttachmentDetail{}))
+
+	// ResourceQuota
+	apiV1Ws.Route(
+		apiV1Ws.GET("/resourcequota").
+			To(apiHandler.handleGetResourceQuotaList).
+			// docs
+			Doc("returns a list of ResourceQuotas from all namespaces").
+			Writes(resourcequota.ResourceQuotaList{}).
+			Returns(http.StatusOK, "OK", resourcequota.ResourceQuotaList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/resourcequota/{namespace}").
+			To(apiHandler.handleGetResourceQuotaList).
+			// docs
+			Doc("returns a list of ResourceQuotas in a namespace").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the ResourceQuota")).
+			Writes(resourcequota.ResourceQuotaList{}).
+			Returns(http.StatusOK, "OK", resourcequota.ResourceQuotaList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/resourcequota/{namespace}/{name}").
+			To(apiHandler.handleGetResourceQuotaDetail).
+			// docs
+			Doc("returns detailed information about ResourceQuota").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the ResourceQuota")).
+			Param(apiV1Ws.PathParameter("name", "name of the ResourceQuota")).
+			Writes(resourcequota.ResourceQuota{}).
+			Returns(http.StatusOK, "OK", resourcequota.ResourceQuota{}))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/resourcequota/{namespace}").
+			To(apiHandler.handleCreateResourceQuota).
+			// docs
+			Doc("creates a ResourceQuota in a namespace").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the ResourceQuota")).
+			Reads(resourcequota.ResourceQuotaSpec{}).
+			Writes(resourcequota.ResourceQuota{}).
+			Returns(http.StatusCreated, "Created", resourcequota.ResourceQuota{}))
+	apiV1Ws.Route(
+		apiV1Ws.PUT("/resourcequota/{namespace}/{name}").
+			To(apiHandler.handleUpdateResourceQuota).
+			// docs
+			Doc("updates limits of a ResourceQuota").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the ResourceQuota")).
+			Param(apiV1Ws.PathParameter("name", "name of the ResourceQuota")).
+			Reads(resourcequota.ResourceQuotaSpec{}).
+			Writes(resourcequota.ResourceQuota{}).
+			Returns(http.StatusOK, "OK", resourcequota.ResourceQuota{}))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/resourcequota/{namespace}/headroom").
+			To(apiHandler.handleGetResourceQuotaHeadroom).
+			// docs
+			Doc("returns how many more replicas of a pod template fit into ResourceQuotas of a namespace").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the ResourceQuotas")).
+			Reads(resourcequota.HeadroomSpec{}).
+			Writes(resourcequota.Headroom{}).
+			Returns(http.StatusOK, "OK", resourcequota.Headroom{}))
+
+	// LimitRange
+	apiV1Ws.Route(
+		apiV1Ws.GET("/limitrange").
+			To(apiHandler.handleGetLimitRangeList).
+			// docs
+			Doc("returns a list of LimitRanges from all namespaces").
+			Writes(limitrange.LimitRangeList{}).
+			Returns(http.StatusOK, "OK", limitrange.LimitRangeList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/limitrange/{namespace}").
+			To(apiHandler.handleGetLimitRangeList).
+			// docs
+			Doc("returns a list of LimitRanges in a namespace").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the LimitRange")).
+			Writes(limitrange.LimitRangeList{}).
+			Returns(http.StatusOK, "OK", limitrange.LimitRangeList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/limitrange/{namespace}/{name}").
+			To(apiHandler.handleGetLimitRangeDetail).
+			// docs
+			Doc("returns detailed information about LimitRange").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the LimitRange")).
+			Param(apiV1Ws.PathParameter("name", "name of the LimitRange")).
+			Writes(limitrange.LimitRange{}).
+			Returns(http.StatusOK, "OK", limitrange.LimitRange{}))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/limitrange/{namespace}").
+			To(apiHandler.handleCreateLimitRange).
+			// docs
+			Doc("creates a LimitRange in a namespace").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the LimitRange")).
+			Reads(limitrange.LimitRangeSpec{}).
+			Writes(limitrange.LimitRange{}).
+			Returns(http.StatusCreated, "Created", limitrange.LimitRange{}))
+	apiV1Ws.Route(
+		apiV1Ws.PUT("/limitrange/{namespace}/{name}").
+			To(apiHandler.handleUpdateLimitRange).
+			// docs
+			Doc("updates limits of a LimitRange").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the LimitRange")).
+			Param(apiV1Ws.PathParameter("name", "name of the LimitRange")).
+			Reads(limitrange.LimitRangeSpec{}).
+			Writes(limitrange.LimitRange{}).
+			Returns(http.StatusOK, "OK", limitrange.LimitRange{}))
+
+	// Admission
+	apiV1Ws.Route(
+		apiV1Ws.GET("/validatingwebhookconfiguration").
+			To(apiHandler.handleGetValidatingWebhookConfigurationList).
+			// docs
+			Doc("returns a list of ValidatingWebhookConfigurations").
+			Writes(admission.WebhookConfigurationList{}).
+			Returns(http.StatusOK, "OK", admission.WebhookConfigurationList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/validatingwebhookconfiguration/{name}").
+			To(apiHandler.handleGetValidatingWebhookConfigurationDetail).
+			// docs
+			Doc("returns detailed information about ValidatingWebhookConfiguration").
+			Param(apiV1Ws.PathParameter("name", "name of the ValidatingWebhookConfiguration")).
+			Writes(admission.WebhookConfigurationDetail{}).
+			Returns(http.StatusOK, "OK", admission.WebhookConfigurationDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/mutatingwebhookconfiguration").
+			To(apiHandler.handleGetMutatingWebhookConfigurationList).
+			// docs
+			Doc("returns a list of MutatingWebhookConfigurations").
+			Writes(admission.WebhookConfigurationList{}).
+			Returns(http.StatusOK, "OK", admission.WebhookConfigurationList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/mutatingwebhookconfiguration/{name}").
+			To(apiHandler.handleGetMutatingWebhookConfigurationDetail).
+			// docs
+			Doc("returns detailed information about MutatingWebhookConfiguration").
+			Param(apiV1Ws.PathParameter("name", "name of the MutatingWebhookConfiguration")).
+			Writes(admission.WebhookConfigurationDetail{}).
+			Returns(http.StatusOK, "OK", admission.WebhookConfigurationDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/validatingadmissionpolicy").
+			To(apiHandler.handleGetValidatingAdmissionPolicyList).
+			// docs
+			Doc("returns a list of ValidatingAdmissionPolicies").
+			Writes(admission.ValidatingAdmissionPolicyList{}).
+			Returns(http.StatusOK, "OK", admission.ValidatingAdmissionPolicyList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/validatingadmissionpolicy/{name}").
+			To(apiHandler.handleGetValidatingAdmissionPolicyDetail).
+			// docs
+			Doc("returns detailed information about ValidatingAdmissionPolicy with its bindings").
+			Param(apiV1Ws.PathParameter("name", "name of the ValidatingAdmissionPolicy")).
+			Writes(admission.ValidatingAdmissionPolicyDetail{}).
+			Returns(http.StatusOK, "OK", admission.ValidatingAdmissionPolicyDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/admission/appliesto").
+			To(apiHandler.handleGetAdmissionAppliesTo).
+			// docs
+			Doc("returns admission webhooks and policies intercepting requests for the object or any object in the namespace. "+
+				"Match conditions that cannot be evaluated are reported with the conditional flag set").
+			Reads(admission.AppliesToSpec{}).
+			Writes(admission.AppliesTo{}).
+			Returns(http.StatusOK, "OK", admission.AppliesTo{}))
+
+	// Gateway API
+	apiV1Ws.Route(
+		apiV1Ws.GET("/gatewayclass").
+			To(apiHandler.handleGetGatewayClassList).
+			// docs
+			Doc("returns a list of GatewayClasses").
+			Writes(gateway.GatewayClassList{}).
+			Returns(http.StatusOK, "OK", gateway.GatewayClassList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/gatewayclass/{name}").
+			To(apiHandler.handleGetGatewayClassDetail).
+			// docs
+			Doc("returns detailed information about GatewayClass").
+			Param(apiV1Ws.PathParameter("name", "name of the GatewayClass")).
+			Writes(gateway.GatewayClassDetail{}).
+			Returns(http.StatusOK, "OK", gateway.GatewayClassDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/gateway").
+			To(apiHandler.handleGetGatewayList).
+			// docs
+			Doc("returns a list of Gateways from all namespaces").
+			Writes(gateway.GatewayList{}).
+			Returns(http.StatusOK, "OK", gateway.GatewayList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/gateway/{namespace}").
+			To(apiHandler.handleGetGatewayList).
+			// docs
+			Doc("returns a list of Gateways from specified namespace").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the Gateway")).
+			Writes(gateway.GatewayList{}).
+			Returns(http.StatusOK, "OK", gateway.GatewayList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/gateway/{namespace}/{name}").
+			To(apiHandler.handleGetGatewayDetail).
+			// docs
+			Doc("returns detailed information about Gateway").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the Gateway")).
+			Param(apiV1Ws.PathParameter("name", "name of the Gateway")).
+			Writes(gateway.GatewayDetail{}).
+			Returns(http.StatusOK, "OK", gateway.GatewayDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/httproute").
+			To(apiHandler.handleGetHTTPRouteList).
+			// docs
+			Doc("returns a list of HTTPRoutes from all namespaces").
+			Writes(gateway.RouteList{}).
+			Returns(http.StatusOK, "OK", gateway.RouteList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/httproute/{namespace}").
+			To(apiHandler.handleGetHTTPRouteList).
+			// docs
+			Doc("returns a list of HTTPRoutes from specified namespace").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the HTTPRoute")).
+			Writes(gateway.RouteList{}).
+			Returns(http.StatusOK, "OK", gateway.RouteList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/httproute/{namespace}/{name}").
+			To(apiHandler.handleGetHTTPRouteDetail).
+			// docs
+			Doc("returns detailed information about HTTPRoute").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the HTTPRoute")).
+			Param(apiV1Ws.PathParameter("name", "name of the HTTPRoute")).
+			Writes(gateway.RouteDetail{}).
+			Returns(http.StatusOK, "OK", gateway.RouteDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/grpcroute").
+			To(apiHandler.handleGetGRPCRouteList).
+			// docs
+			Doc("returns a list of GRPCRoutes from all namespaces").
+			Writes(gateway.RouteList{}).
+			Returns(http.StatusOK, "OK", gateway.RouteList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/grpcroute/{namespace}").
+			To(apiHandler.handleGetGRPCRouteList).
+			// docs
+			Doc("returns a list of GRPCRoutes from specified namespace").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the GRPCRoute")).
+			Writes(gateway.RouteList{}).
+			Returns(http.StatusOK, "OK", gateway.RouteList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/grpcroute/{namespace}/{name}").
+			To(apiHandler.handleGetGRPCRouteDetail).
+			// docs
+			Doc("returns detailed information about GRPCRoute").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the GRPCRoute")).
+			Param(apiV1Ws.PathParameter("name", "name of the GRPCRoute")).
+			Writes(gateway.RouteDetail{}).
+			Returns(http.StatusOK, "OK", gateway.RouteDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/referencegrant").
+			To(apiHandler.handleGetReferenceGrantList).
+			// docs
+			Doc("returns a list of ReferenceGrants from all namespaces").
+			Writes(gateway.ReferenceGrantList{}).
+			Returns(http.StatusOK, "OK", gateway.ReferenceGrantList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/referencegrant/{namespace}").
+			To(apiHandler.handleGetReferenceGrantList).
+			// docs
+			Doc("returns a list of ReferenceGrants from specified namespace").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the ReferenceGrant")).
+			Writes(gateway.ReferenceGrantList{}).
+			Returns(http.StatusOK, "OK", gateway.ReferenceGrantList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/referencegrant/{namespace}/{name}").
+			To(apiHandler.handleGetReferenceGrantDetail).
+			// docs
+			Doc("returns detailed information about ReferenceGrant").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the ReferenceGrant")).
+			Param(apiV1Ws.PathParameter("name", "name of the ReferenceGrant")).
+			Writes(gateway.ReferenceGrant{}).
+			Returns(http.StatusOK, "OK", gateway.ReferenceGrant{}))
+
+	// Logs
+	apiV1Ws.Route(
+		apiV1Ws.GET("/log/source/{namespace}/{resourceName}/{resourceType}").
+			To(apiHandler.handleLogSource).
+			// docs
+			Doc("returns log sources for a resource").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the resource")).
+			Param(apiV1Ws.PathParameter("resourceName", "name of the resource")).
+			Param(apiV1Ws.PathParameter("resourceType", "type of the resource")).
+			Writes(controller.LogSources{}).
+			Returns(http.StatusOK, "OK", controller.LogSources{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/log/{namespace}/{pod}").
+			To(apiHandler.handleLogs).
+			// docs
+			Doc("returns logs from a Pod").
+			Param(apiV1Ws.PathParameter("namespace", "namespace of the Pod")).
 			Param(apiV1Ws.PathParameter("pod", "name of the Pod")).
 			Writes(logs.LogDetails{}).
 			Returns(http.StatusOK, "OK", logs.LogDetails{}))
@@ -1277,15 +2391,1896 @@ func CreateHTTPAPIHandler(iManager integration.Manager) (*restful.Container, err
 	return wsContainer, nil
 }
 
-func (in *APIHandler) handleGetClusterRoleList(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetClusterRoleList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := clusterrole.GetClusterRoleList(k8sClient, dataSelect)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetClusterRoleDetail(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	name := request.PathParameter("name")
+	result, err := clusterrole.GetClusterRoleDetail(k8sClient, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetClusterRoleBindingList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := clusterrolebinding.GetClusterRoleBindingList(k8sClient, dataSelect)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetClusterRoleBindingDetail(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	name := request.PathParameter("name")
+	result, err := clusterrolebinding.GetClusterRoleBindingDetail(k8sClient, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetRoleList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := parseNamespacePathParameter(request)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := role.GetRoleList(k8sClient, namespace, dataSelect)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetRoleDetail(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	result, err := role.GetRoleDetail(k8sClient, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetRoleBindingList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := parseNamespacePathParameter(request)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := rolebinding.GetRoleBindingList(k8sClient, namespace, dataSelect)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetRoleBindingDetail(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	result, err := rolebinding.GetRoleBindingDetail(k8sClient, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetCsrfToken(request *restful.Request, response *restful.Response) {
+	action := request.PathParameter("action")
+	token := xsrftoken.Generate(csrf.Key(), "none", action)
+	_ = response.WriteHeaderAndEntity(http.StatusOK, csrf.Response{Token: token})
+}
+
+func (in *APIHandler) handleGetStatefulSetList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := parseNamespacePathParameter(request)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := statefulset.GetStatefulSetList(k8sClient, namespace, dataSelect,
+		in.iManager.Metric().Client())
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetStatefulSetDetail(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("statefulset")
+	result, err := statefulset.GetStatefulSetDetail(k8sClient, in.iManager.Metric().Client(), namespace, name)
+
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetStatefulSetPods(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("statefulset")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := statefulset.GetStatefulSetPods(k8sClient, in.iManager.Metric().Client(), dataSelect, name, namespace)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetStatefulSetEvents(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("statefulset")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetServiceList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := parseNamespacePathParameter(request)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := service.GetServiceList(k8sClient, namespace, dataSelect)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetServiceDetail(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("service")
+	result, err := service.GetServiceDetail(k8sClient, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetServiceEvent(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("service")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := service.GetServiceEvents(k8sClient, dataSelect, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetServiceAccountList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := parseNamespacePathParameter(request)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := serviceaccount.GetServiceAccountList(k8sClient, namespace, dataSelect)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetServiceAccountDetail(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("serviceaccount")
+	result, err := serviceaccount.GetServiceAccountDetail(k8sClient, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetServiceAccountImagePullSecrets(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("serviceaccount")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := serviceaccount.GetServiceAccountImagePullSecrets(k8sClient, namespace, name, dataSelect)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetServiceAccountSecrets(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("serviceaccount")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := serviceaccount.GetServiceAccountSecrets(k8sClient, namespace, name, dataSelect)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetIngressDetail(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	result, err := ingress.GetIngressDetail(k8sClient, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetIngressEvent(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("ingress")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.NoMetrics
+	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetIngressList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	namespace := parseNamespacePathParameter(request)
+	result, err := ingress.GetIngressList(k8sClient, namespace, dataSelect)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetServicePods(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("service")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := service.GetServicePods(k8sClient, in.iManager.Metric().Client(), namespace, name, dataSelect)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetServiceIngressList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("service")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.NoMetrics
+	result, err := service.GetServiceIngressList(k8sClient, dataSelect, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetServiceRouteList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	dynamicClient, err := getDynamicClient(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("service")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := gateway.GetServiceRouteList(k8sClient, dynamicClient, namespace, name, dataSelect)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetNetworkPolicyList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := parseNamespacePathParameter(request)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := networkpolicy.GetNetworkPolicyList(k8sClient, namespace, dataSelect)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetNetworkPolicyDetail(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("networkpolicy")
+	result, err := networkpolicy.GetNetworkPolicyDetail(k8sClient, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetNodeList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := node.GetNodeList(k8sClient, dataSelect, in.iManager.Metric().Client())
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetNodeDetail(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	name := request.PathParameter("name")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := node.GetNodeDetail(k8sClient, in.iManager.Metric().Client(), name, dataSelect)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetNodeEvents(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	name := request.PathParameter("name")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := event.GetNodeEvents(k8sClient, dataSelect, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetNodePods(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	name := request.PathParameter("name")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := node.GetNodePods(k8sClient, in.iManager.Metric().Client(), dataSelect, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleNodeDrain(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	name := request.PathParameter("name")
+	spec := new(node.NodeDrainSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := node.StartNodeDrain(k8sClient, name, spec)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusAccepted, result)
+}
+
+func (in *APIHandler) handleGetNodeDrain(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	name := request.PathParameter("name")
+	id := request.PathParameter("id")
+	result, err := node.GetNodeDrain(k8sClient, name, id)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleWatchNodeDrain(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	name := request.PathParameter("name")
+	id := request.PathParameter("id")
+	if _, err := node.GetNodeDrain(k8sClient, name, id); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	response.AddHeader("Content-Type", "application/x-ndjson")
+	response.WriteHeader(http.StatusOK)
+	encoder := json.NewEncoder(response)
+	err = node.WatchNodeDrain(request.Request.Context(), k8sClient, name, id, func(event node.DrainEvent) error {
+		if err := encoder.Encode(event); err != nil {
+			return err
+		}
+
+		response.Flush()
+		return nil
+	})
+	if err != nil {
+		klog.V(args.LogLevelVerbose).Infof("Stopped streaming drain operation %s of %s node: %v", id, name, err)
+	}
+}
+
+func (in *APIHandler) handleCancelNodeDrain(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	name := request.PathParameter("name")
+	id := request.PathParameter("id")
+	result, err := node.CancelNodeDrain(k8sClient, name, id)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleNodeCordon(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := node.CordonNode(k8sClient, request.PathParameter("name"))
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleNodeUncordon(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := node.UncordonNode(k8sClient, request.PathParameter("name"))
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleAddNodeTaint(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	spec := new(node.TaintSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := node.AddNodeTaint(k8sClient, request.PathParameter("name"), spec)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleUpdateNodeTaint(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	spec := new(node.TaintSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := node.UpdateNodeTaint(k8sClient, request.PathParameter("name"), spec)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleRemoveNodeTaint(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	key := request.QueryParameter("key")
+	if len(key) == 0 {
+		errors.HandleInternalError(response, errors.NewBadRequest("key must not be empty"))
+		return
+	}
+
+	effect := v1.TaintEffect(request.QueryParameter("effect"))
+	result, err := node.RemoveNodeTaint(k8sClient, request.PathParameter("name"), key, effect)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleUpdateNodeLabels(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	spec := new(node.NodeLabelsSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := node.UpdateNodeLabels(k8sClient, request.PathParameter("name"), spec)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleDeploy(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	appDeploymentSpec := new(deployment.AppDeploymentSpec)
+	if err := request.ReadEntity(appDeploymentSpec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	if err := deployment.DeployApp(appDeploymentSpec, k8sClient); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusCreated, appDeploymentSpec)
+}
+
+func (in *APIHandler) handleBulkAction(request *restful.Request, response *restful.Response) {
+	cfg, err := client.Config(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	spec := new(bulk.ActionSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := bulk.PerformAction(cfg, spec)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleExportResource(request *restful.Request, response *restful.Response) {
+	verber, err := client.VerberClient(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	cfg, err := client.Config(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	format, err := export.ParseFormat(request.QueryParameter("format"))
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	ref := bulk.ObjectReference{
+		Kind:      request.PathParameter("kind"),
+		Namespace: request.PathParameters()["namespace"],
+		Name:      request.PathParameter("name"),
+	}
+	stripDefaults := request.QueryParameter("stripDefaults") == "true"
+	result, err := export.ExportResource(verber, cfg, ref, format, stripDefaults)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	writeExport(response, result, format)
+}
+
+func (in *APIHandler) handleExportResources(request *restful.Request, response *restful.Response) {
+	verber, err := client.VerberClient(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	cfg, err := client.Config(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	spec := new(export.ExportSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	if spec.Format, err = export.ParseFormat(string(spec.Format)); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := export.ExportResources(verber, cfg, spec)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	writeExport(response, result, spec.Format)
+}
+
+func writeExport(response *restful.Response, data []byte, format export.Format) {
+	response.AddHeader(restful.HEADER_ContentType, format.ContentType())
+	response.WriteHeader(http.StatusOK)
+	_, _ = response.Write(data)
+}
+
+func (in *APIHandler) handleExportNamespaceBundle(request *restful.Request, response *restful.Response) {
+	cfg, err := client.Config(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	opts := export.BundleExportOptions{
+		IncludeSecrets: request.QueryParameter("includeSecrets") == "true",
+		StripDefaults:  request.QueryParameter("stripDefaults") == "true",
+	}
+	result, err := export.ExportNamespaceBundle(cfg, namespace, opts)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	response.AddHeader(restful.HEADER_ContentType, export.BundleContentType)
+	response.AddHeader("Content-Disposition", mime.FormatMediaType("attachment",
+		map[string]string{"filename": namespace + ".tar.gz"}))
+	response.WriteHeader(http.StatusOK)
+	_, _ = response.Write(result)
+}
+
+func (in *APIHandler) handleImportNamespaceBundle(request *restful.Request, response *restful.Response) {
+	cfg, err := client.Config(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	opts := export.BundleImportOptions{
+		Prefix:          request.QueryParameter("prefix"),
+		Suffix:          request.QueryParameter("suffix"),
+		CreateNamespace: request.QueryParameter("createNamespace") == "true",
+		DryRun:          request.QueryParameter("dryRun") == "true",
+	}
+	body := http.MaxBytesReader(response.ResponseWriter, request.Request.Body, export.MaxBundleSize)
+	result, err := export.ImportNamespaceBundle(cfg, request.PathParameter("namespace"), body, opts)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetNamespaceDrift(request *restful.Request, response *restful.Response) {
+	cfg, err := client.Config(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := drift.GetNamespaceReport(cfg, request.PathParameter("namespace"))
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetDrift(request *restful.Request, response *restful.Response) {
+	verber, err := client.VerberClient(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	kind := request.PathParameter("kind")
+	namespace := request.PathParameters()["namespace"]
+	name := request.PathParameter("name")
+	result, err := drift.GetDrift(verber, kind, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetManifestDrift(request *restful.Request, response *restful.Response) {
+	verber, err := client.VerberClient(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	manifest, err := io.ReadAll(request.Request.Body)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	kind := request.PathParameter("kind")
+	namespace := request.PathParameters()["namespace"]
+	name := request.PathParameter("name")
+	result, err := drift.GetManifestDrift(verber, kind, namespace, name, manifest)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetOwnership(request *restful.Request, response *restful.Response) {
+	verber, err := client.VerberClient(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	kind := request.PathParameter("kind")
+	namespace := request.PathParameters()["namespace"]
+	name := request.PathParameter("name")
+	result, err := ownership.GetOwnership(verber, kind, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetGraph(request *restful.Request, response *restful.Response) {
+	cfg, err := client.Config(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	depth := graph.DefaultDepth
+	if value := request.QueryParameter("depth"); len(value) > 0 {
+		depth, err = strconv.Atoi(value)
+		if err != nil {
+			errors.HandleInternalError(response, errors.NewBadRequest("depth must be a number"))
+			return
+		}
+	}
+
+	kind := request.PathParameter("kind")
+	namespace := request.PathParameters()["namespace"]
+	name := request.PathParameter("name")
+	result, err := graph.GetGraph(cfg, kind, namespace, name, depth)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleSearch(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	apiextensionsclient, err := client.APIExtensionsClient(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	cfg, err := client.Config(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	limit := search.DefaultLimit
+	if value := request.QueryParameter("limit"); len(value) > 0 {
+		limit, err = strconv.Atoi(value)
+		if err != nil {
+			errors.HandleInternalError(response, errors.NewBadRequest("limit must be a number"))
+			return
+		}
+	}
+
+	namespace := parseNamespacePathParameter(request)
+	result, err := search.Search(k8sClient, apiextensionsclient, dynamicClient, namespace, request.QueryParameter("query"), limit)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetOverview(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := parseNamespacePathParameter(request)
+	result, err := overview.GetOverview(k8sClient, namespace)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleScaleResource(request *restful.Request, response *restful.Response) {
+	cfg, err := client.Config(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	kind := request.PathParameter("kind")
+	name := request.PathParameter("name")
+	count := request.QueryParameter("scaleBy")
+	replicaCountSpec, err := scaling.ScaleResource(cfg, kind, namespace, name, count)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, replicaCountSpec)
+}
+
+func (in *APIHandler) handleGetReplicaCount(request *restful.Request, response *restful.Response) {
+	cfg, err := client.Config(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	kind := request.PathParameter("kind")
+	name := request.PathParameter("name")
+	replicaCounts, err := scaling.GetReplicaCounts(cfg, kind, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, replicaCounts)
+}
+
+func (in *APIHandler) handleDeployFromFile(request *restful.Request, response *restful.Response) {
+	cfg, err := client.Config(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	deploymentSpec := new(deployment.AppDeploymentFromFileSpec)
+	if err := request.ReadEntity(deploymentSpec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	isDeployed, err := deployment.DeployAppFromFile(cfg, deploymentSpec)
+	if !isDeployed {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	errorMessage := ""
+	if err != nil {
+		errorMessage = err.Error()
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusCreated, deployment.AppDeploymentFromFileResponse{
+		Name:    deploymentSpec.Name,
+		Content: deploymentSpec.Content,
+		Error:   errorMessage,
+	})
+}
+
+func (in *APIHandler) handleDeploymentPause(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("deployment")
+	deploymentSpec, err := deployment.PauseDeployment(k8sClient, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, deploymentSpec)
+}
+
+func (in *APIHandler) handleDeploymentRollback(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	rolloutSpec := new(deployment.RolloutSpec)
+	if err := request.ReadEntity(rolloutSpec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("deployment")
+	rolloutSpec, err = deployment.RollbackDeployment(k8sClient, rolloutSpec, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, rolloutSpec)
+}
+
+func (in *APIHandler) handleDeploymentRestart(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("deployment")
+	rolloutSpec, err := deployment.RestartDeployment(k8sClient, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, rolloutSpec)
+}
+
+func (in *APIHandler) handleDeploymentResume(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("deployment")
+	deploymentSpec, err := deployment.ResumeDeployment(k8sClient, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, deploymentSpec)
+}
+
+func (in *APIHandler) handleNameValidity(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	spec := new(validation.AppNameValiditySpec)
+	if err := request.ReadEntity(spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	validity, err := validation.ValidateAppName(spec, k8sClient)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, validity)
+}
+
+func (in *APIHandler) handleImageReferenceValidity(request *restful.Request, response *restful.Response) {
+	spec := new(validation.ImageReferenceValiditySpec)
+	if err := request.ReadEntity(spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	validity, err := validation.ValidateImageReference(spec)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, validity)
+}
+
+func (in *APIHandler) handleProtocolValidity(request *restful.Request, response *restful.Response) {
+	spec := new(validation.ProtocolValiditySpec)
+	if err := request.ReadEntity(spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, validation.ValidateProtocol(spec))
+}
+
+func (in *APIHandler) handleGetAvailableProtocols(request *restful.Request, response *restful.Response) {
+	_ = response.WriteHeaderAndEntity(http.StatusOK, deployment.GetAvailableProtocols())
+}
+
+func (in *APIHandler) handleGetReplicationControllerList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := parseNamespacePathParameter(request)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := replicationcontroller.GetReplicationControllerList(k8sClient, namespace, dataSelect, in.iManager.Metric().Client())
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetReplicaSets(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := parseNamespacePathParameter(request)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := replicaset.GetReplicaSetList(k8sClient, namespace, dataSelect, in.iManager.Metric().Client())
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetReplicaSetDetail(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	replicaSet := request.PathParameter("replicaSet")
+	result, err := replicaset.GetReplicaSetDetail(k8sClient, in.iManager.Metric().Client(), namespace, replicaSet)
+
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetReplicaSetPods(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	replicaSet := request.PathParameter("replicaSet")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := replicaset.GetReplicaSetPods(k8sClient, in.iManager.Metric().Client(), dataSelect, replicaSet, namespace)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetReplicaSetServices(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	replicaSet := request.PathParameter("replicaSet")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := replicaset.GetReplicaSetServices(k8sClient, dataSelect, namespace, replicaSet)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetReplicaSetEvents(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("replicaSet")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+
+}
+
+func (in *APIHandler) handleGetPodEvents(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	klog.V(4).Info("Getting events related to a pod in namespace")
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("pod")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := pod.GetEventsForPod(k8sClient, dataSelect, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Handles execute shell API call
+func (in *APIHandler) handleExecShell(request *restful.Request, response *restful.Response) {
+	sessionID, err := genTerminalSessionId()
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	cfg, err := client.Config(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	terminalSessions.Set(sessionID, TerminalSession{
+		id:       sessionID,
+		bound:    make(chan error),
+		sizeChan: make(chan remotecommand.TerminalSize),
+	})
+	go WaitForTerminal(k8sClient, cfg, request, sessionID)
+	_ = response.WriteHeaderAndEntity(http.StatusOK, TerminalResponse{ID: sessionID})
+}
+
+func (in *APIHandler) handleGetDeployments(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := parseNamespacePathParameter(request)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := deployment.GetDeploymentList(k8sClient, namespace, dataSelect, in.iManager.Metric().Client())
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetDeploymentDetail(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("deployment")
+	result, err := deployment.GetDeploymentDetail(k8sClient, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetDeploymentEvents(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("deployment")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetDeploymentOldReplicaSets(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("deployment")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := deployment.GetDeploymentOldReplicaSets(k8sClient, dataSelect, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetDeploymentNewReplicaSet(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("deployment")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := deployment.GetDeploymentNewReplicaSet(k8sClient, dataSelect, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetPods(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := parseNamespacePathParameter(request)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics // download standard metrics - cpu, and memory - by default
+	result, err := pod.GetPodList(k8sClient, in.iManager.Metric().Client(), namespace, dataSelect)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetPodDetail(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("pod")
+	result, err := pod.GetPodDetail(k8sClient, in.iManager.Metric().Client(), namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetReplicationControllerDetail(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("replicationController")
+	result, err := replicationcontroller.GetReplicationControllerDetail(k8sClient, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleUpdateReplicasCount(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("replicationController")
+	spec := new(replicationcontroller.ReplicationControllerSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	if err := replicationcontroller.UpdateReplicasCount(k8sClient, namespace, name, spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (in *APIHandler) handleGetResource(request *restful.Request, response *restful.Response) {
+	verber, err := client.VerberClient(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	kind := request.PathParameter("kind")
+	namespace := request.PathParameters()["namespace"]
+	name := request.PathParameter("name")
+	result, err := verber.Get(kind, namespace, name)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handlePutResource(
+	request *restful.Request, response *restful.Response) {
+	verber, err := client.VerberClient(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	raw := &unstructured.Unstructured{}
+	bytes, err := io.ReadAll(request.Request.Body)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	err = raw.UnmarshalJSON(bytes)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	if err = verber.Update(raw); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+func (in *APIHandler) handlePatchResource(
+	request *restful.Request, response *restful.Response) {
+	verber, err := client.VerberClient(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	contentType, _, err := mime.ParseMediaType(request.HeaderParameter(restful.HEADER_ContentType))
+	if err != nil {
+		errors.HandleInternalError(response, errors.NewBadRequest(err.Error()))
+		return
+	}
+
+	data, err := io.ReadAll(request.Request.Body)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	kind := request.PathParameter("kind")
+	namespace := request.PathParameters()["namespace"]
+	name := request.PathParameter("name")
+	result, err := verber.Patch(kind, namespace, name, k8stypes.PatchType(contentType), data)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetResourceByGVR(request *restful.Request, response *restful.Response) {
+	verber, err := client.VerberClient(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	gvr := parseGroupVersionResourcePathParameters(request)
+	namespace := request.PathParameters()["namespace"]
+	name := request.PathParameter("name")
+	subresource := request.PathParameters()["subresource"]
+	result, err := verber.GetResource(gvr, namespace, name, subresource)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handlePutResourceByGVR(request *restful.Request, response *restful.Response) {
+	verber, err := client.VerberClient(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	raw := &unstructured.Unstructured{}
+	bytes, err := io.ReadAll(request.Request.Body)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	if err = raw.UnmarshalJSON(bytes); err != nil {
+		errors.HandleInternalError(response, errors.NewBadRequest(err.Error()))
+		return
+	}
+
+	namespace := request.PathParameters()["namespace"]
+	name := request.PathParameter("name")
+	if raw.GetName() != name || raw.GetNamespace() != namespace {
+		errors.HandleInternalError(response, errors.NewBadRequest("name and namespace of the object must match the request path"))
+		return
+	}
+
+	gvr := parseGroupVersionResourcePathParameters(request)
+	subresource := request.PathParameters()["subresource"]
+	if err = verber.UpdateResource(gvr, raw, subresource); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+func (in *APIHandler) handlePatchResourceByGVR(request *restful.Request, response *restful.Response) {
+	verber, err := client.VerberClient(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	contentType, _, err := mime.ParseMediaType(request.HeaderParameter(restful.HEADER_ContentType))
+	if err != nil {
+		errors.HandleInternalError(response, errors.NewBadRequest(err.Error()))
+		return
+	}
+
+	data, err := io.ReadAll(request.Request.Body)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	gvr := parseGroupVersionResourcePathParameters(request)
+	namespace := request.PathParameters()["namespace"]
+	name := request.PathParameter("name")
+	subresource := request.PathParameters()["subresource"]
+	result, err := verber.PatchResource(gvr, namespace, name, subresource, k8stypes.PatchType(contentType), data)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleDeleteResourceByGVR(request *restful.Request, response *restful.Response) {
+	verber, err := client.VerberClient(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	gvr := parseGroupVersionResourcePathParameters(request)
+	namespace := request.PathParameters()["namespace"]
+	name := request.PathParameter("name")
+	propagation := request.QueryParameter("propagation")
+	deleteNow := request.QueryParameter("deleteNow") == "true"
+
+	if err := verber.DeleteResource(gvr, namespace, name, propagation, deleteNow); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+func (in *APIHandler) handleDeleteResource(
+	request *restful.Request, response *restful.Response) {
+	verber, err := client.VerberClient(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	kind := request.PathParameter("kind")
+	namespace := request.PathParameters()["namespace"]
+	name := request.PathParameter("name")
+	propagation := request.QueryParameter("propagation")
+	deleteNow := request.QueryParameter("deleteNow") == "true"
+
+	if err := verber.Delete(kind, namespace, name, propagation, deleteNow); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+func (in *APIHandler) handleGetReplicationControllerPods(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	rc := request.PathParameter("replicationController")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := replicationcontroller.GetReplicationControllerPods(k8sClient, in.iManager.Metric().Client(), dataSelect, rc, namespace)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleCreateNamespace(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespaceSpec := new(ns.NamespaceSpec)
+	if err := request.ReadEntity(namespaceSpec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	if err := ns.CreateNamespace(namespaceSpec, k8sClient); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusCreated, namespaceSpec)
+}
+
+func (in *APIHandler) handleGetNamespaces(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := ns.GetNamespaceList(k8sClient, dataSelect)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleGetNamespaceDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := clusterrole.GetClusterRoleList(k8sClient, dataSelect)
+	name := request.PathParameter("name")
+	result, err := ns.GetNamespaceDetail(k8sClient, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1293,7 +4288,7 @@ func (in *APIHandler) handleGetClusterRoleList(request *restful.Request, respons
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetClusterRoleDetail(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetNamespaceEvents(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -1301,7 +4296,8 @@ func (in *APIHandler) handleGetClusterRoleDetail(request *restful.Request, respo
 	}
 
 	name := request.PathParameter("name")
-	result, err := clusterrole.GetClusterRoleDetail(k8sClient, name)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := event.GetNamespaceEvents(k8sClient, dataSelect, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1309,7 +4305,7 @@ func (in *APIHandler) handleGetClusterRoleDetail(request *restful.Request, respo
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetClusterRoleBindingList(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetEventList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -1317,7 +4313,8 @@ func (in *APIHandler) handleGetClusterRoleBindingList(request *restful.Request,
 	}
 
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := clusterrolebinding.GetClusterRoleBindingList(k8sClient, dataSelect)
+	namespace := parseNamespacePathParameter(request)
+	result, err := event.GetEventList(k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1325,32 +4322,36 @@ func (in *APIHandler) handleGetClusterRoleBindingList(request *restful.Request,
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetClusterRoleBindingDetail(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleCreateImagePullSecret(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	name := request.PathParameter("name")
-	result, err := clusterrolebinding.GetClusterRoleBindingDetail(k8sClient, name)
+	spec := new(secret.ImagePullSecretSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	result, err := secret.CreateSecret(k8sClient, spec)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+	_ = response.WriteHeaderAndEntity(http.StatusCreated, result)
 }
 
-func (in *APIHandler) handleGetRoleList(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetSecretDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := parseNamespacePathParameter(request)
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := role.GetRoleList(k8sClient, namespace, dataSelect)
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	result, err := secret.GetSecretDetail(k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1358,16 +4359,16 @@ func (in *APIHandler) handleGetRoleList(request *restful.Request, response *rest
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetRoleDetail(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetSecretList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("name")
-	result, err := role.GetRoleDetail(k8sClient, namespace, name)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	namespace := parseNamespacePathParameter(request)
+	result, err := secret.GetSecretList(k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1375,16 +4376,16 @@ func (in *APIHandler) handleGetRoleDetail(request *restful.Request, response *re
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetRoleBindingList(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetHelmReleaseList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := rolebinding.GetRoleBindingList(k8sClient, namespace, dataSelect)
+	namespace := parseNamespacePathParameter(request)
+	result, err := helmrelease.GetReleaseList(k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1392,16 +4393,31 @@ func (in *APIHandler) handleGetRoleBindingList(request *restful.Request, respons
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetRoleBindingDetail(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetHelmReleaseDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
+	cfg, err := client.Config(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
-	result, err := rolebinding.GetRoleBindingDetail(k8sClient, namespace, name)
+	revision := 0
+	if value := request.PathParameter("revision"); len(value) > 0 {
+		revision, err = strconv.Atoi(value)
+		if err != nil || revision < 1 {
+			errors.HandleInternalError(response, errors.NewBadRequest("revision must be a positive number"))
+			return
+		}
+	}
+
+	result, err := helmrelease.GetReleaseRevisionDetail(k8sClient, cfg, namespace, name, revision)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1409,42 +4425,27 @@ func (in *APIHandler) handleGetRoleBindingDetail(request *restful.Request, respo
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetCsrfToken(request *restful.Request, response *restful.Response) {
-	action := request.PathParameter("action")
-	token := xsrftoken.Generate(csrf.Key(), "none", action)
-	_ = response.WriteHeaderAndEntity(http.StatusOK, csrf.Response{Token: token})
-}
-
-func (in *APIHandler) handleGetStatefulSetList(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleHelmReleaseRollback(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := parseNamespacePathParameter(request)
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := statefulset.GetStatefulSetList(k8sClient, namespace, dataSelect,
-		in.iManager.Metric().Client())
+	cfg, err := client.Config(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
-}
 
-func (in *APIHandler) handleGetStatefulSetDetail(request *restful.Request, response *restful.Response) {
-	k8sClient, err := client.Client(request.Request)
-	if err != nil {
+	spec := new(helmrelease.RollbackSpec)
+	if err := request.ReadEntity(spec); err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("statefulset")
-	result, err := statefulset.GetStatefulSetDetail(k8sClient, in.iManager.Metric().Client(), namespace, name)
-
+	name := request.PathParameter("name")
+	result, err := helmrelease.RollbackRelease(k8sClient, cfg, namespace, name, spec)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1452,18 +4453,22 @@ func (in *APIHandler) handleGetStatefulSetDetail(request *restful.Request, respo
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetStatefulSetPods(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleHelmReleaseUninstall(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
+	cfg, err := client.Config(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("statefulset")
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := statefulset.GetStatefulSetPods(k8sClient, in.iManager.Metric().Client(), dataSelect, name, namespace)
+	name := request.PathParameter("name")
+	result, err := helmrelease.UninstallRelease(k8sClient, cfg, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1471,17 +4476,16 @@ func (in *APIHandler) handleGetStatefulSetPods(request *restful.Request, respons
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetStatefulSetEvents(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetConfigMapList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("statefulset")
+	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
+	result, err := configmap.GetConfigMapList(k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1489,16 +4493,16 @@ func (in *APIHandler) handleGetStatefulSetEvents(request *restful.Request, respo
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetServiceList(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetConfigMapDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := parseNamespacePathParameter(request)
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := service.GetServiceList(k8sClient, namespace, dataSelect)
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("configmap")
+	result, err := configmap.GetConfigMapDetail(k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1506,16 +4510,15 @@ func (in *APIHandler) handleGetServiceList(request *restful.Request, response *r
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetServiceDetail(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetPersistentVolumeList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("service")
-	result, err := service.GetServiceDetail(k8sClient, namespace, name)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := persistentvolume.GetPersistentVolumeList(k8sClient, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1523,18 +4526,15 @@ func (in *APIHandler) handleGetServiceDetail(request *restful.Request, response
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetServiceEvent(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetPersistentVolumeDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("service")
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := service.GetServiceEvents(k8sClient, dataSelect, namespace, name)
+	name := request.PathParameter("persistentvolume")
+	result, err := persistentvolume.GetPersistentVolumeDetail(k8sClient, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1542,7 +4542,7 @@ func (in *APIHandler) handleGetServiceEvent(request *restful.Request, response *
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetServiceAccountList(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetPersistentVolumeClaimList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -1551,7 +4551,7 @@ func (in *APIHandler) handleGetServiceAccountList(request *restful.Request, resp
 
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := serviceaccount.GetServiceAccountList(k8sClient, namespace, dataSelect)
+	result, err := persistentvolumeclaim.GetPersistentVolumeClaimList(k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1559,7 +4559,7 @@ func (in *APIHandler) handleGetServiceAccountList(request *restful.Request, resp
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetServiceAccountDetail(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetPersistentVolumeClaimDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -1567,8 +4567,8 @@ func (in *APIHandler) handleGetServiceAccountDetail(request *restful.Request, re
 	}
 
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("serviceaccount")
-	result, err := serviceaccount.GetServiceAccountDetail(k8sClient, namespace, name)
+	name := request.PathParameter("name")
+	result, err := persistentvolumeclaim.GetPersistentVolumeClaimDetail(k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1576,7 +4576,7 @@ func (in *APIHandler) handleGetServiceAccountDetail(request *restful.Request, re
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetServiceAccountImagePullSecrets(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleResizePersistentVolumeClaim(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -1584,9 +4584,14 @@ func (in *APIHandler) handleGetServiceAccountImagePullSecrets(request *restful.R
 	}
 
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("serviceaccount")
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := serviceaccount.GetServiceAccountImagePullSecrets(k8sClient, namespace, name, dataSelect)
+	name := request.PathParameter("name")
+	spec := new(persistentvolumeclaim.ResizeSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := persistentvolumeclaim.ResizePersistentVolumeClaim(k8sClient, namespace, name, spec)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1594,17 +4599,23 @@ func (in *APIHandler) handleGetServiceAccountImagePullSecrets(request *restful.R
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetServiceAccountSecrets(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetPersistentVolumeClaimVolumeSnapshots(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
+	dynamicClient, err := getDynamicClient(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("serviceaccount")
+	name := request.PathParameter("name")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := serviceaccount.GetServiceAccountSecrets(k8sClient, namespace, name, dataSelect)
+	result, err := volumesnapshot.GetVolumeSnapshotList(k8sClient, dynamicClient, namespace, name, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1612,35 +4623,51 @@ func (in *APIHandler) handleGetServiceAccountSecrets(request *restful.Request, r
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetIngressDetail(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleCreateVolumeSnapshot(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
+	dynamicClient, err := getDynamicClient(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
-	result, err := ingress.GetIngressDetail(k8sClient, namespace, name)
+	spec := new(volumesnapshot.VolumeSnapshotSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := volumesnapshot.CreateVolumeSnapshot(k8sClient, dynamicClient, namespace, name, spec)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+	_ = response.WriteHeaderAndEntity(http.StatusCreated, result)
 }
 
-func (in *APIHandler) handleGetIngressEvent(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetVolumeSnapshotList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
+	dynamicClient, err := getDynamicClient(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("ingress")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.NoMetrics
-	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
+	result, err := volumesnapshot.GetVolumeSnapshotList(k8sClient, dynamicClient, namespace, "", dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1648,16 +4675,22 @@ func (in *APIHandler) handleGetIngressEvent(request *restful.Request, response *
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetIngressList(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetVolumeSnapshotDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	namespace := parseNamespacePathParameter(request)
-	result, err := ingress.GetIngressList(k8sClient, namespace, dataSelect)
+	dynamicClient, err := getDynamicClient(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	result, err := volumesnapshot.GetVolumeSnapshotDetail(k8sClient, dynamicClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1665,54 +4698,50 @@ func (in *APIHandler) handleGetIngressList(request *restful.Request, response *r
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetServicePods(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleRestoreVolumeSnapshot(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("service")
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := service.GetServicePods(k8sClient, in.iManager.Metric().Client(), namespace, name, dataSelect)
+	dynamicClient, err := getDynamicClient(request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
-}
 
-func (in *APIHandler) handleGetServiceIngressList(request *restful.Request, response *restful.Response) {
-	k8sClient, err := client.Client(request.Request)
-	if err != nil {
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	spec := new(volumesnapshot.RestoreSpec)
+	if err := request.ReadEntity(spec); err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("service")
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.NoMetrics
-	result, err := service.GetServiceIngressList(k8sClient, dataSelect, namespace, name)
+	result, err := volumesnapshot.RestoreVolumeSnapshot(k8sClient, dynamicClient, namespace, name, spec)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+	_ = response.WriteHeaderAndEntity(http.StatusCreated, result)
 }
 
-func (in *APIHandler) handleGetNetworkPolicyList(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetVolumeSnapshotClassList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := parseNamespacePathParameter(request)
+	dynamicClient, err := getDynamicClient(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := networkpolicy.GetNetworkPolicyList(k8sClient, namespace, dataSelect)
+	result, err := volumesnapshot.GetVolumeSnapshotClassList(k8sClient, dynamicClient, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1720,16 +4749,21 @@ func (in *APIHandler) handleGetNetworkPolicyList(request *restful.Request, respo
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetNetworkPolicyDetail(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetVolumeSnapshotClassDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("networkpolicy")
-	result, err := networkpolicy.GetNetworkPolicyDetail(k8sClient, namespace, name)
+	dynamicClient, err := getDynamicClient(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	name := request.PathParameter("name")
+	result, err := volumesnapshot.GetVolumeSnapshotClassDetail(k8sClient, dynamicClient, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1737,16 +4771,21 @@ func (in *APIHandler) handleGetNetworkPolicyDetail(request *restful.Request, res
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetNodeList(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetVolumeSnapshotContentList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
+	dynamicClient, err := getDynamicClient(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := node.GetNodeList(k8sClient, dataSelect, in.iManager.Metric().Client())
+	result, err := volumesnapshot.GetVolumeSnapshotContentList(k8sClient, dynamicClient, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1754,17 +4793,21 @@ func (in *APIHandler) handleGetNodeList(request *restful.Request, response *rest
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetNodeDetail(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetVolumeSnapshotContentDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
+	dynamicClient, err := getDynamicClient(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	name := request.PathParameter("name")
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := node.GetNodeDetail(k8sClient, in.iManager.Metric().Client(), name, dataSelect)
+	result, err := volumesnapshot.GetVolumeSnapshotContentDetail(k8sClient, dynamicClient, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1772,17 +4815,16 @@ func (in *APIHandler) handleGetNodeDetail(request *restful.Request, response *re
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetNodeEvents(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetPodDisruptionBudgetList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	name := request.PathParameter("name")
+	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := event.GetNodeEvents(k8sClient, dataSelect, name)
+	result, err := poddisruptionbudget.List(k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1790,17 +4832,16 @@ func (in *APIHandler) handleGetNodeEvents(request *restful.Request, response *re
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetNodePods(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetPodDisruptionBudgetDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
+	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := node.GetNodePods(k8sClient, in.iManager.Metric().Client(), dataSelect, name)
+	result, err := poddisruptionbudget.Get(k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -1808,116 +4849,130 @@ func (in *APIHandler) handleGetNodePods(request *restful.Request, response *rest
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleNodeDrain(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleEvictPod(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	name := request.PathParameter("name")
-	spec := new(node.NodeDrainSpec)
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("pod")
+	spec := new(poddisruptionbudget.EvictionSpec)
 	if err := request.ReadEntity(spec); err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	if err := node.DrainNode(k8sClient, name, spec); err != nil {
+	result, err := poddisruptionbudget.EvictPod(k8sClient, namespace, name, spec)
+	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	response.WriteHeader(http.StatusAccepted)
+	if result.Status == poddisruptionbudget.EvictionStatusBlocked {
+		_ = response.WriteHeaderAndEntity(http.StatusTooManyRequests, result)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleDeploy(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleEvictPods(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	appDeploymentSpec := new(deployment.AppDeploymentSpec)
-	if err := request.ReadEntity(appDeploymentSpec); err != nil {
+	namespace := request.PathParameter("namespace")
+	spec := new(poddisruptionbudget.BulkEvictionSpec)
+	if err := request.ReadEntity(spec); err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	if err := deployment.DeployApp(appDeploymentSpec, k8sClient); err != nil {
+
+	result, err := poddisruptionbudget.EvictPods(request.Request.Context(), k8sClient, namespace, spec)
+	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusCreated, appDeploymentSpec)
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleScaleResource(request *restful.Request, response *restful.Response) {
-	cfg, err := client.Config(request.Request)
+func (in *APIHandler) handleGetPodContainers(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
 	namespace := request.PathParameter("namespace")
-	kind := request.PathParameter("kind")
-	name := request.PathParameter("name")
-	count := request.QueryParameter("scaleBy")
-	replicaCountSpec, err := scaling.ScaleResource(cfg, kind, namespace, name, count)
+	name := request.PathParameter("pod")
+	result, err := container.GetPodContainers(k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusOK, replicaCountSpec)
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetReplicaCount(request *restful.Request, response *restful.Response) {
-	cfg, err := client.Config(request.Request)
+func (in *APIHandler) handleGetReplicationControllerEvents(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
 	namespace := request.PathParameter("namespace")
-	kind := request.PathParameter("kind")
-	name := request.PathParameter("name")
-	replicaCounts, err := scaling.GetReplicaCounts(cfg, kind, namespace, name)
+	name := request.PathParameter("replicationController")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusOK, replicaCounts)
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleDeployFromFile(request *restful.Request, response *restful.Response) {
-	cfg, err := client.Config(request.Request)
+func (in *APIHandler) handleGetReplicationControllerServices(request *restful.Request,
+	response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	deploymentSpec := new(deployment.AppDeploymentFromFileSpec)
-	if err := request.ReadEntity(deploymentSpec); err != nil {
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("replicationController")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := replicationcontroller.GetReplicationControllerServices(k8sClient, dataSelect, namespace, name)
+	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
 
-	isDeployed, err := deployment.DeployAppFromFile(cfg, deploymentSpec)
-	if !isDeployed {
+func (in *APIHandler) handleGetDaemonSetList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
+	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	errorMessage := ""
+	namespace := parseNamespacePathParameter(request)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := daemonset.GetDaemonSetList(k8sClient, namespace, dataSelect, in.iManager.Metric().Client())
 	if err != nil {
-		errorMessage = err.Error()
+		errors.HandleInternalError(response, err)
+		return
 	}
-
-	_ = response.WriteHeaderAndEntity(http.StatusCreated, deployment.AppDeploymentFromFileResponse{
-		Name:    deploymentSpec.Name,
-		Content: deploymentSpec.Content,
-		Error:   errorMessage,
-	})
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleDeploymentPause(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetDaemonSetDetail(
+	request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -1925,38 +4980,35 @@ func (in *APIHandler) handleDeploymentPause(request *restful.Request, response *
 	}
 
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("deployment")
-	deploymentSpec, err := deployment.PauseDeployment(k8sClient, namespace, name)
+	name := request.PathParameter("daemonSet")
+	result, err := daemonset.GetDaemonSetDetail(k8sClient, in.iManager.Metric().Client(), namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusOK, deploymentSpec)
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleDeploymentRollback(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetDaemonSetPods(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	rolloutSpec := new(deployment.RolloutSpec)
-	if err := request.ReadEntity(rolloutSpec); err != nil {
-		errors.HandleInternalError(response, err)
-		return
-	}
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("deployment")
-	rolloutSpec, err = deployment.RollbackDeployment(k8sClient, rolloutSpec, namespace, name)
+	name := request.PathParameter("daemonSet")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := daemonset.GetDaemonSetPods(k8sClient, in.iManager.Metric().Client(), dataSelect, name, namespace)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusOK, rolloutSpec)
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleDeploymentRestart(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetDaemonSetServices(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -1964,16 +5016,17 @@ func (in *APIHandler) handleDeploymentRestart(request *restful.Request, response
 	}
 
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("deployment")
-	rolloutSpec, err := deployment.RestartDeployment(k8sClient, namespace, name)
+	daemonSet := request.PathParameter("daemonSet")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := daemonset.GetDaemonSetServices(k8sClient, dataSelect, namespace, daemonSet)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusOK, rolloutSpec)
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleDeploymentResume(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetDaemonSetEvents(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -1981,66 +5034,52 @@ func (in *APIHandler) handleDeploymentResume(request *restful.Request, response
 	}
 
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("deployment")
-	deploymentSpec, err := deployment.ResumeDeployment(k8sClient, namespace, name)
+	name := request.PathParameter("daemonSet")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusOK, deploymentSpec)
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleNameValidity(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleDaemonSetRestart(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	spec := new(validation.AppNameValiditySpec)
-	if err := request.ReadEntity(spec); err != nil {
-		errors.HandleInternalError(response, err)
-		return
-	}
-
-	validity, err := validation.ValidateAppName(spec, k8sClient)
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("daemonSet")
+	result, err := daemonset.RestartDaemonSet(k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-
-	_ = response.WriteHeaderAndEntity(http.StatusOK, validity)
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleImageReferenceValidity(request *restful.Request, response *restful.Response) {
-	spec := new(validation.ImageReferenceValiditySpec)
-	if err := request.ReadEntity(spec); err != nil {
-		errors.HandleInternalError(response, err)
-		return
-	}
-
-	validity, err := validation.ValidateImageReference(spec)
+func (in *APIHandler) handleStatefulSetRestart(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusOK, validity)
-}
 
-func (in *APIHandler) handleProtocolValidity(request *restful.Request, response *restful.Response) {
-	spec := new(validation.ProtocolValiditySpec)
-	if err := request.ReadEntity(spec); err != nil {
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("statefulset")
+	result, err := statefulset.RestartStatefulSet(k8sClient, namespace, name)
+	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusOK, validation.ValidateProtocol(spec))
-}
-
-func (in *APIHandler) handleGetAvailableProtocols(request *restful.Request, response *restful.Response) {
-	_ = response.WriteHeaderAndEntity(http.StatusOK, deployment.GetAvailableProtocols())
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetReplicationControllerList(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetHorizontalPodAutoscalerList(request *restful.Request,
+	response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2049,8 +5088,7 @@ func (in *APIHandler) handleGetReplicationControllerList(request *restful.Reques
 
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := replicationcontroller.GetReplicationControllerList(k8sClient, namespace, dataSelect, in.iManager.Metric().Client())
+	result, err := horizontalpodautoscaler.GetHorizontalPodAutoscalerList(k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2058,17 +5096,18 @@ func (in *APIHandler) handleGetReplicationControllerList(request *restful.Reques
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetReplicaSets(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetHorizontalPodAutoscalerListForResource(request *restful.Request,
+	response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := parseNamespacePathParameter(request)
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := replicaset.GetReplicaSetList(k8sClient, namespace, dataSelect, in.iManager.Metric().Client())
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	kind := request.PathParameter("kind")
+	result, err := horizontalpodautoscaler.GetHorizontalPodAutoscalerListForResource(k8sClient, namespace, kind, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2076,7 +5115,7 @@ func (in *APIHandler) handleGetReplicaSets(request *restful.Request, response *r
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetReplicaSetDetail(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetHorizontalPodAutoscalerDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2084,18 +5123,16 @@ func (in *APIHandler) handleGetReplicaSetDetail(request *restful.Request, respon
 	}
 
 	namespace := request.PathParameter("namespace")
-	replicaSet := request.PathParameter("replicaSet")
-	result, err := replicaset.GetReplicaSetDetail(k8sClient, in.iManager.Metric().Client(), namespace, replicaSet)
-
+	name := request.PathParameter("horizontalpodautoscaler")
+	result, err := horizontalpodautoscaler.GetHorizontalPodAutoscalerDetail(k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetReplicaSetPods(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleCreateHorizontalPodAutoscaler(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2103,19 +5140,21 @@ func (in *APIHandler) handleGetReplicaSetPods(request *restful.Request, response
 	}
 
 	namespace := request.PathParameter("namespace")
-	replicaSet := request.PathParameter("replicaSet")
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := replicaset.GetReplicaSetPods(k8sClient, in.iManager.Metric().Client(), dataSelect, replicaSet, namespace)
-	if err != nil {
+	spec := new(horizontalpodautoscaler.HorizontalPodAutoscalerSpec)
+	if err := request.ReadEntity(spec); err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+	result, err := horizontalpodautoscaler.CreateHorizontalPodAutoscaler(k8sClient, namespace, spec)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusCreated, result)
 }
 
-func (in *APIHandler) handleGetReplicaSetServices(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleUpdateHorizontalPodAutoscaler(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2123,51 +5162,49 @@ func (in *APIHandler) handleGetReplicaSetServices(request *restful.Request, resp
 	}
 
 	namespace := request.PathParameter("namespace")
-	replicaSet := request.PathParameter("replicaSet")
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := replicaset.GetReplicaSetServices(k8sClient, dataSelect, namespace, replicaSet)
-	if err != nil {
+	name := request.PathParameter("horizontalpodautoscaler")
+	spec := new(horizontalpodautoscaler.HorizontalPodAutoscalerSpec)
+	if err := request.ReadEntity(spec); err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
+	result, err := horizontalpodautoscaler.UpdateHorizontalPodAutoscaler(k8sClient, namespace, name, spec)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetReplicaSetEvents(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetJobList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("replicaSet")
+	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
+	result, err := job.GetJobList(k8sClient, namespace, dataSelect, in.iManager.Metric().Client())
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
-
 }
 
-func (in *APIHandler) handleGetPodEvents(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetJobDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	klog.V(4).Info("Getting events related to a pod in namespace")
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("pod")
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := pod.GetEventsForPod(k8sClient, dataSelect, namespace, name)
+	name := request.PathParameter("name")
+	result, err := job.GetJobDetail(k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2175,46 +5212,36 @@ func (in *APIHandler) handleGetPodEvents(request *restful.Request, response *res
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-// Handles execute shell API call
-func (in *APIHandler) handleExecShell(request *restful.Request, response *restful.Response) {
-	sessionID, err := genTerminalSessionId()
-	if err != nil {
-		errors.HandleInternalError(response, err)
-		return
-	}
-
+func (in *APIHandler) handleGetJobPods(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	cfg, err := client.Config(request.Request)
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := job.GetJobPods(k8sClient, in.iManager.Metric().Client(), dataSelect, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
-		return
-	}
-
-	terminalSessions.Set(sessionID, TerminalSession{
-		id:       sessionID,
-		bound:    make(chan error),
-		sizeChan: make(chan remotecommand.TerminalSize),
-	})
-	go WaitForTerminal(k8sClient, cfg, request, sessionID)
-	_ = response.WriteHeaderAndEntity(http.StatusOK, TerminalResponse{ID: sessionID})
+		return
+	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetDeployments(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetJobEvents(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := parseNamespacePathParameter(request)
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := deployment.GetDeploymentList(k8sClient, namespace, dataSelect, in.iManager.Metric().Client())
+	result, err := job.GetJobEvents(k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2222,7 +5249,7 @@ func (in *APIHandler) handleGetDeployments(request *restful.Request, response *r
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetDeploymentDetail(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetJobIndexes(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2230,17 +5257,16 @@ func (in *APIHandler) handleGetDeploymentDetail(request *restful.Request, respon
 	}
 
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("deployment")
-	result, err := deployment.GetDeploymentDetail(k8sClient, namespace, name)
+	name := request.PathParameter("name")
+	result, err := job.GetJobIndexes(k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetDeploymentEvents(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleRetryJob(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2248,28 +5274,32 @@ func (in *APIHandler) handleGetDeploymentEvents(request *restful.Request, respon
 	}
 
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("deployment")
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
+	name := request.PathParameter("name")
+	spec := new(job.JobRetrySpec)
+	if err := request.ReadEntity(spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := job.RetryJob(k8sClient, namespace, name, spec)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+	_ = response.WriteHeaderAndEntity(http.StatusCreated, result)
 }
 
-func (in *APIHandler) handleGetDeploymentOldReplicaSets(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetCronJobList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("deployment")
+	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := deployment.GetDeploymentOldReplicaSets(k8sClient, dataSelect, namespace, name)
+	dataSelect.MetricQuery = dataselect.NoMetrics
+	result, err := cronjob.GetCronJobList(k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2277,18 +5307,25 @@ func (in *APIHandler) handleGetDeploymentOldReplicaSets(request *restful.Request
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetDeploymentNewReplicaSet(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetCronJobDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
+	runs := cronjob.DefaultNextRuns
+	if value := request.QueryParameter("runs"); len(value) > 0 {
+		runs, err = strconv.Atoi(value)
+		if err != nil || runs < 1 || runs > cronjob.MaxNextRuns {
+			errors.HandleInternalError(response, errors.NewBadRequest("runs must be a number between 1 and 100"))
+			return
+		}
+	}
+
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("deployment")
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := deployment.GetDeploymentNewReplicaSet(k8sClient, dataSelect, namespace, name)
+	name := request.PathParameter("name")
+	result, err := cronjob.GetCronJobDetail(k8sClient, namespace, name, runs)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2296,17 +5333,19 @@ func (in *APIHandler) handleGetDeploymentNewReplicaSet(request *restful.Request,
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetPods(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetCronJobJobs(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := parseNamespacePathParameter(request)
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	active := request.QueryParameter("active") != "false"
+
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics // download standard metrics - cpu, and memory - by default
-	result, err := pod.GetPodList(k8sClient, in.iManager.Metric().Client(), namespace, dataSelect)
+	result, err := cronjob.GetCronJobJobs(k8sClient, in.iManager.Metric().Client(), dataSelect, namespace, name, active)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2314,7 +5353,7 @@ func (in *APIHandler) handleGetPods(request *restful.Request, response *restful.
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetPodDetail(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetCronJobEvents(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2322,8 +5361,9 @@ func (in *APIHandler) handleGetPodDetail(request *restful.Request, response *res
 	}
 
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("pod")
-	result, err := pod.GetPodDetail(k8sClient, in.iManager.Metric().Client(), namespace, name)
+	name := request.PathParameter("name")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := cronjob.GetCronJobEvents(k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2331,7 +5371,7 @@ func (in *APIHandler) handleGetPodDetail(request *restful.Request, response *res
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetReplicationControllerDetail(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleTriggerCronJob(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2339,16 +5379,16 @@ func (in *APIHandler) handleGetReplicationControllerDetail(request *restful.Requ
 	}
 
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("replicationController")
-	result, err := replicationcontroller.GetReplicationControllerDetail(k8sClient, namespace, name)
+	name := request.PathParameter("name")
+	err = cronjob.TriggerCronJob(k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+	response.WriteHeader(http.StatusOK)
 }
 
-func (in *APIHandler) handleUpdateReplicasCount(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleSuspendCronJob(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2356,103 +5396,92 @@ func (in *APIHandler) handleUpdateReplicasCount(request *restful.Request, respon
 	}
 
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("replicationController")
-	spec := new(replicationcontroller.ReplicationControllerSpec)
-	if err := request.ReadEntity(spec); err != nil {
-		errors.HandleInternalError(response, err)
-		return
-	}
-
-	if err := replicationcontroller.UpdateReplicasCount(k8sClient, namespace, name, spec); err != nil {
+	name := request.PathParameter("name")
+	result, err := cronjob.SuspendCronJob(k8sClient, namespace, name)
+	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-
-	response.WriteHeader(http.StatusAccepted)
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetResource(request *restful.Request, response *restful.Response) {
-	verber, err := client.VerberClient(request.Request)
+func (in *APIHandler) handleResumeCronJob(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	kind := request.PathParameter("kind")
-	namespace := request.PathParameters()["namespace"]
+	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
-	result, err := verber.Get(kind, namespace, name)
+	result, err := cronjob.ResumeCronJob(k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handlePutResource(
-	request *restful.Request, response *restful.Response) {
-	verber, err := client.VerberClient(request.Request)
+func (in *APIHandler) handleGetStorageClassList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	raw := &unstructured.Unstructured{}
-	bytes, err := io.ReadAll(request.Request.Body)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := storageclass.GetStorageClassList(k8sClient, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
 
-	err = raw.UnmarshalJSON(bytes)
+func (in *APIHandler) handleGetStorageClass(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	if err = verber.Update(raw); err != nil {
+	name := request.PathParameter("storageclass")
+	result, err := storageclass.GetStorageClass(k8sClient, name)
+	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-
-	response.WriteHeader(http.StatusNoContent)
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleDeleteResource(
-	request *restful.Request, response *restful.Response) {
-	verber, err := client.VerberClient(request.Request)
+func (in *APIHandler) handleGetStorageClassPersistentVolumes(request *restful.Request,
+	response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	kind := request.PathParameter("kind")
-	namespace := request.PathParameters()["namespace"]
-	name := request.PathParameter("name")
-	propagation := request.QueryParameter("propagation")
-	deleteNow := request.QueryParameter("deleteNow") == "true"
-
-	if err := verber.Delete(kind, namespace, name, propagation, deleteNow); err != nil {
+	name := request.PathParameter("storageclass")
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := persistentvolume.GetStorageClassPersistentVolumes(k8sClient,
+		name, dataSelect)
+	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-
-	response.WriteHeader(http.StatusNoContent)
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetReplicationControllerPods(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetIngressClassList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	rc := request.PathParameter("replicationController")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := replicationcontroller.GetReplicationControllerPods(k8sClient, in.iManager.Metric().Client(), dataSelect, rc, namespace)
+	result, err := ingressclass.GetIngressClassList(k8sClient, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2460,26 +5489,23 @@ func (in *APIHandler) handleGetReplicationControllerPods(request *restful.Reques
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleCreateNamespace(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetIngressClass(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespaceSpec := new(ns.NamespaceSpec)
-	if err := request.ReadEntity(namespaceSpec); err != nil {
-		errors.HandleInternalError(response, err)
-		return
-	}
-	if err := ns.CreateNamespace(namespaceSpec, k8sClient); err != nil {
+	name := request.PathParameter("ingressclass")
+	result, err := ingressclass.GetIngressClass(k8sClient, name)
+	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusCreated, namespaceSpec)
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetNamespaces(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetPriorityClassList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2487,7 +5513,7 @@ func (in *APIHandler) handleGetNamespaces(request *restful.Request, response *re
 	}
 
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := ns.GetNamespaceList(k8sClient, dataSelect)
+	result, err := priorityclass.GetPriorityClassList(k8sClient, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2495,7 +5521,7 @@ func (in *APIHandler) handleGetNamespaces(request *restful.Request, response *re
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetNamespaceDetail(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetPriorityClassDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2503,7 +5529,7 @@ func (in *APIHandler) handleGetNamespaceDetail(request *restful.Request, respons
 	}
 
 	name := request.PathParameter("name")
-	result, err := ns.GetNamespaceDetail(k8sClient, name)
+	result, err := priorityclass.GetPriorityClass(k8sClient, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2511,7 +5537,7 @@ func (in *APIHandler) handleGetNamespaceDetail(request *restful.Request, respons
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetNamespaceEvents(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetPriorityClassPods(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2520,7 +5546,8 @@ func (in *APIHandler) handleGetNamespaceEvents(request *restful.Request, respons
 
 	name := request.PathParameter("name")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := event.GetNamespaceEvents(k8sClient, dataSelect, name)
+	dataSelect.MetricQuery = dataselect.StandardMetrics
+	result, err := priorityclass.GetPriorityClassPods(k8sClient, in.iManager.Metric().Client(), dataSelect, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2528,7 +5555,7 @@ func (in *APIHandler) handleGetNamespaceEvents(request *restful.Request, respons
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetEventList(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetRuntimeClassList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2536,8 +5563,7 @@ func (in *APIHandler) handleGetEventList(request *restful.Request, response *res
 	}
 
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	namespace := parseNamespacePathParameter(request)
-	result, err := event.GetEventList(k8sClient, namespace, dataSelect)
+	result, err := runtimeclass.GetRuntimeClassList(k8sClient, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2545,36 +5571,32 @@ func (in *APIHandler) handleGetEventList(request *restful.Request, response *res
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleCreateImagePullSecret(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetRuntimeClassDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	spec := new(secret.ImagePullSecretSpec)
-	if err := request.ReadEntity(spec); err != nil {
-		errors.HandleInternalError(response, err)
-		return
-	}
-	result, err := secret.CreateSecret(k8sClient, spec)
+	name := request.PathParameter("name")
+	result, err := runtimeclass.GetRuntimeClass(k8sClient, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusCreated, result)
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetSecretDetail(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetLeaseList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("name")
-	result, err := secret.GetSecretDetail(k8sClient, namespace, name)
+	namespace := parseNamespacePathParameter(request)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := lease.GetLeaseList(k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2582,16 +5604,16 @@ func (in *APIHandler) handleGetSecretDetail(request *restful.Request, response *
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetSecretList(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetLeaseDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	namespace := parseNamespacePathParameter(request)
-	result, err := secret.GetSecretList(k8sClient, namespace, dataSelect)
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	result, err := lease.GetLeaseDetail(k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2599,7 +5621,7 @@ func (in *APIHandler) handleGetSecretList(request *restful.Request, response *re
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetConfigMapList(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetResourceQuotaList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2608,7 +5630,7 @@ func (in *APIHandler) handleGetConfigMapList(request *restful.Request, response
 
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := configmap.GetConfigMapList(k8sClient, namespace, dataSelect)
+	result, err := resourcequota.GetResourceQuotaList(k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2616,7 +5638,7 @@ func (in *APIHandler) handleGetConfigMapList(request *restful.Request, response
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetConfigMapDetail(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetResourceQuotaDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2624,8 +5646,8 @@ func (in *APIHandler) handleGetConfigMapDetail(request *restful.Request, respons
 	}
 
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("configmap")
-	result, err := configmap.GetConfigMapDetail(k8sClient, namespace, name)
+	name := request.PathParameter("name")
+	result, err := resourcequota.GetResourceQuota(k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2633,48 +5655,44 @@ func (in *APIHandler) handleGetConfigMapDetail(request *restful.Request, respons
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetPersistentVolumeList(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleCreateResourceQuota(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := persistentvolume.GetPersistentVolumeList(k8sClient, dataSelect)
-	if err != nil {
+	namespace := request.PathParameter("namespace")
+	spec := new(resourcequota.ResourceQuotaSpec)
+	if err := request.ReadEntity(spec); err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
-}
 
-func (in *APIHandler) handleGetPersistentVolumeDetail(request *restful.Request, response *restful.Response) {
-	k8sClient, err := client.Client(request.Request)
+	result, err := resourcequota.CreateResourceQuota(k8sClient, namespace, spec)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
+	_ = response.WriteHeaderAndEntity(http.StatusCreated, result)
+}
 
-	name := request.PathParameter("persistentvolume")
-	result, err := persistentvolume.GetPersistentVolumeDetail(k8sClient, name)
+func (in *APIHandler) handleUpdateResourceQuota(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
-}
 
-func (in *APIHandler) handleGetPersistentVolumeClaimList(request *restful.Request, response *restful.Response) {
-	k8sClient, err := client.Client(request.Request)
-	if err != nil {
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	spec := new(resourcequota.ResourceQuotaSpec)
+	if err := request.ReadEntity(spec); err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := parseNamespacePathParameter(request)
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := persistentvolumeclaim.GetPersistentVolumeClaimList(k8sClient, namespace, dataSelect)
+	result, err := resourcequota.UpdateResourceQuota(k8sClient, namespace, name, spec)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2682,7 +5700,7 @@ func (in *APIHandler) handleGetPersistentVolumeClaimList(request *restful.Reques
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetPersistentVolumeClaimDetail(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetResourceQuotaHeadroom(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2690,8 +5708,13 @@ func (in *APIHandler) handleGetPersistentVolumeClaimDetail(request *restful.Requ
 	}
 
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("name")
-	result, err := persistentvolumeclaim.GetPersistentVolumeClaimDetail(k8sClient, namespace, name)
+	spec := new(resourcequota.HeadroomSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := resourcequota.GetHeadroom(k8sClient, namespace, spec)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2699,7 +5722,7 @@ func (in *APIHandler) handleGetPersistentVolumeClaimDetail(request *restful.Requ
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetPodDisruptionBudgetList(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetLimitRangeList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2708,7 +5731,7 @@ func (in *APIHandler) handleGetPodDisruptionBudgetList(request *restful.Request,
 
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := poddisruptionbudget.List(k8sClient, namespace, dataSelect)
+	result, err := limitrange.GetLimitRangeList(k8sClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2716,7 +5739,7 @@ func (in *APIHandler) handleGetPodDisruptionBudgetList(request *restful.Request,
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetPodDisruptionBudgetDetail(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetLimitRangeDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2725,7 +5748,7 @@ func (in *APIHandler) handleGetPodDisruptionBudgetDetail(request *restful.Reques
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
-	result, err := poddisruptionbudget.Get(k8sClient, namespace, name)
+	result, err := limitrange.GetLimitRange(k8sClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2733,7 +5756,7 @@ func (in *APIHandler) handleGetPodDisruptionBudgetDetail(request *restful.Reques
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetPodContainers(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleCreateLimitRange(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2741,16 +5764,21 @@ func (in *APIHandler) handleGetPodContainers(request *restful.Request, response
 	}
 
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("pod")
-	result, err := container.GetPodContainers(k8sClient, namespace, name)
+	spec := new(limitrange.LimitRangeSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := limitrange.CreateLimitRange(k8sClient, namespace, spec)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+	_ = response.WriteHeaderAndEntity(http.StatusCreated, result)
 }
 
-func (in *APIHandler) handleGetReplicationControllerEvents(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleUpdateLimitRange(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
@@ -2758,9 +5786,14 @@ func (in *APIHandler) handleGetReplicationControllerEvents(request *restful.Requ
 	}
 
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("replicationController")
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
+	name := request.PathParameter("name")
+	spec := new(limitrange.LimitRangeSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := limitrange.UpdateLimitRange(k8sClient, namespace, name, spec)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2768,18 +5801,15 @@ func (in *APIHandler) handleGetReplicationControllerEvents(request *restful.Requ
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetReplicationControllerServices(request *restful.Request,
-	response *restful.Response) {
+func (in *APIHandler) handleGetCSIDriverList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("replicationController")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := replicationcontroller.GetReplicationControllerServices(k8sClient, dataSelect, namespace, name)
+	result, err := csidriver.GetCSIDriverList(k8sClient, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2787,17 +5817,15 @@ func (in *APIHandler) handleGetReplicationControllerServices(request *restful.Re
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetDaemonSetList(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetCSIDriverDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := parseNamespacePathParameter(request)
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := daemonset.GetDaemonSetList(k8sClient, namespace, dataSelect, in.iManager.Metric().Client())
+	name := request.PathParameter("name")
+	result, err := csidriver.GetCSIDriver(k8sClient, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2805,17 +5833,15 @@ func (in *APIHandler) handleGetDaemonSetList(request *restful.Request, response
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetDaemonSetDetail(
-	request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetCSINodeList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("daemonSet")
-	result, err := daemonset.GetDaemonSetDetail(k8sClient, in.iManager.Metric().Client(), namespace, name)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := csinode.GetCSINodeList(k8sClient, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2823,18 +5849,15 @@ func (in *APIHandler) handleGetDaemonSetDetail(
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetDaemonSetPods(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetCSINodeDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("daemonSet")
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := daemonset.GetDaemonSetPods(k8sClient, in.iManager.Metric().Client(), dataSelect, name, namespace)
+	name := request.PathParameter("name")
+	result, err := csinode.GetCSINode(k8sClient, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2842,17 +5865,15 @@ func (in *APIHandler) handleGetDaemonSetPods(request *restful.Request, response
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetDaemonSetServices(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetVolumeAttachmentList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	daemonSet := request.PathParameter("daemonSet")
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := daemonset.GetDaemonSetServices(k8sClient, dataSelect, namespace, daemonSet)
+	result, err := volumeattachment.GetVolumeAttachmentList(k8sClient, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2860,17 +5881,15 @@ func (in *APIHandler) handleGetDaemonSetServices(request *restful.Request, respo
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetDaemonSetEvents(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetVolumeAttachmentDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("daemonSet")
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
+	name := request.PathParameter("name")
+	result, err := volumeattachment.GetVolumeAttachment(k8sClient, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2878,16 +5897,15 @@ func (in *APIHandler) handleGetDaemonSetEvents(request *restful.Request, respons
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleDaemonSetRestart(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetValidatingWebhookConfigurationList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("daemonSet")
-	result, err := daemonset.RestartDaemonSet(k8sClient, namespace, name)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := admission.GetValidatingWebhookConfigurationList(k8sClient, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2895,16 +5913,15 @@ func (in *APIHandler) handleDaemonSetRestart(request *restful.Request, response
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleStatefulSetRestart(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetValidatingWebhookConfigurationDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("statefulset")
-	result, err := statefulset.RestartStatefulSet(k8sClient, namespace, name)
+	name := request.PathParameter("name")
+	result, err := admission.GetValidatingWebhookConfigurationDetail(k8sClient, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2912,17 +5929,15 @@ func (in *APIHandler) handleStatefulSetRestart(request *restful.Request, respons
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetHorizontalPodAutoscalerList(request *restful.Request,
-	response *restful.Response) {
+func (in *APIHandler) handleGetMutatingWebhookConfigurationList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := horizontalpodautoscaler.GetHorizontalPodAutoscalerList(k8sClient, namespace, dataSelect)
+	result, err := admission.GetMutatingWebhookConfigurationList(k8sClient, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2930,18 +5945,15 @@ func (in *APIHandler) handleGetHorizontalPodAutoscalerList(request *restful.Requ
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetHorizontalPodAutoscalerListForResource(request *restful.Request,
-	response *restful.Response) {
+func (in *APIHandler) handleGetMutatingWebhookConfigurationDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
-	kind := request.PathParameter("kind")
-	result, err := horizontalpodautoscaler.GetHorizontalPodAutoscalerListForResource(k8sClient, namespace, kind, name)
+	result, err := admission.GetMutatingWebhookConfigurationDetail(k8sClient, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2949,16 +5961,15 @@ func (in *APIHandler) handleGetHorizontalPodAutoscalerListForResource(request *r
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetHorizontalPodAutoscalerDetail(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetValidatingAdmissionPolicyList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("horizontalpodautoscaler")
-	result, err := horizontalpodautoscaler.GetHorizontalPodAutoscalerDetail(k8sClient, namespace, name)
+	dataSelect := parser.ParseDataSelectPathParameter(request)
+	result, err := admission.GetValidatingAdmissionPolicyList(k8sClient, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2966,17 +5977,15 @@ func (in *APIHandler) handleGetHorizontalPodAutoscalerDetail(request *restful.Re
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetJobList(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetValidatingAdmissionPolicyDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := parseNamespacePathParameter(request)
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := job.GetJobList(k8sClient, namespace, dataSelect, in.iManager.Metric().Client())
+	name := request.PathParameter("name")
+	result, err := admission.GetValidatingAdmissionPolicyDetail(k8sClient, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -2984,16 +5993,20 @@ func (in *APIHandler) handleGetJobList(request *restful.Request, response *restf
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetJobDetail(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetAdmissionAppliesTo(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("name")
-	result, err := job.GetJobDetail(k8sClient, namespace, name)
+	spec := new(admission.AppliesToSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := admission.GetAppliesTo(k8sClient, spec)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -3001,18 +6014,21 @@ func (in *APIHandler) handleGetJobDetail(request *restful.Request, response *res
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetJobPods(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetGatewayClassList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("name")
+	dynamicClient, err := getDynamicClient(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.StandardMetrics
-	result, err := job.GetJobPods(k8sClient, in.iManager.Metric().Client(), dataSelect, namespace, name)
+	result, err := gateway.GetGatewayClassList(k8sClient, dynamicClient, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -3020,17 +6036,21 @@ func (in *APIHandler) handleGetJobPods(request *restful.Request, response *restf
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetJobEvents(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetGatewayClassDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
+	dynamicClient, err := getDynamicClient(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	name := request.PathParameter("name")
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := job.GetJobEvents(k8sClient, dataSelect, namespace, name)
+	result, err := gateway.GetGatewayClassDetail(k8sClient, dynamicClient, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -3038,17 +6058,22 @@ func (in *APIHandler) handleGetJobEvents(request *restful.Request, response *res
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetCronJobList(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetGatewayList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
+	dynamicClient, err := getDynamicClient(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	dataSelect.MetricQuery = dataselect.NoMetrics
-	result, err := cronjob.GetCronJobList(k8sClient, namespace, dataSelect)
+	result, err := gateway.GetGatewayList(k8sClient, dynamicClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -3056,16 +6081,22 @@ func (in *APIHandler) handleGetCronJobList(request *restful.Request, response *r
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetCronJobDetail(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetGatewayDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
+	dynamicClient, err := getDynamicClient(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
-	result, err := cronjob.GetCronJobDetail(k8sClient, namespace, name)
+	result, err := gateway.GetGatewayDetail(k8sClient, dynamicClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -3073,19 +6104,22 @@ func (in *APIHandler) handleGetCronJobDetail(request *restful.Request, response
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetCronJobJobs(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetHTTPRouteList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("name")
-	active := request.QueryParameter("active") != "false"
+	dynamicClient, err := getDynamicClient(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
 
+	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := cronjob.GetCronJobJobs(k8sClient, in.iManager.Metric().Client(), dataSelect, namespace, name, active)
+	result, err := gateway.GetRouteList(k8sClient, dynamicClient, commontypes.ResourceKindHTTPRoute, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -3093,50 +6127,45 @@ func (in *APIHandler) handleGetCronJobJobs(request *restful.Request, response *r
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetCronJobEvents(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetHTTPRouteDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("name")
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := cronjob.GetCronJobEvents(k8sClient, dataSelect, namespace, name)
+	dynamicClient, err := getDynamicClient(request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
-}
 
-func (in *APIHandler) handleTriggerCronJob(request *restful.Request, response *restful.Response) {
-	k8sClient, err := client.Client(request.Request)
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	result, err := gateway.GetRouteDetail(k8sClient, dynamicClient, commontypes.ResourceKindHTTPRoute, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("name")
-	err = cronjob.TriggerCronJob(k8sClient, namespace, name)
+func (in *APIHandler) handleGetGRPCRouteList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	response.WriteHeader(http.StatusOK)
-}
 
-func (in *APIHandler) handleGetStorageClassList(request *restful.Request, response *restful.Response) {
-	k8sClient, err := client.Client(request.Request)
+	dynamicClient, err := getDynamicClient(request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
+	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := storageclass.GetStorageClassList(k8sClient, dataSelect)
+	result, err := gateway.GetRouteList(k8sClient, dynamicClient, commontypes.ResourceKindGRPCRoute, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -3144,50 +6173,45 @@ func (in *APIHandler) handleGetStorageClassList(request *restful.Request, respon
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetStorageClass(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetGRPCRouteDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	name := request.PathParameter("storageclass")
-	result, err := storageclass.GetStorageClass(k8sClient, name)
+	dynamicClient, err := getDynamicClient(request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
-}
 
-func (in *APIHandler) handleGetStorageClassPersistentVolumes(request *restful.Request,
-	response *restful.Response) {
-	k8sClient, err := client.Client(request.Request)
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	result, err := gateway.GetRouteDetail(k8sClient, dynamicClient, commontypes.ResourceKindGRPCRoute, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
 
-	name := request.PathParameter("storageclass")
-	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := persistentvolume.GetStorageClassPersistentVolumes(k8sClient,
-		name, dataSelect)
+func (in *APIHandler) handleGetReferenceGrantList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
-	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
-}
 
-func (in *APIHandler) handleGetIngressClassList(request *restful.Request, response *restful.Response) {
-	k8sClient, err := client.Client(request.Request)
+	dynamicClient, err := getDynamicClient(request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
+	namespace := parseNamespacePathParameter(request)
 	dataSelect := parser.ParseDataSelectPathParameter(request)
-	result, err := ingressclass.GetIngressClassList(k8sClient, dataSelect)
+	result, err := gateway.GetReferenceGrantList(k8sClient, dynamicClient, namespace, dataSelect)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -3195,15 +6219,22 @@ func (in *APIHandler) handleGetIngressClassList(request *restful.Request, respon
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (in *APIHandler) handleGetIngressClass(request *restful.Request, response *restful.Response) {
+func (in *APIHandler) handleGetReferenceGrantDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := client.Client(request.Request)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
 	}
 
-	name := request.PathParameter("ingressclass")
-	result, err := ingressclass.GetIngressClass(k8sClient, name)
+	dynamicClient, err := getDynamicClient(request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	result, err := gateway.GetReferenceGrantDetail(k8sClient, dynamicClient, namespace, name)
 	if err != nil {
 		errors.HandleInternalError(response, err)
 		return
@@ -3321,6 +6352,91 @@ func (in *APIHandler) handleGetCustomResourceObjectDetail(request *restful.Reque
 	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
+func (in *APIHandler) handleCreateCustomResourceObject(request *restful.Request, response *restful.Response) {
+	config, err := client.Config(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	apiextensionsclient, err := client.APIExtensionsClient(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	body, err := io.ReadAll(request.Request.Body)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	crdName := request.PathParameter("crd")
+	namespace := request.PathParameter("namespace")
+	result, err := customresourcedefinition.CreateCustomResourceObject(apiextensionsclient, config, namespace, crdName, body)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusCreated, result)
+}
+
+func (in *APIHandler) handleUpdateCustomResourceObject(request *restful.Request, response *restful.Response) {
+	config, err := client.Config(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	apiextensionsclient, err := client.APIExtensionsClient(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	body, err := io.ReadAll(request.Request.Body)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	name := request.PathParameter("object")
+	crdName := request.PathParameter("crd")
+	namespace := request.PathParameter("namespace")
+	result, err := customresourcedefinition.UpdateCustomResourceObject(apiextensionsclient, config, namespace, crdName, name, body)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	_ = response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (in *APIHandler) handleDeleteCustomResourceObject(request *restful.Request, response *restful.Response) {
+	config, err := client.Config(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	apiextensionsclient, err := client.APIExtensionsClient(request.Request)
+	if err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	name := request.PathParameter("object")
+	crdName := request.PathParameter("crd")
+	namespace := request.PathParameter("namespace")
+	if err := customresourcedefinition.DeleteCustomResourceObject(apiextensionsclient, config, namespace, crdName, name); err != nil {
+		errors.HandleInternalError(response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
 func (in *APIHandler) handleGetCustomResourceObjectEvents(request *restful.Request, response *restful.Response) {
 	klog.V(4).Info("Getting events related to a custom resource object in namespace")
 
@@ -3429,6 +6545,32 @@ func (in *APIHandler) handleLogFile(request *restful.Request, response *restful.
 	handleDownload(response, logStream)
 }
 
+// parseGroupVersionResourcePathParameters parses explicit group, version and resource path
+// parameters. Resources of the core group are addressed with the "core" group.
+// getDynamicClient returns a dynamic client for resources without typed clients, e.g. CRDs
+// installed by cluster add-ons.
+func getDynamicClient(request *restful.Request) (dynamic.Interface, error) {
+	cfg, err := client.Config(request.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	return dynamic.NewForConfig(cfg)
+}
+
+func parseGroupVersionResourcePathParameters(request *restful.Request) schema.GroupVersionResource {
+	group := request.PathParameter("group")
+	if group == coreGroupPathParameter {
+		group = ""
+	}
+
+	return schema.GroupVersionResource{
+		Group:    group,
+		Version:  request.PathParameter("version"),
+		Resource: request.PathParameter("resource"),
+	}
+}
+
 // parseNamespacePathParameter parses namespace selector for list pages in path parameter.
 // The namespace selector is a comma separated list of namespaces that are trimmed.
 // No namespaces mean "view all user namespaces", i.e., everything except kube-system.
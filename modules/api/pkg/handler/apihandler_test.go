@@ -48,6 +48,14 @@ func TestShouldDoCsrfValidation(t *testing.T) {
 			},
 			false,
 		},
+		{
+			&restful.Request{
+				Request: &http.Request{
+					Method: "PATCH",
+				},
+			},
+			false,
+		},
 		{
 			&restful.Request{
 				Request: &http.Request{
@@ -0,0 +1,206 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ownership
+
+import (
+	"bytes"
+	"fmt"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+	"sigs.k8s.io/structured-merge-diff/v4/value"
+
+	"k8s.io/dashboard/client"
+)
+
+// FieldManager is a single entry of the object managed fields.
+type FieldManager struct {
+	Manager   string                            `json:"manager"`
+	Operation metaV1.ManagedFieldsOperationType `json:"operation"`
+
+	// Subresource is set for fields updated through subresources, e.g. "status" or "scale".
+	Subresource string       `json:"subresource,omitempty"`
+	APIVersion  string       `json:"apiVersion"`
+	Time        *metaV1.Time `json:"time,omitempty"`
+}
+
+// ManagerSummary is a field manager together with the number of fields it owns.
+type ManagerSummary struct {
+	FieldManager `json:",inline"`
+	FieldCount   int `json:"fieldCount"`
+}
+
+// FieldOwnership lists managers of a single field. Fields with more than one manager are shared,
+// i.e. all managers set the same value.
+type FieldOwnership struct {
+	// Path of the field, e.g. ".spec.template.spec.containers[name="web"].image".
+	Path     string         `json:"path"`
+	Value    interface{}    `json:"value,omitempty"`
+	Managers []FieldManager `json:"managers"`
+}
+
+// Ownership is a per-field view of object managed fields.
+type Ownership struct {
+	APIVersion string           `json:"apiVersion"`
+	Kind       string           `json:"kind"`
+	Namespace  string           `json:"namespace,omitempty"`
+	Name       string           `json:"name"`
+	Managers   []ManagerSummary `json:"managers"`
+	Fields     []FieldOwnership `json:"fields"`
+}
+
+// GetOwnership returns managers owning fields of the object.
+func GetOwnership(verber client.ResourceVerber, kind, namespace, name string) (*Ownership, error) {
+	raw, err := verber.Get(kind, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	object, ok := raw.(*unstructured.Unstructured)
+	if !ok {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(raw)
+		if err != nil {
+			return nil, err
+		}
+		object = &unstructured.Unstructured{Object: content}
+	}
+
+	return toOwnership(object)
+}
+
+func toOwnership(object *unstructured.Unstructured) (*Ownership, error) {
+	result := &Ownership{
+		APIVersion: object.GetAPIVersion(),
+		Kind:       object.GetKind(),
+		Namespace:  object.GetNamespace(),
+		Name:       object.GetName(),
+		Managers:   make([]ManagerSummary, 0),
+		Fields:     make([]FieldOwnership, 0),
+	}
+
+	managers := make([]FieldManager, 0)
+	sets := make([]*fieldpath.Set, 0)
+	all := &fieldpath.Set{}
+	for _, entry := range object.GetManagedFields() {
+		fields := &fieldpath.Set{}
+		if entry.FieldsV1 != nil {
+			if err := fields.FromJSON(bytes.NewReader(entry.FieldsV1.Raw)); err != nil {
+				return nil, fmt.Errorf("could not parse managed fields of %s: %w", entry.Manager, err)
+			}
+		}
+
+		manager := FieldManager{
+			Manager:     entry.Manager,
+			Operation:   entry.Operation,
+			Subresource: entry.Subresource,
+			APIVersion:  entry.APIVersion,
+			Time:        entry.Time,
+		}
+		managers = append(managers, manager)
+		sets = append(sets, fields)
+		all = all.Union(fields)
+		result.Managers = append(result.Managers, ManagerSummary{FieldManager: manager, FieldCount: fields.Size()})
+	}
+
+	// Fields are listed in the order of paths, parents before children.
+	all.Iterate(func(path fieldpath.Path) {
+		field := FieldOwnership{Path: path.String(), Managers: make([]FieldManager, 0, 1)}
+		if isLeaf(all, path) {
+			field.Value = ValueAt(object.Object, path)
+		}
+
+		for i, fields := range sets {
+			if fields.Has(path) {
+				field.Managers = append(field.Managers, managers[i])
+			}
+		}
+
+		result.Fields = append(result.Fields, field)
+	})
+
+	return result, nil
+}
+
+// isLeaf returns true when no children of the path are owned. Values are returned only for
+// leaves, as parents would repeat values of all their children.
+func isLeaf(set *fieldpath.Set, path fieldpath.Path) bool {
+	for _, element := range path {
+		child, exists := set.Children.Get(element)
+		if !exists {
+			return true
+		}
+
+		set = child
+	}
+
+	return set.Empty()
+}
+
+// ValueAt returns the value of the object at the managed fields path or nil when it is not set.
+func ValueAt(object interface{}, path fieldpath.Path) interface{} {
+	for _, element := range path {
+		switch {
+		case element.FieldName != nil:
+			objectMap, ok := object.(map[string]interface{})
+			if !ok {
+				return nil
+			}
+			object = objectMap[*element.FieldName]
+		case element.Index != nil:
+			list, ok := object.([]interface{})
+			if !ok || *element.Index >= len(list) {
+				return nil
+			}
+			object = list[*element.Index]
+		case element.Key != nil:
+			object = findItem(object, func(item interface{}) bool {
+				itemMap, ok := item.(map[string]interface{})
+				if !ok {
+					return false
+				}
+
+				for _, field := range *element.Key {
+					if !value.Equals(value.NewValueInterface(itemMap[field.Name]), field.Value) {
+						return false
+					}
+				}
+				return true
+			})
+		case element.Value != nil:
+			object = findItem(object, func(item interface{}) bool {
+				return value.Equals(value.NewValueInterface(item), *element.Value)
+			})
+		}
+	}
+
+	return object
+}
+
+func findItem(object interface{}, matches func(interface{}) bool) interface{} {
+	list, ok := object.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	for _, item := range list {
+		if matches(item) {
+			return item
+		}
+	}
+
+	return nil
+}
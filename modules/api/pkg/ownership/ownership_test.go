@@ -0,0 +1,134 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ownership
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"sigs.k8s.io/yaml"
+)
+
+// scaledDeployment is a deployment applied with server-side apply and scaled by an HPA.
+const scaledDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: default
+  labels:
+    app: web
+  managedFields:
+  - manager: kubectl
+    operation: Apply
+    apiVersion: apps/v1
+    time: "2024-01-02T03:04:05Z"
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:metadata:
+        f:labels:
+          f:app: {}
+      f:spec:
+        f:replicas: {}
+        f:template:
+          f:spec:
+            f:containers:
+              k:{"name":"web"}:
+                .: {}
+                f:name: {}
+                f:ports:
+                  k:{"containerPort":80,"protocol":"TCP"}:
+                    .: {}
+                    f:containerPort: {}
+  - manager: kube-controller-manager
+    operation: Update
+    apiVersion: autoscaling/v2
+    subresource: scale
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:spec:
+        f:replicas: {}
+spec:
+  replicas: 5
+  template:
+    spec:
+      containers:
+      - name: web
+        ports:
+        - containerPort: 80
+          protocol: TCP
+`
+
+func newTestObject(t *testing.T, manifest string) *unstructured.Unstructured {
+	t.Helper()
+
+	data, err := yaml.YAMLToJSON([]byte(manifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	object := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	if err := utiljson.Unmarshal(data, &object.Object); err != nil {
+		t.Fatal(err)
+	}
+
+	return object
+}
+
+func TestToOwnership(t *testing.T) {
+	actual, err := toOwnership(newTestObject(t, scaledDeployment))
+	if err != nil {
+		t.Fatalf("toOwnership() returned error: %v", err)
+	}
+
+	if len(actual.Managers) != 2 || actual.Managers[0].FieldCount != 6 || actual.Managers[1].FieldCount != 1 {
+		t.Errorf("unexpected managers %+v", actual.Managers)
+	}
+
+	fields := make(map[string]FieldOwnership)
+	paths := make([]string, 0)
+	for _, field := range actual.Fields {
+		fields[field.Path] = field
+		paths = append(paths, field.Path)
+	}
+
+	expectedPaths := []string{
+		".metadata.labels.app",
+		".spec.replicas",
+		`.spec.template.spec.containers[name="web"]`,
+		`.spec.template.spec.containers[name="web"].name`,
+		`.spec.template.spec.containers[name="web"].ports[containerPort=80,protocol="TCP"]`,
+		`.spec.template.spec.containers[name="web"].ports[containerPort=80,protocol="TCP"].containerPort`,
+	}
+	if !reflect.DeepEqual(paths, expectedPaths) {
+		t.Errorf("toOwnership() returned paths \n%v\nexpected \n%v", paths, expectedPaths)
+	}
+
+	replicas := fields[".spec.replicas"]
+	if replicas.Value != int64(5) || len(replicas.Managers) != 2 || replicas.Managers[1].Subresource != "scale" {
+		t.Errorf("expected replicas shared by apply and scale managers, got %+v", replicas)
+	}
+
+	port := fields[`.spec.template.spec.containers[name="web"].ports[containerPort=80,protocol="TCP"].containerPort`]
+	if port.Value != int64(80) {
+		t.Errorf("expected container port value 80, got %v", port.Value)
+	}
+
+	if container := fields[`.spec.template.spec.containers[name="web"]`]; container.Value != nil {
+		t.Errorf("expected no value for parent fields, got %v", container.Value)
+	}
+}
@@ -0,0 +1,430 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	admissionregistration "k8s.io/api/admissionregistration/v1"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/types"
+)
+
+func rule(scope admissionregistration.ScopeType, groups, versions, resources []string,
+	operations ...admissionregistration.OperationType) admissionregistration.RuleWithOperations {
+	return admissionregistration.RuleWithOperations{
+		Operations: operations,
+		Rule: admissionregistration.Rule{
+			APIGroups:   groups,
+			APIVersions: versions,
+			Resources:   resources,
+			Scope:       &scope,
+		},
+	}
+}
+
+func newTestClient() *fake.Clientset {
+	exact := admissionregistration.Exact
+	return fake.NewSimpleClientset(
+		&v1.Namespace{ObjectMeta: metaV1.ObjectMeta{Name: "default", Labels: map[string]string{"env": "prod"}}},
+		&v1.Namespace{ObjectMeta: metaV1.ObjectMeta{Name: "kube-system", Labels: map[string]string{"env": "system"}}},
+		&admissionregistration.ValidatingWebhookConfiguration{
+			ObjectMeta: metaV1.ObjectMeta{Name: "policy-agent"},
+			Webhooks: []admissionregistration.ValidatingWebhook{
+				{
+					Name: "deployments.policy-agent",
+					Rules: []admissionregistration.RuleWithOperations{
+						rule(admissionregistration.NamespacedScope, []string{"apps"}, []string{"v1"}, []string{"deployments"},
+							admissionregistration.Create, admissionregistration.Update),
+					},
+					NamespaceSelector: &metaV1.LabelSelector{MatchExpressions: []metaV1.LabelSelectorRequirement{
+						{Key: "env", Operator: metaV1.LabelSelectorOpNotIn, Values: []string{"system"}},
+					}},
+				},
+				{
+					Name: "scale.policy-agent",
+					Rules: []admissionregistration.RuleWithOperations{
+						rule(admissionregistration.AllScopes, []string{"*"}, []string{"*"}, []string{"*/scale"}, admissionregistration.OperationAll),
+					},
+				},
+				{
+					Name: "namespaces.policy-agent",
+					Rules: []admissionregistration.RuleWithOperations{
+						rule(admissionregistration.ClusterScope, []string{""}, []string{"v1"}, []string{"namespaces"}, admissionregistration.Create),
+					},
+					NamespaceSelector: &metaV1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+				},
+			},
+		},
+		&admissionregistration.MutatingWebhookConfiguration{
+			ObjectMeta: metaV1.ObjectMeta{Name: "sidecar-injector"},
+			Webhooks: []admissionregistration.MutatingWebhook{
+				{
+					Name: "pods.sidecar-injector",
+					Rules: []admissionregistration.RuleWithOperations{
+						rule(admissionregistration.AllScopes, []string{""}, []string{"v1"}, []string{"pods"}, admissionregistration.Create),
+					},
+					ObjectSelector:  &metaV1.LabelSelector{MatchLabels: map[string]string{"inject": "true"}},
+					MatchConditions: []admissionregistration.MatchCondition{{Name: "not-host", Expression: "!object.spec.hostNetwork"}},
+				},
+				{
+					Name:        "deployments-v1beta1.sidecar-injector",
+					MatchPolicy: &exact,
+					Rules: []admissionregistration.RuleWithOperations{
+						rule(admissionregistration.AllScopes, []string{"apps"}, []string{"v1beta1"}, []string{"deployments"}, admissionregistration.Create),
+					},
+				},
+			},
+		},
+		&admissionregistration.ValidatingAdmissionPolicy{
+			ObjectMeta: metaV1.ObjectMeta{Name: "replica-limit"},
+			Spec: admissionregistration.ValidatingAdmissionPolicySpec{
+				MatchConstraints: &admissionregistration.MatchResources{
+					ResourceRules: []admissionregistration.NamedRuleWithOperations{{
+						RuleWithOperations: rule(admissionregistration.AllScopes, []string{"apps"}, []string{"v1"}, []string{"deployments"},
+							admissionregistration.Create, admissionregistration.Update),
+					}},
+				},
+				Validations: []admissionregistration.Validation{{Expression: "object.spec.replicas <= 5"}},
+			},
+		},
+		&admissionregistration.ValidatingAdmissionPolicy{
+			ObjectMeta: metaV1.ObjectMeta{Name: "unbound"},
+			Spec:       admissionregistration.ValidatingAdmissionPolicySpec{},
+		},
+		&admissionregistration.ValidatingAdmissionPolicyBinding{
+			ObjectMeta: metaV1.ObjectMeta{Name: "replica-limit-prod"},
+			Spec: admissionregistration.ValidatingAdmissionPolicyBindingSpec{
+				PolicyName:        "replica-limit",
+				ValidationActions: []admissionregistration.ValidationAction{admissionregistration.Deny},
+				MatchResources: &admissionregistration.MatchResources{
+					NamespaceSelector: &metaV1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+					ExcludeResourceRules: []admissionregistration.NamedRuleWithOperations{{
+						ResourceNames:      []string{"legacy"},
+						RuleWithOperations: rule(admissionregistration.AllScopes, []string{"*"}, []string{"*"}, []string{"*"}, admissionregistration.OperationAll),
+					}},
+				},
+			},
+		},
+	)
+}
+
+func newTestMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}, meta.RESTScopeRoot)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+	return mapper
+}
+
+func TestGetAppliesTo(t *testing.T) {
+	cases := []struct {
+		info             string
+		spec             AppliesToSpec
+		expectedWebhooks []string
+		expectedPolicies []string
+	}{
+		{
+			"deployment in matching namespace",
+			AppliesToSpec{Kind: "deployment", Namespace: "default", Name: "web"},
+			[]string{"deployments.policy-agent"},
+			[]string{"replica-limit"},
+		},
+		{
+			"deployment in namespace excluded by selectors",
+			AppliesToSpec{Kind: "deployment", Namespace: "kube-system", Name: "web"},
+			[]string{},
+			[]string{},
+		},
+		{
+			"deployment excluded by name from binding",
+			AppliesToSpec{Kind: "deployment", Namespace: "default", Name: "legacy"},
+			[]string{"deployments.policy-agent"},
+			[]string{},
+		},
+		{
+			"deployment deletion",
+			AppliesToSpec{Kind: "deployment", Namespace: "default", Name: "web", Operation: admissionregistration.Delete},
+			[]string{},
+			[]string{},
+		},
+		{
+			"deployment scale subresource",
+			AppliesToSpec{Kind: "deployments.apps", Subresource: "scale", Namespace: "default", Name: "web", Operation: admissionregistration.Update},
+			[]string{"scale.policy-agent"},
+			[]string{},
+		},
+		{
+			"pod with object selector labels",
+			AppliesToSpec{Kind: "pod", Namespace: "default", Labels: map[string]string{"inject": "true"}},
+			[]string{"pods.sidecar-injector"},
+			[]string{},
+		},
+		{
+			"pod without object selector labels",
+			AppliesToSpec{Kind: "pod", Namespace: "default"},
+			[]string{},
+			[]string{},
+		},
+		{
+			"namespace matched by its own labels",
+			AppliesToSpec{Kind: "namespace", Name: "team-a", Labels: map[string]string{"team": "a"}},
+			[]string{"namespaces.policy-agent"},
+			[]string{},
+		},
+		{
+			"any object in namespace",
+			AppliesToSpec{Namespace: "default"},
+			[]string{"deployments-v1beta1.sidecar-injector", "deployments.policy-agent", "pods.sidecar-injector", "scale.policy-agent"},
+			[]string{"replica-limit"},
+		},
+	}
+
+	for _, c := range cases {
+		actual, err := getAppliesTo(newTestClient(), newTestMapper(), &c.spec)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.info, err)
+			continue
+		}
+
+		webhooks := make([]string, 0)
+		for _, webhook := range actual.Webhooks {
+			webhooks = append(webhooks, webhook.Webhook.Name)
+		}
+		sort.Strings(webhooks)
+
+		policies := make([]string, 0)
+		for _, policy := range actual.Policies {
+			policies = append(policies, policy.Name)
+		}
+
+		if !reflect.DeepEqual(webhooks, c.expectedWebhooks) {
+			t.Errorf("%s: expected webhooks %v, got %v", c.info, c.expectedWebhooks, webhooks)
+		}
+
+		if !reflect.DeepEqual(policies, c.expectedPolicies) {
+			t.Errorf("%s: expected policies %v, got %v", c.info, c.expectedPolicies, policies)
+		}
+	}
+}
+
+func TestGetAppliesToConditional(t *testing.T) {
+	spec := &AppliesToSpec{Kind: "pod", Namespace: "default", Labels: map[string]string{"inject": "true"}}
+	actual, err := getAppliesTo(newTestClient(), newTestMapper(), spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The object has no spec, so the condition of the webhook cannot be evaluated.
+	if len(actual.Webhooks) != 1 || !actual.Webhooks[0].Conditional || len(actual.Webhooks[0].ConditionErrors) != 1 {
+		t.Fatalf("expected single conditional webhook, got %+v", actual.Webhooks)
+	}
+
+	if actual.Webhooks[0].TypeMeta.Kind != types.ResourceKindMutatingWebhookConfiguration || actual.Webhooks[0].Configuration != "sidecar-injector" {
+		t.Errorf("expected webhook of sidecar-injector mutating configuration, got %+v", actual.Webhooks[0])
+	}
+
+	expectedResource := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	if actual.Resource != expectedResource || !actual.Namespaced || actual.Operation != admissionregistration.Create {
+		t.Errorf("expected namespaced CREATE of %v, got %v namespaced=%v %s", expectedResource, actual.Resource, actual.Namespaced, actual.Operation)
+	}
+}
+
+func TestGetAppliesToMatchConditions(t *testing.T) {
+	client := newTestClient()
+	objects := []runtime.Object{
+		&admissionregistration.ValidatingWebhookConfiguration{
+			ObjectMeta: metaV1.ObjectMeta{Name: "conditions"},
+			Webhooks: []admissionregistration.ValidatingWebhook{
+				{
+					Name: "skip-system.conditions",
+					Rules: []admissionregistration.RuleWithOperations{
+						rule(admissionregistration.AllScopes, []string{""}, []string{"v1"}, []string{"pods"}, admissionregistration.Create),
+					},
+					MatchConditions: []admissionregistration.MatchCondition{
+						{Name: "create", Expression: "request.operation == 'CREATE'"},
+						{Name: "not-system", Expression: "!object.metadata.name.startsWith('system-')"},
+					},
+				},
+				{
+					Name: "authorized.conditions",
+					Rules: []admissionregistration.RuleWithOperations{
+						rule(admissionregistration.AllScopes, []string{""}, []string{"v1"}, []string{"pods"}, admissionregistration.Create),
+					},
+					MatchConditions: []admissionregistration.MatchCondition{
+						{Name: "not-admin", Expression: "!authorizer.group('').resource('pods').namespace(object.metadata.namespace).check('escalate').allowed()"},
+					},
+				},
+			},
+		},
+		&admissionregistration.ValidatingAdmissionPolicy{
+			ObjectMeta: metaV1.ObjectMeta{Name: "team-labels"},
+			Spec: admissionregistration.ValidatingAdmissionPolicySpec{
+				MatchConstraints: &admissionregistration.MatchResources{
+					ResourceRules: []admissionregistration.NamedRuleWithOperations{{
+						RuleWithOperations: rule(admissionregistration.AllScopes, []string{""}, []string{"v1"}, []string{"pods"}, admissionregistration.Create),
+					}},
+				},
+				Variables:       []admissionregistration.Variable{{Name: "team", Expression: "object.metadata.?labels.team.orValue('')"}},
+				MatchConditions: []admissionregistration.MatchCondition{{Name: "has-team", Expression: "variables.team != ''"}},
+			},
+		},
+		&admissionregistration.ValidatingAdmissionPolicyBinding{
+			ObjectMeta: metaV1.ObjectMeta{Name: "team-labels"},
+			Spec:       admissionregistration.ValidatingAdmissionPolicyBindingSpec{PolicyName: "team-labels"},
+		},
+	}
+	for _, object := range objects {
+		if err := client.Tracker().Add(object); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	cases := []struct {
+		info             string
+		spec             AppliesToSpec
+		expectedWebhooks []string
+		expectedPolicies []string
+	}{
+		{
+			"all conditions true",
+			AppliesToSpec{Kind: "pod", Namespace: "default", Name: "web", Labels: map[string]string{"team": "a"}},
+			[]string{"authorized.conditions", "skip-system.conditions"},
+			[]string{"team-labels"},
+		},
+		{
+			"conditions false",
+			AppliesToSpec{Kind: "pod", Namespace: "default", Name: "system-web"},
+			[]string{"authorized.conditions"},
+			[]string{},
+		},
+	}
+
+	for _, c := range cases {
+		actual, err := getAppliesTo(client, newTestMapper(), &c.spec)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.info, err)
+		}
+
+		webhooks := make([]string, 0)
+		for _, webhook := range actual.Webhooks {
+			webhooks = append(webhooks, webhook.Webhook.Name)
+
+			// The authorizer is not available, so the condition using it cannot be evaluated.
+			conditional := webhook.Webhook.Name == "authorized.conditions"
+			if webhook.Conditional != conditional || (len(webhook.ConditionErrors) > 0) != conditional {
+				t.Errorf("%s: webhook %s has conditional=%v errors=%v, expected conditional=%v", c.info,
+					webhook.Webhook.Name, webhook.Conditional, webhook.ConditionErrors, conditional)
+			}
+		}
+		sort.Strings(webhooks)
+
+		policies := make([]string, 0)
+		for _, policy := range actual.Policies {
+			policies = append(policies, policy.Name)
+			if policy.Conditional {
+				t.Errorf("%s: policy %s should not be conditional, got errors %v", c.info, policy.Name, policy.ConditionErrors)
+			}
+		}
+
+		if !reflect.DeepEqual(webhooks, c.expectedWebhooks) {
+			t.Errorf("%s: expected webhooks %v, got %v", c.info, c.expectedWebhooks, webhooks)
+		}
+
+		if !reflect.DeepEqual(policies, c.expectedPolicies) {
+			t.Errorf("%s: expected policies %v, got %v", c.info, c.expectedPolicies, policies)
+		}
+	}
+}
+
+func TestGetAppliesToInvalidSpec(t *testing.T) {
+	cases := []struct {
+		info string
+		spec AppliesToSpec
+	}{
+		{"no kind nor namespace", AppliesToSpec{}},
+		{"unknown kind", AppliesToSpec{Kind: "unknown", Namespace: "default"}},
+		{"namespaced kind without namespace", AppliesToSpec{Kind: "pod"}},
+		{"unsupported operation", AppliesToSpec{Kind: "pod", Namespace: "default", Operation: admissionregistration.OperationAll}},
+	}
+
+	for _, c := range cases {
+		_, err := getAppliesTo(newTestClient(), newTestMapper(), &c.spec)
+		if !k8serrors.IsBadRequest(err) {
+			t.Errorf("%s: expected bad request, got %v", c.info, err)
+		}
+	}
+}
+
+func TestGetValidatingAdmissionPolicyList(t *testing.T) {
+	actual, err := GetValidatingAdmissionPolicyList(newTestClient(), dataselect.NoDataSelect)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	bindings := map[string]int{}
+	for _, policy := range actual.Items {
+		bindings[policy.ObjectMeta.Name] = policy.Bindings
+	}
+
+	expected := map[string]int{"replica-limit": 1, "unbound": 0}
+	if actual.ListMeta.TotalItems != 2 || !reflect.DeepEqual(bindings, expected) {
+		t.Errorf("expected bindings %v, got %v", expected, bindings)
+	}
+}
+
+func TestGetValidatingAdmissionPolicyDetail(t *testing.T) {
+	actual, err := GetValidatingAdmissionPolicyDetail(newTestClient(), "replica-limit")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if actual.FailurePolicy != string(admissionregistration.Fail) || len(actual.Validations) != 1 ||
+		len(actual.Bindings) != 1 || actual.Bindings[0].ObjectMeta.Name != "replica-limit-prod" {
+		t.Errorf("unexpected policy detail %+v", actual)
+	}
+}
+
+func TestGetWebhookConfigurationDetail(t *testing.T) {
+	validating, err := GetValidatingWebhookConfigurationDetail(newTestClient(), "policy-agent")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(validating.Webhooks) != 3 || validating.Webhooks[0].MatchPolicy != string(admissionregistration.Equivalent) ||
+		validating.Webhooks[0].ReinvocationPolicy != "" {
+		t.Errorf("unexpected validating webhook configuration %+v", validating)
+	}
+
+	mutating, err := GetMutatingWebhookConfigurationDetail(newTestClient(), "sidecar-injector")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(mutating.Webhooks) != 2 || mutating.Webhooks[0].ReinvocationPolicy != string(admissionregistration.NeverReinvocationPolicy) ||
+		mutating.Webhooks[1].MatchPolicy != string(admissionregistration.Exact) {
+		t.Errorf("unexpected mutating webhook configuration %+v", mutating)
+	}
+}
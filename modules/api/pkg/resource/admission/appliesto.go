@@ -0,0 +1,317 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+	"fmt"
+
+	admissionregistration "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// AppliesToSpec describes an object, or any object in a namespace, to find admission webhooks
+// and policies intercepting it.
+type AppliesToSpec struct {
+	// Kind of the object as used by the "_raw" endpoints, e.g. "deployment" or "resource.group".
+	// When empty, webhooks and policies intercepting any object in the namespace are returned.
+	Kind string `json:"kind,omitempty"`
+
+	// Subresource of the request, e.g. "scale". Empty for the object itself.
+	Subresource string `json:"subresource,omitempty"`
+
+	Namespace string            `json:"namespace,omitempty"`
+	Name      string            `json:"name,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+
+	// Operation of the request, CREATE by default.
+	Operation admissionregistration.OperationType `json:"operation,omitempty"`
+}
+
+// AppliesTo lists admission webhooks and policies intercepting the requests described by the spec.
+type AppliesTo struct {
+	// Resource the kind of the spec resolved to. Empty when any object in a namespace is matched.
+	Resource   schema.GroupVersionResource         `json:"resource"`
+	Namespaced bool                                `json:"namespaced"`
+	Operation  admissionregistration.OperationType `json:"operation"`
+
+	Webhooks []MatchingWebhook `json:"webhooks"`
+	Policies []MatchingPolicy  `json:"policies"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// MatchingWebhook is a webhook whose rules and selectors match the request.
+type MatchingWebhook struct {
+	// Configuration is the name of the webhook configuration, its type meta tells whether the
+	// webhook is validating or mutating.
+	Configuration string         `json:"configuration"`
+	TypeMeta      types.TypeMeta `json:"typeMeta"`
+	Webhook       Webhook        `json:"webhook"`
+
+	// Conditional is set when some match conditions of the webhook could not be evaluated, e.g.
+	// because they use the authorizer or fields of the object not given in the spec. The webhook
+	// is called only if all of them are true.
+	Conditional bool `json:"conditional"`
+
+	// ConditionErrors tell why match conditions could not be evaluated.
+	ConditionErrors []string `json:"conditionErrors,omitempty"`
+}
+
+// MatchingPolicy is a validating admission policy matching the request with the bindings which
+// match it too.
+type MatchingPolicy struct {
+	Name            string                                 `json:"name"`
+	FailurePolicy   string                                 `json:"failurePolicy"`
+	MatchConditions []admissionregistration.MatchCondition `json:"matchConditions"`
+	Bindings        []ValidatingAdmissionPolicyBinding     `json:"bindings"`
+
+	// Conditional is set when some match conditions of the policy could not be evaluated, see
+	// MatchingWebhook.
+	Conditional bool `json:"conditional"`
+
+	// ConditionErrors tell why match conditions could not be evaluated.
+	ConditionErrors []string `json:"conditionErrors,omitempty"`
+}
+
+// GetAppliesTo returns admission webhooks and policies intercepting requests described by the spec.
+func GetAppliesTo(client kubernetes.Interface, spec *AppliesToSpec) (*AppliesTo, error) {
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(client.Discovery()))
+	return getAppliesTo(client, mapper, spec)
+}
+
+func getAppliesTo(client kubernetes.Interface, mapper meta.RESTMapper, spec *AppliesToSpec) (*AppliesTo, error) {
+	klog.V(4).Infof("Getting admission webhooks and policies applying to %s %s in %s namespace", spec.Kind, spec.Name, spec.Namespace)
+	r, err := toRequest(client, mapper, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	channels := &common.ResourceChannels{
+		ValidatingWebhookConfigurationList:   common.GetValidatingWebhookConfigurationListChannel(client, 1),
+		MutatingWebhookConfigurationList:     common.GetMutatingWebhookConfigurationListChannel(client, 1),
+		ValidatingAdmissionPolicyList:        common.GetValidatingAdmissionPolicyListChannel(client, 1),
+		ValidatingAdmissionPolicyBindingList: common.GetValidatingAdmissionPolicyBindingListChannel(client, 1),
+	}
+
+	result := &AppliesTo{
+		Resource:   r.resource,
+		Namespaced: r.namespaced,
+		Operation:  r.operation,
+		Webhooks:   make([]MatchingWebhook, 0),
+		Policies:   make([]MatchingPolicy, 0),
+	}
+
+	webhooks, nonCriticalErrors, err := getWebhooks(channels)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, webhook := range webhooks {
+		matches, err := webhookMatches(&webhook.Webhook, r)
+		if err != nil {
+			return nil, err
+		}
+
+		if !matches {
+			continue
+		}
+
+		conditions := evaluateMatchConditions(webhook.Webhook.MatchConditions, nil, false, r)
+		if conditions.matches {
+			webhook.Conditional = len(conditions.errors) > 0
+			webhook.ConditionErrors = conditions.errors
+			result.Webhooks = append(result.Webhooks, webhook)
+		}
+	}
+
+	policies, bindings, policyErrors, err := getPoliciesAndBindings(channels)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, policy := range policies {
+		matching, err := getMatchingPolicy(&policy, bindings, r)
+		if err != nil {
+			return nil, err
+		}
+
+		if matching != nil {
+			result.Policies = append(result.Policies, *matching)
+		}
+	}
+
+	result.Errors = errors.MergeErrors(nonCriticalErrors, policyErrors)
+	return result, nil
+}
+
+// toRequest resolves the kind of the spec and labels of the namespace.
+func toRequest(client kubernetes.Interface, mapper meta.RESTMapper, spec *AppliesToSpec) (*request, error) {
+	r := &request{
+		subresource: spec.Subresource,
+		operation:   spec.Operation,
+		name:        spec.Name,
+		namespace:   spec.Namespace,
+		labels:      spec.Labels,
+	}
+
+	if len(r.operation) == 0 {
+		r.operation = admissionregistration.Create
+	}
+
+	switch r.operation {
+	case admissionregistration.Create, admissionregistration.Update, admissionregistration.Delete, admissionregistration.Connect:
+	default:
+		return nil, errors.NewBadRequest(fmt.Sprintf("unsupported operation %q", r.operation))
+	}
+
+	if len(spec.Kind) == 0 {
+		if len(spec.Namespace) == 0 {
+			return nil, errors.NewBadRequest("kind or namespace is required")
+		}
+
+		r.anyObject = true
+		r.namespaced = true
+	} else {
+		gvr, err := mapper.ResourceFor(schema.ParseGroupResource(spec.Kind).WithVersion(""))
+		if err != nil {
+			return nil, errors.NewBadRequest(fmt.Sprintf("unknown kind %q: %s", spec.Kind, err))
+		}
+
+		gvk, err := mapper.KindFor(gvr)
+		if err != nil {
+			return nil, err
+		}
+
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		r.resource = gvr
+		r.kind = gvk
+		r.namespaced = mapping.Scope.Name() == meta.RESTScopeNameNamespace
+		r.isNamespace = gvr.Group == "" && gvr.Resource == "namespaces"
+		if r.namespaced && len(spec.Namespace) == 0 {
+			return nil, errors.NewBadRequest(fmt.Sprintf("namespace is required for %s", spec.Kind))
+		}
+	}
+
+	if r.namespaced {
+		namespace, err := client.CoreV1().Namespaces().Get(context.TODO(), spec.Namespace, metaV1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		r.namespaceLabels = namespace.Labels
+	}
+
+	return r, nil
+}
+
+// getWebhooks returns webhooks of all validating and mutating webhook configurations.
+func getWebhooks(channels *common.ResourceChannels) ([]MatchingWebhook, []error, error) {
+	validating := <-channels.ValidatingWebhookConfigurationList.List
+	err := <-channels.ValidatingWebhookConfigurationList.Error
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, nil, criticalError
+	}
+
+	mutating := <-channels.MutatingWebhookConfigurationList.List
+	err = <-channels.MutatingWebhookConfigurationList.Error
+	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, nil, criticalError
+	}
+
+	result := make([]MatchingWebhook, 0)
+	if mutating != nil {
+		for _, configuration := range mutating.Items {
+			for _, webhook := range configuration.Webhooks {
+				result = append(result, MatchingWebhook{
+					Configuration: configuration.Name,
+					TypeMeta:      types.NewTypeMeta(types.ResourceKindMutatingWebhookConfiguration),
+					Webhook:       fromMutatingWebhook(webhook),
+				})
+			}
+		}
+	}
+
+	if validating != nil {
+		for _, configuration := range validating.Items {
+			for _, webhook := range configuration.Webhooks {
+				result = append(result, MatchingWebhook{
+					Configuration: configuration.Name,
+					TypeMeta:      types.NewTypeMeta(types.ResourceKindValidatingWebhookConfiguration),
+					Webhook:       fromValidatingWebhook(webhook),
+				})
+			}
+		}
+	}
+
+	return result, nonCriticalErrors, nil
+}
+
+// getMatchingPolicy returns the policy when its match constraints and at least one of its
+// bindings match the request, nil otherwise.
+func getMatchingPolicy(policy *admissionregistration.ValidatingAdmissionPolicy,
+	bindings []admissionregistration.ValidatingAdmissionPolicyBinding, r *request) (*MatchingPolicy, error) {
+	matches, err := matchResourcesMatch(policy.Spec.MatchConstraints, r)
+	if err != nil || !matches {
+		return nil, err
+	}
+
+	matchingBindings := make([]ValidatingAdmissionPolicyBinding, 0)
+	for _, binding := range bindingsOf(policy.Name, bindings) {
+		matches, err := matchResourcesMatch(binding.Spec.MatchResources, r)
+		if err != nil {
+			return nil, err
+		}
+
+		if matches {
+			matchingBindings = append(matchingBindings, toValidatingAdmissionPolicyBinding(binding))
+		}
+	}
+
+	if len(matchingBindings) == 0 {
+		return nil, nil
+	}
+
+	conditions := evaluateMatchConditions(policy.Spec.MatchConditions, policy.Spec.Variables, policy.Spec.ParamKind != nil, r)
+	if !conditions.matches {
+		return nil, nil
+	}
+
+	return &MatchingPolicy{
+		Name:            policy.Name,
+		FailurePolicy:   stringOrDefault(policy.Spec.FailurePolicy, admissionregistration.Fail),
+		MatchConditions: nonNilMatchConditions(policy.Spec.MatchConditions),
+		Bindings:        matchingBindings,
+		Conditional:     len(conditions.errors) > 0,
+		ConditionErrors: conditions.errors,
+	}, nil
+}
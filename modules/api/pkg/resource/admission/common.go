@@ -0,0 +1,113 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	admissionregistration "k8s.io/api/admissionregistration/v1"
+
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+)
+
+// The code below allows to perform complex data section on admission registration resources.
+
+type ValidatingWebhookConfigurationCell admissionregistration.ValidatingWebhookConfiguration
+
+func (in ValidatingWebhookConfigurationCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(in.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(in.CreationTimestamp.Time)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+type MutatingWebhookConfigurationCell admissionregistration.MutatingWebhookConfiguration
+
+func (in MutatingWebhookConfigurationCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(in.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(in.CreationTimestamp.Time)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+type ValidatingAdmissionPolicyCell admissionregistration.ValidatingAdmissionPolicy
+
+func (in ValidatingAdmissionPolicyCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(in.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(in.CreationTimestamp.Time)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+func toValidatingWebhookConfigurationCells(std []admissionregistration.ValidatingWebhookConfiguration) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = ValidatingWebhookConfigurationCell(std[i])
+	}
+	return cells
+}
+
+func fromValidatingWebhookConfigurationCells(cells []dataselect.DataCell) []admissionregistration.ValidatingWebhookConfiguration {
+	std := make([]admissionregistration.ValidatingWebhookConfiguration, len(cells))
+	for i := range std {
+		std[i] = admissionregistration.ValidatingWebhookConfiguration(cells[i].(ValidatingWebhookConfigurationCell))
+	}
+	return std
+}
+
+func toMutatingWebhookConfigurationCells(std []admissionregistration.MutatingWebhookConfiguration) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = MutatingWebhookConfigurationCell(std[i])
+	}
+	return cells
+}
+
+func fromMutatingWebhookConfigurationCells(cells []dataselect.DataCell) []admissionregistration.MutatingWebhookConfiguration {
+	std := make([]admissionregistration.MutatingWebhookConfiguration, len(cells))
+	for i := range std {
+		std[i] = admissionregistration.MutatingWebhookConfiguration(cells[i].(MutatingWebhookConfigurationCell))
+	}
+	return std
+}
+
+func toValidatingAdmissionPolicyCells(std []admissionregistration.ValidatingAdmissionPolicy) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = ValidatingAdmissionPolicyCell(std[i])
+	}
+	return cells
+}
+
+func fromValidatingAdmissionPolicyCells(cells []dataselect.DataCell) []admissionregistration.ValidatingAdmissionPolicy {
+	std := make([]admissionregistration.ValidatingAdmissionPolicy, len(cells))
+	for i := range std {
+		std[i] = admissionregistration.ValidatingAdmissionPolicy(cells[i].(ValidatingAdmissionPolicyCell))
+	}
+	return std
+}
@@ -0,0 +1,147 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	celtypes "github.com/google/cel-go/common/types"
+	admissionregistration "k8s.io/api/admissionregistration/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericadmission "k8s.io/apiserver/pkg/admission"
+	celplugin "k8s.io/apiserver/pkg/admission/plugin/cel"
+	"k8s.io/apiserver/pkg/admission/plugin/webhook/matchconditions"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	"k8s.io/apiserver/pkg/cel/environment"
+)
+
+// baseEnvSet is the CEL environment of the API server, built once as it is expensive.
+var baseEnvSet = sync.OnceValue(func() *environment.EnvSet {
+	return environment.MustBaseEnvSet(environment.DefaultCompatibilityVersion(), true)
+})
+
+// conditionsResult is the outcome of match conditions evaluation. Errors are set for conditions
+// that could not be evaluated, e.g. because they use the authorizer or object fields that are not
+// known. The request matches unless any of the conditions is false.
+type conditionsResult struct {
+	matches bool
+	errors  []string
+}
+
+// policyVariable is a variable of a validating admission policy, match conditions can refer to
+// variables as well.
+type policyVariable admissionregistration.Variable
+
+func (in *policyVariable) GetName() string {
+	return in.Name
+}
+
+func (in *policyVariable) GetExpression() string {
+	return in.Expression
+}
+
+func (in *policyVariable) ReturnTypes() []*cel.Type {
+	return []*cel.Type{cel.AnyType, cel.DynType}
+}
+
+// evaluateMatchConditions evaluates match conditions the same way the API server does. The object
+// is built from the metadata of the request only, the request has no user info and there is no
+// authorizer, params or namespace object bound.
+func evaluateMatchConditions(conditions []admissionregistration.MatchCondition, variables []admissionregistration.Variable,
+	hasParams bool, r *request) conditionsResult {
+	if len(conditions) == 0 {
+		return conditionsResult{matches: true}
+	}
+
+	if r.anyObject {
+		return conditionsResult{matches: true, errors: []string{"match conditions depend on the object and are not evaluated"}}
+	}
+
+	compiler, err := celplugin.NewCompositedCompiler(baseEnvSet())
+	if err != nil {
+		return conditionsResult{matches: true, errors: []string{err.Error()}}
+	}
+
+	options := celplugin.OptionalVariableDeclarations{HasParams: hasParams, HasAuthorizer: true, StrictCost: true}
+	for i := range variables {
+		compiler.CompileAndStoreVariable((*policyVariable)(&variables[i]), options, environment.StoredExpressions)
+	}
+
+	accessors := make([]celplugin.ExpressionAccessor, 0, len(conditions))
+	for i := range conditions {
+		accessors = append(accessors, (*matchconditions.MatchCondition)(&conditions[i]))
+	}
+
+	attributes := r.versionedAttributes()
+	admissionRequest := celplugin.CreateAdmissionRequest(attributes.Attributes,
+		metaV1.GroupVersionResource(r.resource), metaV1.GroupVersionKind(r.kind))
+	results, _, err := compiler.CompileCondition(accessors, options, environment.StoredExpressions).
+		ForInput(context.TODO(), attributes, admissionRequest, celplugin.OptionalVariableBindings{}, nil,
+			celconfig.RuntimeCELCostBudgetMatchConditions)
+	if err != nil {
+		return conditionsResult{matches: true, errors: []string{err.Error()}}
+	}
+
+	result := conditionsResult{matches: true}
+	for i, evaluation := range results {
+		if evaluation.Error != nil {
+			result.errors = append(result.errors, fmt.Sprintf("%s: %v", conditions[i].Name, evaluation.Error))
+			continue
+		}
+
+		// A single false condition excludes the request, even when other conditions fail.
+		if evaluation.EvalResult == celtypes.False {
+			return conditionsResult{matches: false}
+		}
+	}
+
+	return result
+}
+
+// versionedAttributes returns admission attributes of the request. The object only has the kind,
+// name, namespace and labels given in the spec.
+func (r *request) versionedAttributes() *genericadmission.VersionedAttributes {
+	object := &unstructured.Unstructured{}
+	object.SetGroupVersionKind(r.kind)
+	object.SetName(r.name)
+	object.SetLabels(r.labels)
+	if r.namespaced {
+		object.SetNamespace(r.namespace)
+	}
+
+	var newObject, oldObject runtime.Object
+	switch r.operation {
+	case admissionregistration.Create:
+		newObject = object
+	case admissionregistration.Update:
+		newObject, oldObject = object, object.DeepCopy()
+	case admissionregistration.Delete:
+		oldObject = object
+	}
+
+	attributes := genericadmission.NewAttributesRecord(newObject, oldObject, r.kind, object.GetNamespace(), r.name,
+		r.resource, r.subresource, genericadmission.Operation(r.operation), nil, false, nil)
+	return &genericadmission.VersionedAttributes{
+		Attributes:         attributes,
+		VersionedKind:      r.kind,
+		VersionedObject:    newObject,
+		VersionedOldObject: oldObject,
+	}
+}
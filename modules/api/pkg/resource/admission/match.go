@@ -0,0 +1,199 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"strings"
+
+	admissionregistration "k8s.io/api/admissionregistration/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// request describes an admission request the same way the API server matches it against
+// webhooks and policies.
+type request struct {
+	resource    schema.GroupVersionResource
+	kind        schema.GroupVersionKind
+	subresource string
+	operation   admissionregistration.OperationType
+	name        string
+	namespace   string
+
+	// namespaced is false for cluster-scoped objects.
+	namespaced bool
+
+	// isNamespace is set when the object is a Namespace, whose own labels are matched by
+	// namespace selectors.
+	isNamespace bool
+
+	labels          map[string]string
+	namespaceLabels map[string]string
+
+	// anyObject is set when any object in the namespace is matched, ignoring resources of
+	// rules and object selectors, which depend on the object.
+	anyObject bool
+}
+
+// webhookMatches returns whether rules and selectors of the webhook match the request. Match
+// conditions are evaluated separately.
+func webhookMatches(webhook *Webhook, r *request) (bool, error) {
+	ignoreVersion := webhook.MatchPolicy == string(admissionregistration.Equivalent)
+	if !rulesMatch(webhook.Rules, r, ignoreVersion) {
+		return false, nil
+	}
+
+	return selectorsMatch(webhook.NamespaceSelector, webhook.ObjectSelector, r)
+}
+
+// matchResourcesMatch returns whether match resources of a policy or a binding select the
+// request. Nil match resources select everything.
+func matchResourcesMatch(resources *admissionregistration.MatchResources, r *request) (bool, error) {
+	if resources == nil {
+		return true, nil
+	}
+
+	ignoreVersion := resources.MatchPolicy == nil || *resources.MatchPolicy == admissionregistration.Equivalent
+	if len(resources.ResourceRules) > 0 && !namedRulesMatch(resources.ResourceRules, r, ignoreVersion) {
+		return false, nil
+	}
+
+	if !r.anyObject && namedRulesMatch(resources.ExcludeResourceRules, r, ignoreVersion) {
+		return false, nil
+	}
+
+	return selectorsMatch(resources.NamespaceSelector, resources.ObjectSelector, r)
+}
+
+func rulesMatch(rules []admissionregistration.RuleWithOperations, r *request, ignoreVersion bool) bool {
+	for _, rule := range rules {
+		if ruleMatches(rule, r, ignoreVersion) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func namedRulesMatch(rules []admissionregistration.NamedRuleWithOperations, r *request, ignoreVersion bool) bool {
+	for _, rule := range rules {
+		if ruleMatches(rule.RuleWithOperations, r, ignoreVersion) && (r.anyObject || len(rule.ResourceNames) == 0 || contains(rule.ResourceNames, r.name)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ruleMatches follows the rule matching of the API server. With the Equivalent match policy,
+// requests for other versions of a resource are converted to the version of the rule, so the
+// version is not compared then.
+func ruleMatches(rule admissionregistration.RuleWithOperations, r *request, ignoreVersion bool) bool {
+	operations := make([]string, 0, len(rule.Operations))
+	for _, operation := range rule.Operations {
+		operations = append(operations, string(operation))
+	}
+
+	if !matchesAny(operations, string(r.operation)) {
+		return false
+	}
+
+	if r.anyObject {
+		// Any object in a namespace is only intercepted by rules for namespaced resources.
+		return rule.Scope == nil || *rule.Scope != admissionregistration.ClusterScope
+	}
+
+	if !scopeMatches(rule.Scope, r.namespaced) || !matchesAny(rule.APIGroups, r.resource.Group) {
+		return false
+	}
+
+	if !ignoreVersion && !matchesAny(rule.APIVersions, r.resource.Version) {
+		return false
+	}
+
+	for _, resource := range rule.Resources {
+		res, sub, _ := strings.Cut(resource, "/")
+		if (res == "*" || res == r.resource.Resource) && (sub == "*" || sub == r.subresource) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func scopeMatches(scope *admissionregistration.ScopeType, namespaced bool) bool {
+	if scope == nil {
+		return true
+	}
+
+	switch *scope {
+	case admissionregistration.ClusterScope:
+		return !namespaced
+	case admissionregistration.NamespacedScope:
+		return namespaced
+	default:
+		return true
+	}
+}
+
+// selectorsMatch matches namespace and object selectors. Namespace selectors are ignored for
+// cluster-scoped objects other than namespaces.
+func selectorsMatch(namespaceSelector, objectSelector *metaV1.LabelSelector, r *request) (bool, error) {
+	if r.namespaced || r.isNamespace {
+		namespaceLabels := r.namespaceLabels
+		if r.isNamespace {
+			namespaceLabels = r.labels
+		}
+
+		matches, err := selectorMatches(namespaceSelector, namespaceLabels)
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+
+	if r.anyObject {
+		return true, nil
+	}
+
+	return selectorMatches(objectSelector, r.labels)
+}
+
+func selectorMatches(selector *metaV1.LabelSelector, values map[string]string) (bool, error) {
+	if selector == nil {
+		return true, nil
+	}
+
+	s, err := metaV1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false, err
+	}
+
+	return s.Matches(labels.Set(values)), nil
+}
+
+func matchesAny(values []string, value string) bool {
+	return contains(values, "*") || contains(values, value)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
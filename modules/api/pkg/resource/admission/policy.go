@@ -0,0 +1,242 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+
+	admissionregistration "k8s.io/api/admissionregistration/v1"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// ValidatingAdmissionPolicyList holds a list of validating admission policies in the cluster.
+type ValidatingAdmissionPolicyList struct {
+	ListMeta types.ListMeta              `json:"listMeta"`
+	Items    []ValidatingAdmissionPolicy `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// ValidatingAdmissionPolicy is a representation of a ValidatingAdmissionPolicy.
+type ValidatingAdmissionPolicy struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+
+	FailurePolicy string                           `json:"failurePolicy"`
+	ParamKind     *admissionregistration.ParamKind `json:"paramKind,omitempty"`
+
+	// Validations is the number of CEL validations of the policy.
+	Validations int `json:"validations"`
+
+	// Bindings is the number of bindings of the policy. Policies without bindings have no effect.
+	Bindings int `json:"bindings"`
+}
+
+// ValidatingAdmissionPolicyDetail provides the presentation layer view of a validating admission
+// policy with its type checking status and bindings.
+type ValidatingAdmissionPolicyDetail struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+
+	FailurePolicy    string                                  `json:"failurePolicy"`
+	ParamKind        *admissionregistration.ParamKind        `json:"paramKind,omitempty"`
+	MatchConstraints *admissionregistration.MatchResources   `json:"matchConstraints,omitempty"`
+	MatchConditions  []admissionregistration.MatchCondition  `json:"matchConditions"`
+	Validations      []admissionregistration.Validation      `json:"validations"`
+	AuditAnnotations []admissionregistration.AuditAnnotation `json:"auditAnnotations,omitempty"`
+	Variables        []admissionregistration.Variable        `json:"variables,omitempty"`
+
+	// TypeCheckingWarnings are reported by the API server for expressions failing type checking.
+	TypeCheckingWarnings []admissionregistration.ExpressionWarning `json:"typeCheckingWarnings"`
+	Conditions           []common.Condition                        `json:"conditions"`
+
+	Bindings []ValidatingAdmissionPolicyBinding `json:"bindings"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// ValidatingAdmissionPolicyBinding binds a policy to resources and parameters.
+type ValidatingAdmissionPolicyBinding struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+
+	ValidationActions []admissionregistration.ValidationAction `json:"validationActions"`
+	ParamRef          *admissionregistration.ParamRef          `json:"paramRef,omitempty"`
+	MatchResources    *admissionregistration.MatchResources    `json:"matchResources,omitempty"`
+}
+
+// GetValidatingAdmissionPolicyList returns all validating admission policies in the cluster. The
+// list is empty on servers not serving admissionregistration.k8s.io/v1 policies.
+func GetValidatingAdmissionPolicyList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*ValidatingAdmissionPolicyList, error) {
+	klog.V(4).Info("Getting list of validating admission policies")
+	channels := &common.ResourceChannels{
+		ValidatingAdmissionPolicyList:        common.GetValidatingAdmissionPolicyListChannel(client, 1),
+		ValidatingAdmissionPolicyBindingList: common.GetValidatingAdmissionPolicyBindingListChannel(client, 1),
+	}
+
+	result := &ValidatingAdmissionPolicyList{Items: make([]ValidatingAdmissionPolicy, 0), Errors: make([]error, 0)}
+	policies, bindings, nonCriticalErrors, err := getPoliciesAndBindings(channels)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Errors = nonCriticalErrors
+	cells, filteredTotal := dataselect.GenericDataSelectWithFilter(toValidatingAdmissionPolicyCells(policies), dsQuery)
+	for _, policy := range fromValidatingAdmissionPolicyCells(cells) {
+		result.Items = append(result.Items, toValidatingAdmissionPolicy(&policy, len(bindingsOf(policy.Name, bindings))))
+	}
+
+	result.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+	return result, nil
+}
+
+// GetValidatingAdmissionPolicyDetail returns the validating admission policy with its bindings.
+func GetValidatingAdmissionPolicyDetail(client kubernetes.Interface, name string) (*ValidatingAdmissionPolicyDetail, error) {
+	klog.V(4).Infof("Getting details of %s validating admission policy", name)
+	policy, err := client.AdmissionregistrationV1().ValidatingAdmissionPolicies().Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	channels := &common.ResourceChannels{
+		ValidatingAdmissionPolicyBindingList: common.GetValidatingAdmissionPolicyBindingListChannel(client, 1),
+	}
+
+	bindingList := <-channels.ValidatingAdmissionPolicyBindingList.List
+	err = <-channels.ValidatingAdmissionPolicyBindingList.Error
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	bindings := make([]ValidatingAdmissionPolicyBinding, 0)
+	if bindingList != nil {
+		for _, binding := range bindingsOf(name, bindingList.Items) {
+			bindings = append(bindings, toValidatingAdmissionPolicyBinding(binding))
+		}
+	}
+
+	result := &ValidatingAdmissionPolicyDetail{
+		ObjectMeta:           types.NewObjectMeta(policy.ObjectMeta),
+		TypeMeta:             types.NewTypeMeta(types.ResourceKindValidatingAdmissionPolicy),
+		FailurePolicy:        stringOrDefault(policy.Spec.FailurePolicy, admissionregistration.Fail),
+		ParamKind:            policy.Spec.ParamKind,
+		MatchConstraints:     policy.Spec.MatchConstraints,
+		MatchConditions:      nonNilMatchConditions(policy.Spec.MatchConditions),
+		Validations:          policy.Spec.Validations,
+		AuditAnnotations:     policy.Spec.AuditAnnotations,
+		Variables:            policy.Spec.Variables,
+		TypeCheckingWarnings: make([]admissionregistration.ExpressionWarning, 0),
+		Conditions:           make([]common.Condition, 0, len(policy.Status.Conditions)),
+		Bindings:             bindings,
+		Errors:               nonCriticalErrors,
+	}
+
+	if result.Validations == nil {
+		result.Validations = make([]admissionregistration.Validation, 0)
+	}
+
+	if policy.Status.TypeChecking != nil {
+		result.TypeCheckingWarnings = append(result.TypeCheckingWarnings, policy.Status.TypeChecking.ExpressionWarnings...)
+	}
+
+	for _, condition := range policy.Status.Conditions {
+		result.Conditions = append(result.Conditions, common.Condition{
+			Type:               condition.Type,
+			Status:             v1.ConditionStatus(condition.Status),
+			LastTransitionTime: condition.LastTransitionTime,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		})
+	}
+
+	return result, nil
+}
+
+// getPoliciesAndBindings reads policies and their bindings from the channels. Servers not serving
+// the policies return not found, which results in no policies.
+func getPoliciesAndBindings(channels *common.ResourceChannels) ([]admissionregistration.ValidatingAdmissionPolicy,
+	[]admissionregistration.ValidatingAdmissionPolicyBinding, []error, error) {
+	policyList := <-channels.ValidatingAdmissionPolicyList.List
+	policyErr := <-channels.ValidatingAdmissionPolicyList.Error
+	bindingList := <-channels.ValidatingAdmissionPolicyBindingList.List
+	bindingErr := <-channels.ValidatingAdmissionPolicyBindingList.Error
+
+	policies := make([]admissionregistration.ValidatingAdmissionPolicy, 0)
+	bindings := make([]admissionregistration.ValidatingAdmissionPolicyBinding, 0)
+	if errors.IsNotFound(policyErr) {
+		return policies, bindings, make([]error, 0), nil
+	}
+
+	nonCriticalErrors, criticalError := errors.ExtractErrors(policyErr)
+	if criticalError != nil {
+		return nil, nil, nil, criticalError
+	}
+
+	nonCriticalErrors, criticalError = errors.AppendError(bindingErr, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, nil, nil, criticalError
+	}
+
+	if policyList != nil {
+		policies = policyList.Items
+	}
+
+	if bindingList != nil {
+		bindings = bindingList.Items
+	}
+
+	return policies, bindings, nonCriticalErrors, nil
+}
+
+func bindingsOf(policyName string, bindings []admissionregistration.ValidatingAdmissionPolicyBinding) []admissionregistration.ValidatingAdmissionPolicyBinding {
+	result := make([]admissionregistration.ValidatingAdmissionPolicyBinding, 0)
+	for _, binding := range bindings {
+		if binding.Spec.PolicyName == policyName {
+			result = append(result, binding)
+		}
+	}
+
+	return result
+}
+
+func toValidatingAdmissionPolicy(policy *admissionregistration.ValidatingAdmissionPolicy, bindings int) ValidatingAdmissionPolicy {
+	return ValidatingAdmissionPolicy{
+		ObjectMeta:    types.NewObjectMeta(policy.ObjectMeta),
+		TypeMeta:      types.NewTypeMeta(types.ResourceKindValidatingAdmissionPolicy),
+		FailurePolicy: stringOrDefault(policy.Spec.FailurePolicy, admissionregistration.Fail),
+		ParamKind:     policy.Spec.ParamKind,
+		Validations:   len(policy.Spec.Validations),
+		Bindings:      bindings,
+	}
+}
+
+func toValidatingAdmissionPolicyBinding(binding admissionregistration.ValidatingAdmissionPolicyBinding) ValidatingAdmissionPolicyBinding {
+	return ValidatingAdmissionPolicyBinding{
+		ObjectMeta:        types.NewObjectMeta(binding.ObjectMeta),
+		ValidationActions: binding.Spec.ValidationActions,
+		ParamRef:          binding.Spec.ParamRef,
+		MatchResources:    binding.Spec.MatchResources,
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+
+	admissionregistration "k8s.io/api/admissionregistration/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// WebhookConfigurationList holds a list of validating or mutating webhook configurations in the cluster.
+type WebhookConfigurationList struct {
+	ListMeta types.ListMeta         `json:"listMeta"`
+	Items    []WebhookConfiguration `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// WebhookConfiguration is a representation of a ValidatingWebhookConfiguration or a
+// MutatingWebhookConfiguration, told apart by the type meta.
+type WebhookConfiguration struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+
+	// Webhooks is the number of webhooks in the configuration.
+	Webhooks int `json:"webhooks"`
+}
+
+// WebhookConfigurationDetail provides the presentation layer view of a webhook configuration.
+type WebhookConfigurationDetail struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+	Webhooks   []Webhook        `json:"webhooks"`
+}
+
+// Webhook is a single validating or mutating admission webhook.
+type Webhook struct {
+	Name         string              `json:"name"`
+	ClientConfig WebhookClientConfig `json:"clientConfig"`
+
+	Rules             []admissionregistration.RuleWithOperations `json:"rules"`
+	NamespaceSelector *metaV1.LabelSelector                      `json:"namespaceSelector,omitempty"`
+	ObjectSelector    *metaV1.LabelSelector                      `json:"objectSelector,omitempty"`
+	MatchConditions   []admissionregistration.MatchCondition     `json:"matchConditions"`
+
+	FailurePolicy           string   `json:"failurePolicy"`
+	MatchPolicy             string   `json:"matchPolicy"`
+	SideEffects             string   `json:"sideEffects"`
+	TimeoutSeconds          *int32   `json:"timeoutSeconds,omitempty"`
+	AdmissionReviewVersions []string `json:"admissionReviewVersions"`
+
+	// ReinvocationPolicy is set for mutating webhooks only.
+	ReinvocationPolicy string `json:"reinvocationPolicy,omitempty"`
+}
+
+// WebhookClientConfig tells how the webhook is called. The CA bundle is not exposed.
+type WebhookClientConfig struct {
+	URL     *string                                 `json:"url,omitempty"`
+	Service *admissionregistration.ServiceReference `json:"service,omitempty"`
+}
+
+// GetValidatingWebhookConfigurationList returns all validating webhook configurations in the cluster.
+func GetValidatingWebhookConfigurationList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*WebhookConfigurationList, error) {
+	klog.V(4).Info("Getting list of validating webhook configurations")
+	channels := &common.ResourceChannels{
+		ValidatingWebhookConfigurationList: common.GetValidatingWebhookConfigurationListChannel(client, 1),
+	}
+
+	configurations := <-channels.ValidatingWebhookConfigurationList.List
+	err := <-channels.ValidatingWebhookConfigurationList.Error
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	result := &WebhookConfigurationList{Items: make([]WebhookConfiguration, 0), Errors: nonCriticalErrors}
+	if configurations == nil {
+		return result, nil
+	}
+
+	cells, filteredTotal := dataselect.GenericDataSelectWithFilter(toValidatingWebhookConfigurationCells(configurations.Items), dsQuery)
+	for _, configuration := range fromValidatingWebhookConfigurationCells(cells) {
+		result.Items = append(result.Items, WebhookConfiguration{
+			ObjectMeta: types.NewObjectMeta(configuration.ObjectMeta),
+			TypeMeta:   types.NewTypeMeta(types.ResourceKindValidatingWebhookConfiguration),
+			Webhooks:   len(configuration.Webhooks),
+		})
+	}
+
+	result.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+	return result, nil
+}
+
+// GetValidatingWebhookConfigurationDetail returns the validating webhook configuration.
+func GetValidatingWebhookConfigurationDetail(client kubernetes.Interface, name string) (*WebhookConfigurationDetail, error) {
+	klog.V(4).Infof("Getting details of %s validating webhook configuration", name)
+	configuration, err := client.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]Webhook, 0, len(configuration.Webhooks))
+	for _, webhook := range configuration.Webhooks {
+		webhooks = append(webhooks, fromValidatingWebhook(webhook))
+	}
+
+	return &WebhookConfigurationDetail{
+		ObjectMeta: types.NewObjectMeta(configuration.ObjectMeta),
+		TypeMeta:   types.NewTypeMeta(types.ResourceKindValidatingWebhookConfiguration),
+		Webhooks:   webhooks,
+	}, nil
+}
+
+// GetMutatingWebhookConfigurationList returns all mutating webhook configurations in the cluster.
+func GetMutatingWebhookConfigurationList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*WebhookConfigurationList, error) {
+	klog.V(4).Info("Getting list of mutating webhook configurations")
+	channels := &common.ResourceChannels{
+		MutatingWebhookConfigurationList: common.GetMutatingWebhookConfigurationListChannel(client, 1),
+	}
+
+	configurations := <-channels.MutatingWebhookConfigurationList.List
+	err := <-channels.MutatingWebhookConfigurationList.Error
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	result := &WebhookConfigurationList{Items: make([]WebhookConfiguration, 0), Errors: nonCriticalErrors}
+	if configurations == nil {
+		return result, nil
+	}
+
+	cells, filteredTotal := dataselect.GenericDataSelectWithFilter(toMutatingWebhookConfigurationCells(configurations.Items), dsQuery)
+	for _, configuration := range fromMutatingWebhookConfigurationCells(cells) {
+		result.Items = append(result.Items, WebhookConfiguration{
+			ObjectMeta: types.NewObjectMeta(configuration.ObjectMeta),
+			TypeMeta:   types.NewTypeMeta(types.ResourceKindMutatingWebhookConfiguration),
+			Webhooks:   len(configuration.Webhooks),
+		})
+	}
+
+	result.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+	return result, nil
+}
+
+// GetMutatingWebhookConfigurationDetail returns the mutating webhook configuration.
+func GetMutatingWebhookConfigurationDetail(client kubernetes.Interface, name string) (*WebhookConfigurationDetail, error) {
+	klog.V(4).Infof("Getting details of %s mutating webhook configuration", name)
+	configuration, err := client.AdmissionregistrationV1().MutatingWebhookConfigurations().Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]Webhook, 0, len(configuration.Webhooks))
+	for _, webhook := range configuration.Webhooks {
+		webhooks = append(webhooks, fromMutatingWebhook(webhook))
+	}
+
+	return &WebhookConfigurationDetail{
+		ObjectMeta: types.NewObjectMeta(configuration.ObjectMeta),
+		TypeMeta:   types.NewTypeMeta(types.ResourceKindMutatingWebhookConfiguration),
+		Webhooks:   webhooks,
+	}, nil
+}
+
+func fromValidatingWebhook(webhook admissionregistration.ValidatingWebhook) Webhook {
+	return Webhook{
+		Name:                    webhook.Name,
+		ClientConfig:            toClientConfig(webhook.ClientConfig),
+		Rules:                   nonNilRules(webhook.Rules),
+		NamespaceSelector:       webhook.NamespaceSelector,
+		ObjectSelector:          webhook.ObjectSelector,
+		MatchConditions:         nonNilMatchConditions(webhook.MatchConditions),
+		FailurePolicy:           stringOrDefault(webhook.FailurePolicy, admissionregistration.Fail),
+		MatchPolicy:             stringOrDefault(webhook.MatchPolicy, admissionregistration.Equivalent),
+		SideEffects:             stringOrDefault(webhook.SideEffects, ""),
+		TimeoutSeconds:          webhook.TimeoutSeconds,
+		AdmissionReviewVersions: webhook.AdmissionReviewVersions,
+	}
+}
+
+func fromMutatingWebhook(webhook admissionregistration.MutatingWebhook) Webhook {
+	return Webhook{
+		Name:                    webhook.Name,
+		ClientConfig:            toClientConfig(webhook.ClientConfig),
+		Rules:                   nonNilRules(webhook.Rules),
+		NamespaceSelector:       webhook.NamespaceSelector,
+		ObjectSelector:          webhook.ObjectSelector,
+		MatchConditions:         nonNilMatchConditions(webhook.MatchConditions),
+		FailurePolicy:           stringOrDefault(webhook.FailurePolicy, admissionregistration.Fail),
+		MatchPolicy:             stringOrDefault(webhook.MatchPolicy, admissionregistration.Equivalent),
+		SideEffects:             stringOrDefault(webhook.SideEffects, ""),
+		TimeoutSeconds:          webhook.TimeoutSeconds,
+		AdmissionReviewVersions: webhook.AdmissionReviewVersions,
+		ReinvocationPolicy:      stringOrDefault(webhook.ReinvocationPolicy, admissionregistration.NeverReinvocationPolicy),
+	}
+}
+
+func toClientConfig(config admissionregistration.WebhookClientConfig) WebhookClientConfig {
+	return WebhookClientConfig{URL: config.URL, Service: config.Service}
+}
+
+func nonNilRules(rules []admissionregistration.RuleWithOperations) []admissionregistration.RuleWithOperations {
+	if rules == nil {
+		return make([]admissionregistration.RuleWithOperations, 0)
+	}
+
+	return rules
+}
+
+func nonNilMatchConditions(conditions []admissionregistration.MatchCondition) []admissionregistration.MatchCondition {
+	if conditions == nil {
+		return make([]admissionregistration.MatchCondition, 0)
+	}
+
+	return conditions
+}
+
+// stringOrDefault returns the value of an optional string typed field of the API, which is
+// defaulted by the API server on creation.
+func stringOrDefault[T ~string](value *T, defaultValue T) string {
+	if value == nil {
+		return string(defaultValue)
+	}
+
+	return string(*value)
+}
@@ -0,0 +1,79 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s.io/dashboard/errors"
+)
+
+// CheckInstalled returns whether the resource of an optional CRD, e.g. a Gateway API or a volume
+// snapshot one, is served by the cluster. CRDs of a group version may be installed separately,
+// so the resource itself is looked up.
+func CheckInstalled(client kubernetes.Interface, resource schema.GroupVersionResource) (bool, error) {
+	resources, err := client.Discovery().ServerResourcesForGroupVersion(resource.GroupVersion().String())
+	if k8serrors.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, r := range resources.APIResources {
+		if r.Name == resource.Resource {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// RequireInstalled fails with a bad request when the resource is not served by the cluster.
+func RequireInstalled(client kubernetes.Interface, resource schema.GroupVersionResource) error {
+	installed, err := CheckInstalled(client, resource)
+	if err != nil {
+		return err
+	}
+
+	if !installed {
+		return errors.NewBadRequest(fmt.Sprintf("%s of %s are not installed", resource.Resource, resource.GroupVersion().String()))
+	}
+
+	return nil
+}
+
+// FromUnstructured converts the object into a type mirroring the parts of a custom resource used
+// by Dashboard. Mirror types are used for CRDs whose client libraries are not a dependency.
+func FromUnstructured(obj *unstructured.Unstructured, into interface{}) error {
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), into)
+}
+
+// ToUnstructured converts the object of a mirror type back into an unstructured one.
+func ToUnstructured(obj interface{}) (*unstructured.Unstructured, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unstructured.Unstructured{Object: content}, nil
+}
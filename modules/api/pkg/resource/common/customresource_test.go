@@ -0,0 +1,56 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCheckInstalled(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	client.Resources = []*metaV1.APIResourceList{{
+		GroupVersion: "example.com/v1",
+		APIResources: []metaV1.APIResource{{Name: "widgets", Kind: "Widget", Namespaced: true}},
+	}}
+
+	cases := []struct {
+		resource schema.GroupVersionResource
+		expected bool
+	}{
+		{schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}, true},
+		{schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "gadgets"}, false},
+		{schema.GroupVersionResource{Group: "other.com", Version: "v1", Resource: "widgets"}, false},
+	}
+
+	for _, c := range cases {
+		actual, err := CheckInstalled(client, c.resource)
+		if err != nil {
+			t.Fatalf("CheckInstalled(%s) returned error: %v", c.resource, err)
+		}
+
+		if actual != c.expected {
+			t.Errorf("CheckInstalled(%s) == %t, expected %t", c.resource, actual, c.expected)
+		}
+
+		if err := RequireInstalled(client, c.resource); c.expected == (err != nil) || (err != nil && !k8serrors.IsBadRequest(err)) {
+			t.Errorf("RequireInstalled(%s) returned %v", c.resource, err)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+)
+
+// excludedNamespacedResources lists resources that are never applied by users, as they are
+// recreated by the cluster or are not meaningful outside of it.
+var excludedNamespacedResources = sets.New(
+	"events",
+	"events.events.k8s.io",
+	"endpoints",
+	"endpointslices.discovery.k8s.io",
+	"controllerrevisions.apps",
+	"leases.coordination.k8s.io",
+)
+
+// NamespacedResourceLister lists namespaced resources served by the API server.
+// discovery.DiscoveryInterface implements it.
+type NamespacedResourceLister interface {
+	ServerPreferredNamespacedResources() ([]*metaV1.APIResourceList, error)
+}
+
+// ListNamespacedObjects lists objects of the namespace for all discovered resources accepted by
+// the filter and passes them to visit with their kinds set. Resources never applied by users,
+// e.g. events, are skipped. Groups that could not be discovered and resources that could not be
+// listed, e.g. because of missing permissions, are returned as non-critical errors.
+func ListNamespacedObjects(lister NamespacedResourceLister, client dynamic.Interface, namespace string,
+	filter func(gvr schema.GroupVersionResource, resource metaV1.APIResource) bool,
+	visit func(gvr schema.GroupVersionResource, object *unstructured.Unstructured)) ([]string, error) {
+	var nonCriticalErrors []string
+
+	resourceLists, err := lister.ServerPreferredNamespacedResources()
+	if err != nil {
+		if !discovery.IsGroupDiscoveryFailedError(err) {
+			return nil, err
+		}
+
+		klog.V(3).InfoS("could not discover all resources", "error", err)
+		nonCriticalErrors = append(nonCriticalErrors, err.Error())
+	}
+
+	for _, list := range resourceLists {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resource := range list.APIResources {
+			gvr := gv.WithResource(resource.Name)
+			if excludedNamespacedResources.Has(gvr.GroupResource().String()) || !filter(gvr, resource) {
+				continue
+			}
+
+			objects, err := client.Resource(gvr).Namespace(namespace).List(context.TODO(), metaV1.ListOptions{})
+			if k8serrors.IsForbidden(err) || k8serrors.IsNotFound(err) || k8serrors.IsMethodNotSupported(err) {
+				nonCriticalErrors = append(nonCriticalErrors, fmt.Sprintf("%s: %s", gvr.GroupResource(), err))
+				continue
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			for i := range objects.Items {
+				// List responses do not include kinds of items.
+				objects.Items[i].SetGroupVersionKind(gv.WithKind(resource.Kind))
+				visit(gvr, &objects.Items[i])
+			}
+		}
+	}
+
+	return nonCriticalErrors, nil
+}
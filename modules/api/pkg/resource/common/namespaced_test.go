@@ -0,0 +1,92 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"reflect"
+	"testing"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+type fakeNamespacedResourceLister []*metaV1.APIResourceList
+
+func (in fakeNamespacedResourceLister) ServerPreferredNamespacedResources() ([]*metaV1.APIResourceList, error) {
+	return in, nil
+}
+
+func TestListNamespacedObjects(t *testing.T) {
+	verbs := metaV1.Verbs{"get", "list"}
+	lister := fakeNamespacedResourceLister{
+		{
+			GroupVersion: "v1",
+			APIResources: []metaV1.APIResource{
+				{Name: "configmaps", Namespaced: true, Kind: "ConfigMap", Verbs: verbs},
+				{Name: "secrets", Namespaced: true, Kind: "Secret", Verbs: verbs},
+				{Name: "events", Namespaced: true, Kind: "Event", Verbs: verbs},
+				{Name: "pods", Namespaced: true, Kind: "Pod", Verbs: verbs},
+			},
+		},
+	}
+
+	listKinds := map[schema.GroupVersionResource]string{
+		{Version: "v1", Resource: "configmaps"}: "ConfigMapList",
+		{Version: "v1", Resource: "secrets"}:    "SecretList",
+		{Version: "v1", Resource: "events"}:     "EventList",
+		{Version: "v1", Resource: "pods"}:       "PodList",
+	}
+
+	configMap := &unstructured.Unstructured{}
+	configMap.SetAPIVersion("v1")
+	configMap.SetKind("ConfigMap")
+	configMap.SetNamespace("default")
+	configMap.SetName("settings")
+
+	event := configMap.DeepCopy()
+	event.SetKind("Event")
+	event.SetName("settings.created")
+
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, configMap, event)
+	client.PrependReactor("list", "secrets", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, k8serrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "", nil)
+	})
+
+	skipPods := func(gvr schema.GroupVersionResource, _ metaV1.APIResource) bool {
+		return gvr.Resource != "pods"
+	}
+
+	visited := make([]string, 0)
+	errs, err := ListNamespacedObjects(lister, client, "default", skipPods,
+		func(gvr schema.GroupVersionResource, object *unstructured.Unstructured) {
+			visited = append(visited, gvr.Resource+"/"+object.GetKind()+"/"+object.GetName())
+		})
+	if err != nil {
+		t.Fatalf("ListNamespacedObjects() error = %v", err)
+	}
+
+	if expected := []string{"configmaps/ConfigMap/settings"}; !reflect.DeepEqual(visited, expected) {
+		t.Errorf("ListNamespacedObjects() visited %v, expected %v", visited, expected)
+	}
+
+	if len(errs) != 1 {
+		t.Errorf("ListNamespacedObjects() errors = %v, expected one error for secrets", errs)
+	}
+}
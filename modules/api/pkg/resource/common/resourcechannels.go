@@ -17,13 +17,18 @@ package common
 import (
 	"context"
 
+	admissionregistration "k8s.io/api/admissionregistration/v1"
 	apps "k8s.io/api/apps/v1"
 	autoscaling "k8s.io/api/autoscaling/v1"
 	batch "k8s.io/api/batch/v1"
+	coordination "k8s.io/api/coordination/v1"
 	v1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	nodev1 "k8s.io/api/node/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbac "k8s.io/api/rbac/v1"
+	scheduling "k8s.io/api/scheduling/v1"
 	storage "k8s.io/api/storage/v1"
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
@@ -69,6 +74,9 @@ type ResourceChannels struct {
 	// List and error channels to Endpoints.
 	EndpointList EndpointListChannel
 
+	// List and error channels to EndpointSlices.
+	EndpointSliceList EndpointSliceListChannel
+
 	// List and error channels to Ingresses.
 	IngressList IngressListChannel
 
@@ -127,6 +135,36 @@ type ResourceChannels struct {
 	ClusterRoleBindingList ClusterRoleBindingListChannel
 
 	PodDisruptionBudget PodDisruptionBudgetListChannel
+
+	// List and error channels to ValidatingWebhookConfigurations
+	ValidatingWebhookConfigurationList ValidatingWebhookConfigurationListChannel
+
+	// List and error channels to MutatingWebhookConfigurations
+	MutatingWebhookConfigurationList MutatingWebhookConfigurationListChannel
+
+	// List and error channels to ValidatingAdmissionPolicies
+	ValidatingAdmissionPolicyList ValidatingAdmissionPolicyListChannel
+
+	// List and error channels to ValidatingAdmissionPolicyBindings
+	ValidatingAdmissionPolicyBindingList ValidatingAdmissionPolicyBindingListChannel
+
+	// List and error channels to PriorityClasses
+	PriorityClassList PriorityClassListChannel
+
+	// List and error channels to RuntimeClasses
+	RuntimeClassList RuntimeClassListChannel
+
+	// List and error channels to Leases
+	LeaseList LeaseListChannel
+
+	// List and error channels to CSIDrivers
+	CSIDriverList CSIDriverListChannel
+
+	// List and error channels to CSINodes
+	CSINodeList CSINodeListChannel
+
+	// List and error channels to VolumeAttachments
+	VolumeAttachmentList VolumeAttachmentListChannel
 }
 
 // ServiceListChannel is a list and error channels to Services.
@@ -342,6 +380,33 @@ func GetEndpointListChannelWithOptions(client client.Interface,
 	return channel
 }
 
+// EndpointSliceListChannel is a list and error channels to EndpointSlices.
+type EndpointSliceListChannel struct {
+	List  chan *discovery.EndpointSliceList
+	Error chan error
+}
+
+// GetEndpointSliceListChannelWithOptions returns a pair of channels to an EndpointSlice list
+// filtered by provided options and errors that both must be read numReads times.
+func GetEndpointSliceListChannelWithOptions(client client.Interface,
+	nsQuery *NamespaceQuery, opt metaV1.ListOptions, numReads int) EndpointSliceListChannel {
+	channel := EndpointSliceListChannel{
+		List:  make(chan *discovery.EndpointSliceList, numReads),
+		Error: make(chan error, numReads),
+	}
+
+	go func() {
+		list, err := client.DiscoveryV1().EndpointSlices(nsQuery.ToRequestParam()).List(context.TODO(), opt)
+
+		for i := 0; i < numReads; i++ {
+			channel.List <- list
+			channel.Error <- err
+		}
+	}()
+
+	return channel
+}
+
 // PodListChannel is a list and error channels to Pods.
 type PodListChannel struct {
 	List  chan *v1.PodList
@@ -996,3 +1061,263 @@ func GetIngressClassListChannel(client client.Interface, numReads int) IngressCl
 
 	return channel
 }
+
+// ValidatingWebhookConfigurationListChannel is a list and error channels to validating webhook configurations.
+type ValidatingWebhookConfigurationListChannel struct {
+	List  chan *admissionregistration.ValidatingWebhookConfigurationList
+	Error chan error
+}
+
+// GetValidatingWebhookConfigurationListChannel returns a pair of channels to a validating webhook configuration
+// list and errors that both must be read numReads times.
+func GetValidatingWebhookConfigurationListChannel(client client.Interface, numReads int) ValidatingWebhookConfigurationListChannel {
+	channel := ValidatingWebhookConfigurationListChannel{
+		List:  make(chan *admissionregistration.ValidatingWebhookConfigurationList, numReads),
+		Error: make(chan error, numReads),
+	}
+
+	go func() {
+		list, err := client.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(context.TODO(), helpers.ListEverything)
+		for i := 0; i < numReads; i++ {
+			channel.List <- list
+			channel.Error <- err
+		}
+	}()
+
+	return channel
+}
+
+// MutatingWebhookConfigurationListChannel is a list and error channels to mutating webhook configurations.
+type MutatingWebhookConfigurationListChannel struct {
+	List  chan *admissionregistration.MutatingWebhookConfigurationList
+	Error chan error
+}
+
+// GetMutatingWebhookConfigurationListChannel returns a pair of channels to a mutating webhook configuration
+// list and errors that both must be read numReads times.
+func GetMutatingWebhookConfigurationListChannel(client client.Interface, numReads int) MutatingWebhookConfigurationListChannel {
+	channel := MutatingWebhookConfigurationListChannel{
+		List:  make(chan *admissionregistration.MutatingWebhookConfigurationList, numReads),
+		Error: make(chan error, numReads),
+	}
+
+	go func() {
+		list, err := client.AdmissionregistrationV1().MutatingWebhookConfigurations().List(context.TODO(), helpers.ListEverything)
+		for i := 0; i < numReads; i++ {
+			channel.List <- list
+			channel.Error <- err
+		}
+	}()
+
+	return channel
+}
+
+// ValidatingAdmissionPolicyListChannel is a list and error channels to validating admission policies.
+type ValidatingAdmissionPolicyListChannel struct {
+	List  chan *admissionregistration.ValidatingAdmissionPolicyList
+	Error chan error
+}
+
+// GetValidatingAdmissionPolicyListChannel returns a pair of channels to a validating admission policy
+// list and errors that both must be read numReads times.
+func GetValidatingAdmissionPolicyListChannel(client client.Interface, numReads int) ValidatingAdmissionPolicyListChannel {
+	channel := ValidatingAdmissionPolicyListChannel{
+		List:  make(chan *admissionregistration.ValidatingAdmissionPolicyList, numReads),
+		Error: make(chan error, numReads),
+	}
+
+	go func() {
+		list, err := client.AdmissionregistrationV1().ValidatingAdmissionPolicies().List(context.TODO(), helpers.ListEverything)
+		for i := 0; i < numReads; i++ {
+			channel.List <- list
+			channel.Error <- err
+		}
+	}()
+
+	return channel
+}
+
+// ValidatingAdmissionPolicyBindingListChannel is a list and error channels to validating admission policy bindings.
+type ValidatingAdmissionPolicyBindingListChannel struct {
+	List  chan *admissionregistration.ValidatingAdmissionPolicyBindingList
+	Error chan error
+}
+
+// GetValidatingAdmissionPolicyBindingListChannel returns a pair of channels to a validating admission policy binding
+// list and errors that both must be read numReads times.
+func GetValidatingAdmissionPolicyBindingListChannel(client client.Interface, numReads int) ValidatingAdmissionPolicyBindingListChannel {
+	channel := ValidatingAdmissionPolicyBindingListChannel{
+		List:  make(chan *admissionregistration.ValidatingAdmissionPolicyBindingList, numReads),
+		Error: make(chan error, numReads),
+	}
+
+	go func() {
+		list, err := client.AdmissionregistrationV1().ValidatingAdmissionPolicyBindings().List(context.TODO(), helpers.ListEverything)
+		for i := 0; i < numReads; i++ {
+			channel.List <- list
+			channel.Error <- err
+		}
+	}()
+
+	return channel
+}
+
+// PriorityClassListChannel is a list and error channels to priority classes.
+type PriorityClassListChannel struct {
+	List  chan *scheduling.PriorityClassList
+	Error chan error
+}
+
+// GetPriorityClassListChannel returns a pair of channels to a priority class list and errors
+// that both must be read numReads times.
+func GetPriorityClassListChannel(client client.Interface, numReads int) PriorityClassListChannel {
+	channel := PriorityClassListChannel{
+		List:  make(chan *scheduling.PriorityClassList, numReads),
+		Error: make(chan error, numReads),
+	}
+
+	go func() {
+		list, err := client.SchedulingV1().PriorityClasses().List(context.TODO(), helpers.ListEverything)
+		for i := 0; i < numReads; i++ {
+			channel.List <- list
+			channel.Error <- err
+		}
+	}()
+
+	return channel
+}
+
+// RuntimeClassListChannel is a list and error channels to runtime classes.
+type RuntimeClassListChannel struct {
+	List  chan *nodev1.RuntimeClassList
+	Error chan error
+}
+
+// GetRuntimeClassListChannel returns a pair of channels to a runtime class list and errors
+// that both must be read numReads times.
+func GetRuntimeClassListChannel(client client.Interface, numReads int) RuntimeClassListChannel {
+	channel := RuntimeClassListChannel{
+		List:  make(chan *nodev1.RuntimeClassList, numReads),
+		Error: make(chan error, numReads),
+	}
+
+	go func() {
+		list, err := client.NodeV1().RuntimeClasses().List(context.TODO(), helpers.ListEverything)
+		for i := 0; i < numReads; i++ {
+			channel.List <- list
+			channel.Error <- err
+		}
+	}()
+
+	return channel
+}
+
+// LeaseListChannel is a list and error channels to Leases.
+type LeaseListChannel struct {
+	List  chan *coordination.LeaseList
+	Error chan error
+}
+
+// GetLeaseListChannel returns a pair of channels to a Lease list for a namespace and errors that
+// both must be read numReads times.
+func GetLeaseListChannel(client client.Interface, nsQuery *NamespaceQuery, numReads int) LeaseListChannel {
+	channel := LeaseListChannel{
+		List:  make(chan *coordination.LeaseList, numReads),
+		Error: make(chan error, numReads),
+	}
+
+	go func() {
+		list, err := client.CoordinationV1().Leases(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+		if err == nil {
+			filteredItems := make([]coordination.Lease, 0)
+			for _, item := range list.Items {
+				if nsQuery.Matches(item.Namespace) {
+					filteredItems = append(filteredItems, item)
+				}
+			}
+			list.Items = filteredItems
+		}
+
+		for i := 0; i < numReads; i++ {
+			channel.List <- list
+			channel.Error <- err
+		}
+	}()
+
+	return channel
+}
+
+// CSIDriverListChannel is a list and error channels to CSI drivers.
+type CSIDriverListChannel struct {
+	List  chan *storage.CSIDriverList
+	Error chan error
+}
+
+// GetCSIDriverListChannel returns a pair of channels to a CSI driver list and errors
+// that both must be read numReads times.
+func GetCSIDriverListChannel(client client.Interface, numReads int) CSIDriverListChannel {
+	channel := CSIDriverListChannel{
+		List:  make(chan *storage.CSIDriverList, numReads),
+		Error: make(chan error, numReads),
+	}
+
+	go func() {
+		list, err := client.StorageV1().CSIDrivers().List(context.TODO(), helpers.ListEverything)
+		for i := 0; i < numReads; i++ {
+			channel.List <- list
+			channel.Error <- err
+		}
+	}()
+
+	return channel
+}
+
+// CSINodeListChannel is a list and error channels to CSI nodes.
+type CSINodeListChannel struct {
+	List  chan *storage.CSINodeList
+	Error chan error
+}
+
+// GetCSINodeListChannel returns a pair of channels to a CSI node list and errors
+// that both must be read numReads times.
+func GetCSINodeListChannel(client client.Interface, numReads int) CSINodeListChannel {
+	channel := CSINodeListChannel{
+		List:  make(chan *storage.CSINodeList, numReads),
+		Error: make(chan error, numReads),
+	}
+
+	go func() {
+		list, err := client.StorageV1().CSINodes().List(context.TODO(), helpers.ListEverything)
+		for i := 0; i < numReads; i++ {
+			channel.List <- list
+			channel.Error <- err
+		}
+	}()
+
+	return channel
+}
+
+// VolumeAttachmentListChannel is a list and error channels to volume attachments.
+type VolumeAttachmentListChannel struct {
+	List  chan *storage.VolumeAttachmentList
+	Error chan error
+}
+
+// GetVolumeAttachmentListChannel returns a pair of channels to a volume attachment list and errors
+// that both must be read numReads times.
+func GetVolumeAttachmentListChannel(client client.Interface, numReads int) VolumeAttachmentListChannel {
+	channel := VolumeAttachmentListChannel{
+		List:  make(chan *storage.VolumeAttachmentList, numReads),
+		Error: make(chan error, numReads),
+	}
+
+	go func() {
+		list, err := client.StorageV1().VolumeAttachments().List(context.TODO(), helpers.ListEverything)
+		for i := 0; i < numReads; i++ {
+			channel.List <- list
+			channel.Error <- err
+		}
+	}()
+
+	return channel
+}
@@ -16,10 +16,14 @@ package cronjob
 
 import (
 	"context"
+	"time"
 
 	batch "k8s.io/api/batch/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sClient "k8s.io/client-go/kubernetes"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/errors"
 )
 
 // CronJobDetail contains Cron Job details.
@@ -27,29 +31,64 @@ type CronJobDetail struct {
 	// Extends list item structure.
 	CronJob `json:",inline"`
 
-	ConcurrencyPolicy       string `json:"concurrencyPolicy"`
-	StartingDeadLineSeconds *int64 `json:"startingDeadlineSeconds"`
+	ConcurrencyPolicy       string  `json:"concurrencyPolicy"`
+	StartingDeadLineSeconds *int64  `json:"startingDeadlineSeconds"`
+	TimeZone                *string `json:"timeZone,omitempty"`
+
+	SuccessfulJobsHistoryLimit *int32 `json:"successfulJobsHistoryLimit,omitempty"`
+	FailedJobsHistoryLimit     *int32 `json:"failedJobsHistoryLimit,omitempty"`
+
+	LastSuccessfulTime *metaV1.Time `json:"lastSuccessfulTime,omitempty"`
+
+	// Schedule contains next run times and missed schedules.
+	Schedule ScheduleInfo `json:"schedule"`
+
+	// History summarizes Jobs owned by the Cron Job.
+	History RunHistory `json:"history"`
 
 	// List of non-critical errors, that occurred during resource retrieval.
 	Errors []error `json:"errors"`
 }
 
-// GetCronJobDetail gets Cron Job details.
-func GetCronJobDetail(client k8sClient.Interface, namespace, name string) (*CronJobDetail, error) {
+// GetCronJobDetail gets Cron Job details with the given number of next run times.
+func GetCronJobDetail(client k8sClient.Interface, namespace, name string, runs int) (*CronJobDetail, error) {
 
 	rawObject, err := client.BatchV1().CronJobs(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	cj := toCronJobDetail(rawObject)
+	channels := &common.ResourceChannels{
+		JobList: common.GetJobListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
+	}
+
+	jobs := <-channels.JobList.List
+	err = <-channels.JobList.Error
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	var ownedJobs []batch.Job
+	if jobs != nil {
+		ownedJobs = filterJobsByOwnerUID(rawObject.UID, jobs.Items)
+	}
+
+	cj := toCronJobDetail(rawObject, ownedJobs, time.Now(), runs, nonCriticalErrors)
 	return &cj, nil
 }
 
-func toCronJobDetail(cj *batch.CronJob) CronJobDetail {
+func toCronJobDetail(cj *batch.CronJob, jobs []batch.Job, now time.Time, runs int, nonCriticalErrors []error) CronJobDetail {
 	return CronJobDetail{
-		CronJob:                 toCronJob(cj),
-		ConcurrencyPolicy:       string(cj.Spec.ConcurrencyPolicy),
-		StartingDeadLineSeconds: cj.Spec.StartingDeadlineSeconds,
+		CronJob:                    toCronJob(cj),
+		ConcurrencyPolicy:          string(cj.Spec.ConcurrencyPolicy),
+		StartingDeadLineSeconds:    cj.Spec.StartingDeadlineSeconds,
+		TimeZone:                   cj.Spec.TimeZone,
+		SuccessfulJobsHistoryLimit: cj.Spec.SuccessfulJobsHistoryLimit,
+		FailedJobsHistoryLimit:     cj.Spec.FailedJobsHistoryLimit,
+		LastSuccessfulTime:         cj.Status.LastSuccessfulTime,
+		Schedule:                   getScheduleInfo(cj, now, runs),
+		History:                    getRunHistory(jobs),
+		Errors:                     nonCriticalErrors,
 	}
 }
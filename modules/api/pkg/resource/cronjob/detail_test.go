@@ -37,7 +37,7 @@ func TestGetCronJobDetail(t *testing.T) {
 		{
 			namespace,
 			name,
-			[]string{"get"},
+			[]string{"get", "list"},
 			&batch.CronJob{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      name,
@@ -59,6 +59,12 @@ func TestGetCronJobDetail(t *testing.T) {
 					Suspend:         &suspend,
 					ContainerImages: []string{},
 				},
+				Schedule: cronjob.ScheduleInfo{
+					TimeZone: "UTC",
+					NextRuns: []metav1.Time{},
+					Error:    `invalid schedule "": expected 5 fields, found 0`,
+				},
+				Errors: []error{},
 			},
 		},
 	}
@@ -66,7 +72,7 @@ func TestGetCronJobDetail(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := fake.NewSimpleClientset(c.raw)
 		dataselect.DefaultDataSelectWithMetrics.MetricQuery = dataselect.NoMetrics
-		actual, _ := cronjob.GetCronJobDetail(fakeClient, c.namespace, c.name)
+		actual, _ := cronjob.GetCronJobDetail(fakeClient, c.namespace, c.name, cronjob.DefaultNextRuns)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
@@ -0,0 +1,123 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cronjob
+
+import (
+	batch "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RunHistory summarizes Jobs owned by the Cron Job. Only Jobs kept by the successful and failed
+// jobs history limits are taken into account.
+type RunHistory struct {
+	Total     int `json:"total"`
+	Active    int `json:"active"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+
+	// SuccessRate is the percentage of succeeded Jobs among finished Jobs. It is not set when no
+	// Job has finished yet.
+	SuccessRate *float64 `json:"successRate,omitempty"`
+
+	// AverageDurationSeconds and MaxDurationSeconds are computed over finished Jobs.
+	AverageDurationSeconds int64 `json:"averageDurationSeconds"`
+	MaxDurationSeconds     int64 `json:"maxDurationSeconds"`
+
+	// LastFailure is the most recently failed Job.
+	LastFailure *JobFailure `json:"lastFailure,omitempty"`
+}
+
+// JobFailure describes why a Job failed.
+type JobFailure struct {
+	JobName string      `json:"jobName"`
+	Time    metaV1.Time `json:"time"`
+	Reason  string      `json:"reason"`
+	Message string      `json:"message"`
+}
+
+func getRunHistory(jobs []batch.Job) RunHistory {
+	history := RunHistory{Total: len(jobs)}
+	var totalDuration int64
+	finished := 0
+	for _, j := range jobs {
+		condition, ok := getFinishedCondition(&j)
+		if !ok {
+			if j.Status.Active > 0 {
+				history.Active++
+			}
+
+			continue
+		}
+
+		finished++
+		if condition.Type == batch.JobComplete {
+			history.Succeeded++
+		} else {
+			history.Failed++
+			if history.LastFailure == nil || history.LastFailure.Time.Before(&condition.LastTransitionTime) {
+				history.LastFailure = &JobFailure{
+					JobName: j.Name,
+					Time:    condition.LastTransitionTime,
+					Reason:  condition.Reason,
+					Message: condition.Message,
+				}
+			}
+		}
+
+		duration := getJobDurationSeconds(&j, condition)
+		totalDuration += duration
+		if duration > history.MaxDurationSeconds {
+			history.MaxDurationSeconds = duration
+		}
+	}
+
+	if finished > 0 {
+		rate := float64(history.Succeeded) / float64(finished) * 100
+		history.SuccessRate = &rate
+		history.AverageDurationSeconds = totalDuration / int64(finished)
+	}
+
+	return history
+}
+
+// getFinishedCondition returns the true Complete or Failed condition of the Job.
+func getFinishedCondition(j *batch.Job) (batch.JobCondition, bool) {
+	for _, condition := range j.Status.Conditions {
+		if (condition.Type == batch.JobComplete || condition.Type == batch.JobFailed) &&
+			condition.Status == v1.ConditionTrue {
+			return condition, true
+		}
+	}
+
+	return batch.JobCondition{}, false
+}
+
+func getJobDurationSeconds(j *batch.Job, condition batch.JobCondition) int64 {
+	if j.Status.StartTime == nil {
+		return 0
+	}
+
+	end := condition.LastTransitionTime
+	if j.Status.CompletionTime != nil {
+		end = *j.Status.CompletionTime
+	}
+
+	if end.Before(j.Status.StartTime) {
+		return 0
+	}
+
+	return int64(end.Sub(j.Status.StartTime.Time).Seconds())
+}
@@ -0,0 +1,102 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cronjob
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	batch "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newFinishedJob(name string, start time.Time, duration time.Duration, conditionType batch.JobConditionType, reason string) batch.Job {
+	end := metaV1.NewTime(start.Add(duration))
+	j := batch.Job{
+		ObjectMeta: metaV1.ObjectMeta{Name: name},
+		Status: batch.JobStatus{
+			StartTime: lo.ToPtr(metaV1.NewTime(start)),
+			Conditions: []batch.JobCondition{
+				{Type: conditionType, Status: v1.ConditionTrue, LastTransitionTime: end, Reason: reason},
+			},
+		},
+	}
+
+	if conditionType == batch.JobComplete {
+		j.Status.CompletionTime = &end
+	}
+
+	return j
+}
+
+func TestGetRunHistory(t *testing.T) {
+	start := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	history := getRunHistory([]batch.Job{
+		newFinishedJob("a", start, 10*time.Second, batch.JobComplete, ""),
+		newFinishedJob("b", start.Add(time.Hour), 30*time.Second, batch.JobComplete, ""),
+		newFinishedJob("c", start.Add(2*time.Hour), 50*time.Second, batch.JobFailed, "BackoffLimitExceeded"),
+		newFinishedJob("d", start.Add(-time.Hour), 2*time.Second, batch.JobFailed, "DeadlineExceeded"),
+		{ObjectMeta: metaV1.ObjectMeta{Name: "e"}, Status: batch.JobStatus{Active: 1}},
+	})
+
+	if history.Total != 5 || history.Active != 1 || history.Succeeded != 2 || history.Failed != 2 {
+		t.Errorf("unexpected job counts %+v", history)
+	}
+
+	if history.SuccessRate == nil || *history.SuccessRate != 50 {
+		t.Errorf("expected 50%% success rate, got %v", history.SuccessRate)
+	}
+
+	if history.AverageDurationSeconds != 23 || history.MaxDurationSeconds != 50 {
+		t.Errorf("unexpected durations %+v", history)
+	}
+
+	if history.LastFailure == nil || history.LastFailure.JobName != "c" || history.LastFailure.Reason != "BackoffLimitExceeded" {
+		t.Errorf("expected last failure of job c, got %+v", history.LastFailure)
+	}
+
+	if empty := getRunHistory(nil); empty.SuccessRate != nil || empty.LastFailure != nil {
+		t.Errorf("expected empty history, got %+v", empty)
+	}
+}
+
+func TestSuspendCronJob(t *testing.T) {
+	client := fake.NewSimpleClientset(&batch.CronJob{
+		ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "backup"},
+		Spec:       batch.CronJobSpec{Schedule: "@daily"},
+	})
+
+	detail, err := SuspendCronJob(client, "default", "backup")
+	if err != nil {
+		t.Fatalf("SuspendCronJob() returned error: %v", err)
+	}
+
+	if detail.Suspend == nil || !*detail.Suspend || len(detail.Schedule.NextRuns) != 0 {
+		t.Errorf("expected suspended cron job without next runs, got %+v", detail)
+	}
+
+	if _, err = ResumeCronJob(client, "default", "backup"); err != nil {
+		t.Fatalf("ResumeCronJob() returned error: %v", err)
+	}
+
+	cj, _ := client.BatchV1().CronJobs("default").Get(context.TODO(), "backup", metaV1.GetOptions{})
+	if cj.Spec.Suspend == nil || *cj.Spec.Suspend {
+		t.Errorf("expected resumed cron job, got %+v", cj.Spec)
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cronjob
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	// The image is built from scratch, so time zone data has to be embedded to resolve time zones
+	// of Cron Jobs.
+	_ "time/tzdata"
+
+	batch "k8s.io/api/batch/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DefaultNextRuns is the number of next run times computed when not specified.
+	DefaultNextRuns = 5
+
+	// MaxNextRuns is the upper bound of computed next run times.
+	MaxNextRuns = 100
+
+	// maxMissedSchedules is the number of missed schedules after which counting stops, the same
+	// limit as used by the Cron Job controller.
+	maxMissedSchedules = 100
+
+	// missedScheduleGracePeriod is the time the controller has to start a Job before its schedule
+	// is considered missed.
+	missedScheduleGracePeriod = time.Minute
+)
+
+// ScheduleInfo describes when the Cron Job runs.
+type ScheduleInfo struct {
+	// TimeZone used to interpret the schedule. The time zone of the controller is assumed to be
+	// UTC when the Cron Job does not set it.
+	TimeZone string `json:"timeZone"`
+
+	// NextRuns are the upcoming schedule times. It is empty for suspended Cron Jobs.
+	NextRuns []metaV1.Time `json:"nextRuns"`
+
+	// MissedSchedules is the number of schedule times since the last scheduled Job that did not
+	// start a Job, e.g. because of the starting deadline or the Forbid concurrency policy. It is
+	// not computed for suspended Cron Jobs and counting stops at 100.
+	MissedSchedules int `json:"missedSchedules"`
+
+	// LastMissedSchedule is the latest counted missed schedule time.
+	LastMissedSchedule *metaV1.Time `json:"lastMissedSchedule,omitempty"`
+
+	// Error is set when the schedule or the time zone cannot be parsed.
+	Error string `json:"error,omitempty"`
+}
+
+func getScheduleInfo(cj *batch.CronJob, now time.Time, runs int) ScheduleInfo {
+	info := ScheduleInfo{TimeZone: "UTC", NextRuns: make([]metaV1.Time, 0)}
+	if cj.Spec.TimeZone != nil {
+		info.TimeZone = *cj.Spec.TimeZone
+	}
+
+	location, err := time.LoadLocation(info.TimeZone)
+	if err != nil {
+		info.Error = fmt.Sprintf("invalid time zone %q: %s", info.TimeZone, err.Error())
+		return info
+	}
+
+	schedule, err := parseSchedule(cj.Spec.Schedule)
+	if err != nil {
+		info.Error = fmt.Sprintf("invalid schedule %q: %s", cj.Spec.Schedule, err.Error())
+		return info
+	}
+
+	if cj.Spec.Suspend != nil && *cj.Spec.Suspend {
+		return info
+	}
+
+	for t := schedule.next(now.In(location)); !t.IsZero() && len(info.NextRuns) < runs; t = schedule.next(t) {
+		info.NextRuns = append(info.NextRuns, metaV1.NewTime(t))
+	}
+
+	earliest := cj.CreationTimestamp.Time
+	if cj.Status.LastScheduleTime != nil {
+		earliest = cj.Status.LastScheduleTime.Time
+	}
+
+	latest := now.Add(-missedScheduleGracePeriod)
+	for t := schedule.next(earliest.In(location)); !t.IsZero() && !t.After(latest); t = schedule.next(t) {
+		missed := metaV1.NewTime(t)
+		info.LastMissedSchedule = &missed
+		info.MissedSchedules++
+		if info.MissedSchedules >= maxMissedSchedules {
+			break
+		}
+	}
+
+	return info
+}
+
+// cronSchedule is a parsed standard cron schedule with minute, hour, day of month, month and day
+// of week fields, interpreted the same way as by the Cron Job controller.
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+
+	// domStar and dowStar are set when the field matches any day. Only when neither is set,
+	// a day matching either of the fields is scheduled.
+	domStar, dowStar bool
+}
+
+type cronField struct {
+	name     string
+	min, max uint
+	names    map[string]uint
+}
+
+var (
+	minuteField = cronField{name: "minute", min: 0, max: 59}
+	hourField   = cronField{name: "hour", min: 0, max: 23}
+	domField    = cronField{name: "day of month", min: 1, max: 31}
+	monthField  = cronField{name: "month", min: 1, max: 12, names: map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dowField = cronField{name: "day of week", min: 0, max: 6, names: map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var scheduleDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+func parseSchedule(spec string) (*cronSchedule, error) {
+	spec = strings.TrimSpace(spec)
+	if expanded, ok := scheduleDescriptors[strings.ToLower(spec)]; ok {
+		spec = expanded
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields, found %d", len(fields))
+	}
+
+	schedule := &cronSchedule{}
+	var err error
+	if schedule.minute, _, err = minuteField.parse(fields[0]); err != nil {
+		return nil, err
+	}
+
+	if schedule.hour, _, err = hourField.parse(fields[1]); err != nil {
+		return nil, err
+	}
+
+	if schedule.dom, schedule.domStar, err = domField.parse(fields[2]); err != nil {
+		return nil, err
+	}
+
+	if schedule.month, _, err = monthField.parse(fields[3]); err != nil {
+		return nil, err
+	}
+
+	if schedule.dow, schedule.dowStar, err = dowField.parse(fields[4]); err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// parse returns the bit set of values matched by the comma-separated list of ranges and whether
+// the field matches any value.
+func (in cronField) parse(expr string) (bits uint64, star bool, err error) {
+	for _, part := range strings.Split(expr, ",") {
+		partBits, partStar, err := in.parseRange(part)
+		if err != nil {
+			return 0, false, err
+		}
+
+		bits |= partBits
+		star = star || partStar
+	}
+
+	return bits, star, nil
+}
+
+// parseRange parses a single value, a range "a-b", "*" or "?", optionally followed by a step.
+// A single value with a step, e.g. "5/15", means the range from the value to the maximum.
+func (in cronField) parseRange(expr string) (uint64, bool, error) {
+	rangeAndStep := strings.Split(expr, "/")
+	lowAndHigh := strings.Split(rangeAndStep[0], "-")
+	if len(rangeAndStep) > 2 || len(lowAndHigh) > 2 {
+		return 0, false, fmt.Errorf("invalid %s %q", in.name, expr)
+	}
+
+	var start, end uint
+	star := false
+	if lowAndHigh[0] == "*" || lowAndHigh[0] == "?" {
+		if len(lowAndHigh) > 1 {
+			return 0, false, fmt.Errorf("invalid %s %q", in.name, expr)
+		}
+
+		start, end, star = in.min, in.max, true
+	} else {
+		var err error
+		if start, err = in.value(lowAndHigh[0]); err != nil {
+			return 0, false, err
+		}
+
+		end = start
+		if len(lowAndHigh) == 2 {
+			if end, err = in.value(lowAndHigh[1]); err != nil {
+				return 0, false, err
+			}
+		}
+	}
+
+	step := uint64(1)
+	if len(rangeAndStep) == 2 {
+		var err error
+		if step, err = strconv.ParseUint(rangeAndStep[1], 10, 8); err != nil || step == 0 {
+			return 0, false, fmt.Errorf("invalid step in %s %q", in.name, expr)
+		}
+
+		if len(lowAndHigh) == 1 {
+			end = in.max
+		}
+
+		if step > 1 {
+			star = false
+		}
+	}
+
+	if start < in.min || end > in.max || start > end {
+		return 0, false, fmt.Errorf("%s %q out of range %d-%d", in.name, expr, in.min, in.max)
+	}
+
+	var bits uint64
+	for i := start; i <= end; i += uint(step) {
+		bits |= 1 << i
+	}
+
+	return bits, star, nil
+}
+
+func (in cronField) value(expr string) (uint, error) {
+	if value, ok := in.names[strings.ToLower(expr)]; ok {
+		return value, nil
+	}
+
+	value, err := strconv.ParseUint(expr, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", in.name, expr)
+	}
+
+	return uint(value), nil
+}
+
+// next returns the first schedule time after t in the location of t, or zero time if there is
+// none in the next five years, e.g. for February 30th.
+func (in *cronSchedule) next(t time.Time) time.Time {
+	location := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	yearLimit := t.Year() + 5
+
+WRAP:
+	if t.Year() > yearLimit {
+		return time.Time{}
+	}
+
+	for in.month&(1<<uint(t.Month())) == 0 {
+		t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, location)
+		if t.Month() == time.January {
+			goto WRAP
+		}
+	}
+
+	for !in.dayMatches(t) {
+		t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, location)
+		if t.Day() == 1 {
+			goto WRAP
+		}
+	}
+
+	for in.hour&(1<<uint(t.Hour())) == 0 {
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, location)
+		if t.Hour() == 0 {
+			goto WRAP
+		}
+	}
+
+	for in.minute&(1<<uint(t.Minute())) == 0 {
+		t = t.Add(time.Minute)
+		if t.Minute() == 0 {
+			goto WRAP
+		}
+	}
+
+	return t
+}
+
+func (in *cronSchedule) dayMatches(t time.Time) bool {
+	domMatch := in.dom&(1<<uint(t.Day())) > 0
+	dowMatch := in.dow&(1<<uint(t.Weekday())) > 0
+	if in.domStar || in.dowStar {
+		return domMatch && dowMatch
+	}
+
+	return domMatch || dowMatch
+}
@@ -0,0 +1,98 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cronjob
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	batch "k8s.io/api/batch/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestScheduleNext(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("LoadLocation() returned error: %v", err)
+	}
+
+	cases := []struct {
+		schedule string
+		from     time.Time
+		expected time.Time
+	}{
+		{"*/15 * * * *", time.Date(2024, 3, 1, 10, 7, 30, 0, time.UTC), time.Date(2024, 3, 1, 10, 15, 0, 0, time.UTC)},
+		{"0 9 * * mon-fri", time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC), time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC)},
+		{"0 0 1,15 * 5", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * JAN ?", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2024, 12, 31, 23, 59, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"5/20 1 * * *", time.Date(2024, 3, 1, 1, 30, 0, 0, time.UTC), time.Date(2024, 3, 1, 1, 45, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Time{}},
+		// 02:30 does not exist in Berlin on the day daylight saving time starts.
+		{"30 2 * * *", time.Date(2024, 3, 30, 12, 0, 0, 0, berlin), time.Date(2024, 4, 1, 2, 30, 0, 0, berlin)},
+	}
+
+	for _, c := range cases {
+		schedule, err := parseSchedule(c.schedule)
+		if err != nil {
+			t.Errorf("parseSchedule(%q) returned error: %v", c.schedule, err)
+			continue
+		}
+
+		if actual := schedule.next(c.from); !actual.Equal(c.expected) {
+			t.Errorf("next(%q, %s) = %s, expected %s", c.schedule, c.from, actual, c.expected)
+		}
+	}
+}
+
+func TestParseScheduleInvalid(t *testing.T) {
+	for _, schedule := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "*/0 * * * *",
+		"5-1 * * * *", "* * * foo *", "*-5 * * * *", "1/2/3 * * * *"} {
+		if _, err := parseSchedule(schedule); err == nil {
+			t.Errorf("parseSchedule(%q) should fail", schedule)
+		}
+	}
+}
+
+func TestGetScheduleInfo(t *testing.T) {
+	now := time.Date(2024, 3, 1, 13, 30, 0, 0, time.UTC)
+	cj := &batch.CronJob{
+		ObjectMeta: metaV1.ObjectMeta{CreationTimestamp: metaV1.NewTime(now.Add(-24 * time.Hour))},
+		Spec:       batch.CronJobSpec{Schedule: "0 * * * *", TimeZone: lo.ToPtr("Europe/Berlin")},
+		Status:     batch.CronJobStatus{LastScheduleTime: lo.ToPtr(metaV1.NewTime(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)))},
+	}
+
+	info := getScheduleInfo(cj, now, 3)
+	if info.Error != "" || info.TimeZone != "Europe/Berlin" || len(info.NextRuns) != 3 ||
+		!info.NextRuns[0].Equal(lo.ToPtr(metaV1.NewTime(time.Date(2024, 3, 1, 14, 0, 0, 0, time.UTC)))) {
+		t.Errorf("unexpected next runs %+v", info)
+	}
+
+	if info.MissedSchedules != 3 || info.LastMissedSchedule == nil ||
+		!info.LastMissedSchedule.Time.Equal(time.Date(2024, 3, 1, 13, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected 3 missed schedules until 13:00, got %+v", info)
+	}
+
+	cj.Spec.Suspend = lo.ToPtr(true)
+	if info := getScheduleInfo(cj, now, 3); len(info.NextRuns) != 0 || info.MissedSchedules != 0 {
+		t.Errorf("expected no runs of suspended cron job, got %+v", info)
+	}
+
+	cj.Spec.TimeZone = lo.ToPtr("Mars/Olympus")
+	if info := getScheduleInfo(cj, now, 3); info.Error == "" {
+		t.Error("expected invalid time zone error")
+	}
+}
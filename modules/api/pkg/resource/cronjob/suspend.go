@@ -0,0 +1,46 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cronjob
+
+import (
+	"context"
+	"fmt"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	client "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+// SuspendCronJob stops the Cron Job from scheduling new Jobs. Active Jobs are not affected.
+func SuspendCronJob(client client.Interface, namespace, name string) (*CronJobDetail, error) {
+	return setSuspend(client, namespace, name, true)
+}
+
+// ResumeCronJob lets the suspended Cron Job schedule Jobs again.
+func ResumeCronJob(client client.Interface, namespace, name string) (*CronJobDetail, error) {
+	return setSuspend(client, namespace, name, false)
+}
+
+func setSuspend(client client.Interface, namespace, name string, suspend bool) (*CronJobDetail, error) {
+	klog.V(4).Infof("Setting suspend of %s cron job to %t", name, suspend)
+	patch := []byte(fmt.Sprintf(`{"spec":{"suspend":%t}}`, suspend))
+	_, err := client.BatchV1().CronJobs(namespace).Patch(context.TODO(), name, types.MergePatchType, patch, metaV1.PatchOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return GetCronJobDetail(client, namespace, name, DefaultNextRuns)
+}
@@ -0,0 +1,93 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csidriver
+
+import (
+	"context"
+	"sort"
+
+	storage "k8s.io/api/storage/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/errors"
+)
+
+// CSIDriverDetail provides the presentation layer view of CSI Driver resource.
+type CSIDriverDetail struct {
+	// Extends list item structure.
+	CSIDriver `json:",inline"`
+
+	TokenRequests []storage.TokenRequest `json:"tokenRequests"`
+
+	// Nodes lists names of nodes where the driver is registered.
+	Nodes []string `json:"nodes"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetCSIDriver returns CSI Driver resource with nodes where it is registered.
+func GetCSIDriver(client kubernetes.Interface, name string) (*CSIDriverDetail, error) {
+	klog.V(4).Infof("Getting details of %s CSI driver", name)
+
+	driver, err := client.StorageV1().CSIDrivers().Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	channels := &common.ResourceChannels{
+		CSINodeList: common.GetCSINodeListChannel(client, 1),
+	}
+
+	csiNodes := <-channels.CSINodeList.List
+	err = <-channels.CSINodeList.Error
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	var items []storage.CSINode
+	if csiNodes != nil {
+		items = csiNodes.Items
+	}
+
+	detail := toCSIDriverDetail(driver, items, nonCriticalErrors)
+	return &detail, nil
+}
+
+func toCSIDriverDetail(driver *storage.CSIDriver, csiNodes []storage.CSINode, nonCriticalErrors []error) CSIDriverDetail {
+	detail := CSIDriverDetail{
+		CSIDriver:     toCSIDriver(driver),
+		TokenRequests: make([]storage.TokenRequest, 0),
+		Nodes:         make([]string, 0),
+		Errors:        nonCriticalErrors,
+	}
+
+	detail.TokenRequests = append(detail.TokenRequests, driver.Spec.TokenRequests...)
+	for _, csiNode := range csiNodes {
+		for _, nodeDriver := range csiNode.Spec.Drivers {
+			if nodeDriver.Name == driver.Name {
+				detail.Nodes = append(detail.Nodes, csiNode.Name)
+				break
+			}
+		}
+	}
+
+	sort.Strings(detail.Nodes)
+	return detail
+}
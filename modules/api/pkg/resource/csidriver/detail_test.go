@@ -0,0 +1,101 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csidriver
+
+import (
+	"reflect"
+	"testing"
+
+	storage "k8s.io/api/storage/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s.io/dashboard/types"
+)
+
+func TestGetCSIDriver(t *testing.T) {
+	attachRequired := false
+	fsGroupPolicy := storage.FileFSGroupPolicy
+	client := fake.NewSimpleClientset(
+		&storage.CSIDriver{
+			ObjectMeta: metaV1.ObjectMeta{Name: "ebs.csi.aws.com"},
+		},
+		&storage.CSIDriver{
+			ObjectMeta: metaV1.ObjectMeta{Name: "secrets-store.csi.k8s.io"},
+			Spec: storage.CSIDriverSpec{
+				AttachRequired:       &attachRequired,
+				FSGroupPolicy:        &fsGroupPolicy,
+				VolumeLifecycleModes: []storage.VolumeLifecycleMode{storage.VolumeLifecycleEphemeral},
+			},
+		},
+		&storage.CSINode{
+			ObjectMeta: metaV1.ObjectMeta{Name: "node-2"},
+			Spec:       storage.CSINodeSpec{Drivers: []storage.CSINodeDriver{{Name: "ebs.csi.aws.com"}}},
+		},
+		&storage.CSINode{
+			ObjectMeta: metaV1.ObjectMeta{Name: "node-1"},
+			Spec: storage.CSINodeSpec{Drivers: []storage.CSINodeDriver{
+				{Name: "secrets-store.csi.k8s.io"}, {Name: "ebs.csi.aws.com"},
+			}},
+		},
+	)
+
+	cases := []struct {
+		name     string
+		expected *CSIDriverDetail
+	}{
+		{
+			"ebs.csi.aws.com",
+			&CSIDriverDetail{
+				CSIDriver: CSIDriver{
+					ObjectMeta:           types.ObjectMeta{Name: "ebs.csi.aws.com"},
+					TypeMeta:             types.TypeMeta{Kind: types.ResourceKindCSIDriver},
+					AttachRequired:       true,
+					FSGroupPolicy:        string(storage.ReadWriteOnceWithFSTypeFSGroupPolicy),
+					VolumeLifecycleModes: []string{string(storage.VolumeLifecyclePersistent)},
+				},
+				TokenRequests: []storage.TokenRequest{},
+				Nodes:         []string{"node-1", "node-2"},
+				Errors:        []error{},
+			},
+		},
+		{
+			"secrets-store.csi.k8s.io",
+			&CSIDriverDetail{
+				CSIDriver: CSIDriver{
+					ObjectMeta:           types.ObjectMeta{Name: "secrets-store.csi.k8s.io"},
+					TypeMeta:             types.TypeMeta{Kind: types.ResourceKindCSIDriver},
+					FSGroupPolicy:        string(storage.FileFSGroupPolicy),
+					VolumeLifecycleModes: []string{string(storage.VolumeLifecycleEphemeral)},
+				},
+				TokenRequests: []storage.TokenRequest{},
+				Nodes:         []string{"node-1"},
+				Errors:        []error{},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual, err := GetCSIDriver(client, c.name)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("GetCSIDriver(%s) == got\n%#v, expected\n %#v", c.name, actual, c.expected)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csidriver
+
+import (
+	storage "k8s.io/api/storage/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// CSIDriverList holds a list of CSI Driver objects in the cluster.
+type CSIDriverList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+	Items    []CSIDriver    `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// CSIDriver is a representation of a Kubernetes CSI Driver object. Optional fields are shown with
+// the values defaulted by the API server.
+type CSIDriver struct {
+	ObjectMeta           types.ObjectMeta `json:"objectMeta"`
+	TypeMeta             types.TypeMeta   `json:"typeMeta"`
+	AttachRequired       bool             `json:"attachRequired"`
+	PodInfoOnMount       bool             `json:"podInfoOnMount"`
+	StorageCapacity      bool             `json:"storageCapacity"`
+	RequiresRepublish    bool             `json:"requiresRepublish"`
+	SELinuxMount         bool             `json:"seLinuxMount"`
+	FSGroupPolicy        string           `json:"fsGroupPolicy"`
+	VolumeLifecycleModes []string         `json:"volumeLifecycleModes"`
+}
+
+// GetCSIDriverList returns a list of all CSI driver objects in the cluster.
+func GetCSIDriverList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*CSIDriverList, error) {
+	klog.V(4).Infof("Getting list of CSI drivers in the cluster")
+
+	channels := &common.ResourceChannels{
+		CSIDriverList: common.GetCSIDriverListChannel(client, 1),
+	}
+
+	return GetCSIDriverListFromChannels(channels, dsQuery)
+}
+
+// GetCSIDriverListFromChannels returns a list of all CSI driver objects in the cluster.
+func GetCSIDriverListFromChannels(channels *common.ResourceChannels,
+	dsQuery *dataselect.DataSelectQuery) (*CSIDriverList, error) {
+	csiDrivers := <-channels.CSIDriverList.List
+	err := <-channels.CSIDriverList.Error
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toCSIDriverList(csiDrivers.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toCSIDriverList(csiDrivers []storage.CSIDriver, nonCriticalErrors []error,
+	dsQuery *dataselect.DataSelectQuery) *CSIDriverList {
+	csiDriverList := &CSIDriverList{
+		Items:    make([]CSIDriver, 0),
+		ListMeta: types.ListMeta{TotalItems: len(csiDrivers)},
+		Errors:   nonCriticalErrors,
+	}
+
+	csiDriverCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(csiDrivers), dsQuery)
+	csiDrivers = fromCells(csiDriverCells)
+	csiDriverList.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+
+	for _, csiDriver := range csiDrivers {
+		csiDriverList.Items = append(csiDriverList.Items, toCSIDriver(&csiDriver))
+	}
+
+	return csiDriverList
+}
+
+func toCSIDriver(csiDriver *storage.CSIDriver) CSIDriver {
+	spec := csiDriver.Spec
+	result := CSIDriver{
+		ObjectMeta:           types.NewObjectMeta(csiDriver.ObjectMeta),
+		TypeMeta:             types.NewTypeMeta(types.ResourceKindCSIDriver),
+		AttachRequired:       boolOrDefault(spec.AttachRequired, true),
+		PodInfoOnMount:       boolOrDefault(spec.PodInfoOnMount, false),
+		StorageCapacity:      boolOrDefault(spec.StorageCapacity, false),
+		RequiresRepublish:    boolOrDefault(spec.RequiresRepublish, false),
+		SELinuxMount:         boolOrDefault(spec.SELinuxMount, false),
+		FSGroupPolicy:        string(storage.ReadWriteOnceWithFSTypeFSGroupPolicy),
+		VolumeLifecycleModes: []string{string(storage.VolumeLifecyclePersistent)},
+	}
+
+	if spec.FSGroupPolicy != nil {
+		result.FSGroupPolicy = string(*spec.FSGroupPolicy)
+	}
+
+	if len(spec.VolumeLifecycleModes) > 0 {
+		result.VolumeLifecycleModes = make([]string, 0, len(spec.VolumeLifecycleModes))
+		for _, mode := range spec.VolumeLifecycleModes {
+			result.VolumeLifecycleModes = append(result.VolumeLifecycleModes, string(mode))
+		}
+	}
+
+	return result
+}
+
+func boolOrDefault(value *bool, defaultValue bool) bool {
+	if value == nil {
+		return defaultValue
+	}
+
+	return *value
+}
@@ -0,0 +1,113 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csinode
+
+import (
+	"context"
+
+	storage "k8s.io/api/storage/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// CSINodeDetail provides the presentation layer view of CSI Node resource.
+type CSINodeDetail struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+	Drivers    []CSINodeDriver  `json:"drivers"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// CSINodeDriver is a CSI driver registered on the node.
+type CSINodeDriver struct {
+	Name         string   `json:"name"`
+	NodeID       string   `json:"nodeID"`
+	TopologyKeys []string `json:"topologyKeys"`
+
+	// Allocatable is the maximum number of volumes of the driver the node can have attached. It is
+	// not set when the driver does not report a limit.
+	Allocatable *int32 `json:"allocatable,omitempty"`
+
+	// Attachments is the number of volumes of the driver attached to the node.
+	Attachments int `json:"attachments"`
+}
+
+// GetCSINode returns CSI Node resource with the number of volumes attached for each driver.
+func GetCSINode(client kubernetes.Interface, name string) (*CSINodeDetail, error) {
+	klog.V(4).Infof("Getting details of %s CSI node", name)
+
+	csiNode, err := client.StorageV1().CSINodes().Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	channels := &common.ResourceChannels{
+		VolumeAttachmentList: common.GetVolumeAttachmentListChannel(client, 1),
+	}
+
+	attachments := <-channels.VolumeAttachmentList.List
+	err = <-channels.VolumeAttachmentList.Error
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	var items []storage.VolumeAttachment
+	if attachments != nil {
+		items = attachments.Items
+	}
+
+	detail := toCSINodeDetail(csiNode, items, nonCriticalErrors)
+	return &detail, nil
+}
+
+func toCSINodeDetail(csiNode *storage.CSINode, attachments []storage.VolumeAttachment, nonCriticalErrors []error) CSINodeDetail {
+	attached := make(map[string]int)
+	for _, attachment := range attachments {
+		if attachment.Spec.NodeName == csiNode.Name && attachment.Status.Attached {
+			attached[attachment.Spec.Attacher]++
+		}
+	}
+
+	drivers := make([]CSINodeDriver, 0, len(csiNode.Spec.Drivers))
+	for _, driver := range csiNode.Spec.Drivers {
+		nodeDriver := CSINodeDriver{
+			Name:         driver.Name,
+			NodeID:       driver.NodeID,
+			TopologyKeys: driver.TopologyKeys,
+			Attachments:  attached[driver.Name],
+		}
+
+		if driver.Allocatable != nil {
+			nodeDriver.Allocatable = driver.Allocatable.Count
+		}
+
+		drivers = append(drivers, nodeDriver)
+	}
+
+	return CSINodeDetail{
+		ObjectMeta: types.NewObjectMeta(csiNode.ObjectMeta),
+		TypeMeta:   types.NewTypeMeta(types.ResourceKindCSINode),
+		Drivers:    drivers,
+		Errors:     nonCriticalErrors,
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csinode
+
+import (
+	"reflect"
+	"testing"
+
+	storage "k8s.io/api/storage/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/types"
+)
+
+func attachment(name, attacher, node string, attached bool) *storage.VolumeAttachment {
+	return &storage.VolumeAttachment{
+		ObjectMeta: metaV1.ObjectMeta{Name: name},
+		Spec:       storage.VolumeAttachmentSpec{Attacher: attacher, NodeName: node},
+		Status:     storage.VolumeAttachmentStatus{Attached: attached},
+	}
+}
+
+func TestGetCSINode(t *testing.T) {
+	count := int32(25)
+	client := fake.NewSimpleClientset(
+		&storage.CSINode{
+			ObjectMeta: metaV1.ObjectMeta{Name: "node-1"},
+			Spec: storage.CSINodeSpec{Drivers: []storage.CSINodeDriver{
+				{
+					Name:         "ebs.csi.aws.com",
+					NodeID:       "i-0123",
+					TopologyKeys: []string{"topology.ebs.csi.aws.com/zone"},
+					Allocatable:  &storage.VolumeNodeResources{Count: &count},
+				},
+				{Name: "secrets-store.csi.k8s.io", NodeID: "node-1"},
+			}},
+		},
+		attachment("csi-1", "ebs.csi.aws.com", "node-1", true),
+		attachment("csi-2", "ebs.csi.aws.com", "node-1", true),
+		attachment("csi-3", "ebs.csi.aws.com", "node-1", false),
+		attachment("csi-4", "ebs.csi.aws.com", "node-2", true),
+	)
+
+	actual, err := GetCSINode(client, "node-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := &CSINodeDetail{
+		ObjectMeta: types.ObjectMeta{Name: "node-1"},
+		TypeMeta:   types.TypeMeta{Kind: types.ResourceKindCSINode},
+		Drivers: []CSINodeDriver{
+			{
+				Name:         "ebs.csi.aws.com",
+				NodeID:       "i-0123",
+				TopologyKeys: []string{"topology.ebs.csi.aws.com/zone"},
+				Allocatable:  &count,
+				Attachments:  2,
+			},
+			{Name: "secrets-store.csi.k8s.io", NodeID: "node-1"},
+		},
+		Errors: []error{},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("GetCSINode() == got\n%#v, expected\n %#v", actual, expected)
+	}
+
+	list, err := GetCSINodeList(client, dataselect.NoDataSelect)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedDrivers := []string{"ebs.csi.aws.com", "secrets-store.csi.k8s.io"}
+	if list.ListMeta.TotalItems != 1 || !reflect.DeepEqual(list.Items[0].Drivers, expectedDrivers) {
+		t.Errorf("GetCSINodeList() == got\n%#v, expected drivers %v", list.Items, expectedDrivers)
+	}
+}
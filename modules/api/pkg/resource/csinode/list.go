@@ -0,0 +1,100 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csinode
+
+import (
+	storage "k8s.io/api/storage/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// CSINodeList holds a list of CSI Node objects in the cluster.
+type CSINodeList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+	Items    []CSINode      `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// CSINode is a representation of a Kubernetes CSI Node object. It has the same name as the node.
+type CSINode struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+
+	// Drivers lists names of CSI drivers registered on the node.
+	Drivers []string `json:"drivers"`
+}
+
+// GetCSINodeList returns a list of all CSI node objects in the cluster.
+func GetCSINodeList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*CSINodeList, error) {
+	klog.V(4).Infof("Getting list of CSI nodes in the cluster")
+
+	channels := &common.ResourceChannels{
+		CSINodeList: common.GetCSINodeListChannel(client, 1),
+	}
+
+	return GetCSINodeListFromChannels(channels, dsQuery)
+}
+
+// GetCSINodeListFromChannels returns a list of all CSI node objects in the cluster.
+func GetCSINodeListFromChannels(channels *common.ResourceChannels,
+	dsQuery *dataselect.DataSelectQuery) (*CSINodeList, error) {
+	csiNodes := <-channels.CSINodeList.List
+	err := <-channels.CSINodeList.Error
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toCSINodeList(csiNodes.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toCSINodeList(csiNodes []storage.CSINode, nonCriticalErrors []error,
+	dsQuery *dataselect.DataSelectQuery) *CSINodeList {
+	csiNodeList := &CSINodeList{
+		Items:    make([]CSINode, 0),
+		ListMeta: types.ListMeta{TotalItems: len(csiNodes)},
+		Errors:   nonCriticalErrors,
+	}
+
+	csiNodeCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(csiNodes), dsQuery)
+	csiNodes = fromCells(csiNodeCells)
+	csiNodeList.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+
+	for _, csiNode := range csiNodes {
+		csiNodeList.Items = append(csiNodeList.Items, toCSINode(&csiNode))
+	}
+
+	return csiNodeList
+}
+
+func toCSINode(csiNode *storage.CSINode) CSINode {
+	drivers := make([]string, 0, len(csiNode.Spec.Drivers))
+	for _, driver := range csiNode.Spec.Drivers {
+		drivers = append(drivers, driver.Name)
+	}
+
+	return CSINode{
+		ObjectMeta: types.NewObjectMeta(csiNode.ObjectMeta),
+		TypeMeta:   types.NewTypeMeta(types.ResourceKindCSINode),
+		Drivers:    drivers,
+	}
+}
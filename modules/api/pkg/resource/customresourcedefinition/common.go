@@ -104,3 +104,45 @@ func GetCustomResourceObjectDetail(client apiextensionsclientset.Interface, name
 
 	return nil, errors.NewNotFound(fmt.Sprintf("unsupported extensions api versions: %s", version))
 }
+
+func CreateCustomResourceObject(client apiextensionsclientset.Interface, config *rest.Config, namespace string, crdName string, body []byte) (*types.CustomResourceObjectDetail, error) {
+	version, err := GetExtensionsAPIVersion(client)
+	if err != nil {
+		return nil, err
+	}
+
+	switch version {
+	case v1:
+		return crdv1.CreateCustomResourceObject(client, config, namespace, crdName, body)
+	}
+
+	return nil, errors.NewNotFound(fmt.Sprintf("unsupported extensions api versions: %s", version))
+}
+
+func UpdateCustomResourceObject(client apiextensionsclientset.Interface, config *rest.Config, namespace string, crdName string, name string, body []byte) (*types.CustomResourceObjectDetail, error) {
+	version, err := GetExtensionsAPIVersion(client)
+	if err != nil {
+		return nil, err
+	}
+
+	switch version {
+	case v1:
+		return crdv1.UpdateCustomResourceObject(client, config, namespace, crdName, name, body)
+	}
+
+	return nil, errors.NewNotFound(fmt.Sprintf("unsupported extensions api versions: %s", version))
+}
+
+func DeleteCustomResourceObject(client apiextensionsclientset.Interface, config *rest.Config, namespace string, crdName string, name string) error {
+	version, err := GetExtensionsAPIVersion(client)
+	if err != nil {
+		return err
+	}
+
+	switch version {
+	case v1:
+		return crdv1.DeleteCustomResourceObject(client, config, namespace, crdName, name)
+	}
+
+	return errors.NewNotFound(fmt.Sprintf("unsupported extensions api versions: %s", version))
+}
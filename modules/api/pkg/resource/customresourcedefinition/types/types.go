@@ -69,6 +69,10 @@ type CustomResourceDefinitionVersion struct {
 type CustomResourceObject struct {
 	TypeMeta   types.TypeMeta   `json:"typeMeta"`
 	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+
+	// Values of the additional printer columns of the CRD, in the order of the columns. Values which
+	// could not be found are null.
+	Columns []interface{} `json:"columns,omitempty"`
 }
 
 func (r *CustomResourceObject) UnmarshalJSON(data []byte) error {
@@ -99,6 +103,9 @@ type CustomResourceObjectList struct {
 	TypeMeta metav1.TypeMeta `json:"typeMeta"`
 	ListMeta types.ListMeta  `json:"listMeta"`
 
+	// Additional printer columns of the served version the objects were fetched in.
+	Columns []CustomResourcePrinterColumn `json:"columns"`
+
 	// Unordered list of custom resource definitions
 	Items []CustomResourceObject `json:"items"`
 
@@ -122,6 +129,17 @@ func (r *CustomResourceObjectList) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// CustomResourcePrinterColumn is an additional printer column of a CRD version. Values are evaluated
+// with the JSONPath against each object.
+type CustomResourcePrinterColumn struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Format      string `json:"format,omitempty"`
+	Description string `json:"description,omitempty"`
+	Priority    int32  `json:"priority"`
+	JSONPath    string `json:"jsonPath"`
+}
+
 type CustomResourceDefinitionNames struct {
 	// plural is the plural name of the resource to serve.
 	// The custom resources are served under `/apis/<group>/<version>/.../<plural>`.
@@ -0,0 +1,180 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/client-go/util/jsonpath"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/customresourcedefinition/types"
+)
+
+// printerColumn is an additional printer column with its parsed JSONPath.
+type printerColumn struct {
+	types.CustomResourcePrinterColumn
+	path *jsonpath.JSONPath
+}
+
+// getPrinterColumns returns additional printer columns of the first version of the CRD, which is the
+// version objects are fetched in. Columns with invalid JSONPath are skipped, the API server does not
+// accept them anyway.
+func getPrinterColumns(crd *apiextensions.CustomResourceDefinition) []printerColumn {
+	columns := make([]printerColumn, 0)
+	if len(crd.Spec.Versions) == 0 {
+		return columns
+	}
+
+	for _, column := range crd.Spec.Versions[0].AdditionalPrinterColumns {
+		path := jsonpath.New(column.Name)
+		if err := path.Parse(fmt.Sprintf("{%s}", column.JSONPath)); err != nil {
+			klog.V(4).Infof("Skipping printer column %s of %s: %v", column.Name, crd.Name, err)
+			continue
+		}
+
+		path.AllowMissingKeys(true)
+		columns = append(columns, printerColumn{
+			CustomResourcePrinterColumn: types.CustomResourcePrinterColumn{
+				Name:        column.Name,
+				Type:        column.Type,
+				Format:      column.Format,
+				Description: column.Description,
+				Priority:    column.Priority,
+				JSONPath:    column.JSONPath,
+			},
+			path: path,
+		})
+	}
+
+	return columns
+}
+
+func toPrinterColumns(columns []printerColumn) []types.CustomResourcePrinterColumn {
+	result := make([]types.CustomResourcePrinterColumn, len(columns))
+	for i, column := range columns {
+		result[i] = column.CustomResourcePrinterColumn
+	}
+
+	return result
+}
+
+// getColumnValues evaluates the columns against the object the same way as the API server does when
+// it prints objects as a table, except that dates are returned as they are instead of as age.
+func getColumnValues(columns []printerColumn, object interface{}) []interface{} {
+	if len(columns) == 0 {
+		return nil
+	}
+
+	values := make([]interface{}, len(columns))
+	for i, column := range columns {
+		results, err := column.path.FindResults(object)
+		if err != nil || len(results) == 0 || len(results[0]) == 0 {
+			continue
+		}
+
+		value := results[0][0].Interface()
+		if column.Type == "string" {
+			var buf bytes.Buffer
+			if err := column.path.PrintResults(&buf, []reflect.Value{reflect.ValueOf(value)}); err == nil {
+				values[i] = buf.String()
+			}
+			continue
+		}
+
+		values[i] = toColumnValue(column.Type, value)
+	}
+
+	return values
+}
+
+func toColumnValue(columnType string, value interface{}) interface{} {
+	switch columnType {
+	case "integer":
+		switch typed := value.(type) {
+		case int64:
+			return typed
+		case float64:
+			return int64(typed)
+		case json.Number:
+			if i, err := typed.Int64(); err == nil {
+				return i
+			}
+		}
+	case "number":
+		switch typed := value.(type) {
+		case int64:
+			return float64(typed)
+		case float64:
+			return typed
+		case json.Number:
+			if f, err := typed.Float64(); err == nil {
+				return f
+			}
+		}
+	case "boolean":
+		if b, ok := value.(bool); ok {
+			return b
+		}
+	case "date":
+		if s, ok := value.(string); ok {
+			return s
+		}
+	}
+
+	return nil
+}
+
+// setListColumnValues evaluates the columns against items of the raw list, which are in the same
+// order as the decoded items.
+func setListColumnValues(raw []byte, items []types.CustomResourceObject, columns []printerColumn) error {
+	if len(columns) == 0 {
+		return nil
+	}
+
+	rawList := &struct {
+		Items []interface{} `json:"items"`
+	}{}
+	if err := json.Unmarshal(raw, rawList); err != nil {
+		return err
+	}
+
+	for i := range items {
+		if i < len(rawList.Items) {
+			items[i].Columns = getColumnValues(columns, rawList.Items[i])
+		}
+	}
+
+	return nil
+}
+
+// setColumnValues evaluates the columns against the raw object.
+func setColumnValues(raw []byte, object *types.CustomResourceObject, columns []printerColumn) error {
+	if len(columns) == 0 {
+		return nil
+	}
+
+	var content interface{}
+	if err := json.Unmarshal(raw, &content); err != nil {
+		return err
+	}
+
+	object.Columns = getColumnValues(columns, content)
+	return nil
+}
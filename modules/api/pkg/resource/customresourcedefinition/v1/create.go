@@ -0,0 +1,172 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/customresourcedefinition/types"
+	"k8s.io/dashboard/errors"
+)
+
+// CreateCustomResourceObject validates the object against the schema of the CRD and creates it.
+func CreateCustomResourceObject(client apiextensionsclientset.Interface, config *rest.Config, namespace,
+	crdName string, body []byte) (*types.CustomResourceObjectDetail, error) {
+	crd, err := client.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), crdName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	object, crd, err := toValidObject(crd, namespace, "", body)
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(4).Infof("Creating %s %s in %s namespace", crd.Spec.Names.Kind, object.GetName(), namespace)
+	restClient, err := NewRESTClient(config, crd)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := object.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := restClient.Post().
+		NamespaceIfScoped(namespace, crd.Spec.Scope == apiextensionsv1.NamespaceScoped).
+		Resource(crd.Spec.Names.Plural).
+		Body(data).
+		Do(context.TODO()).Raw()
+	if err != nil {
+		return nil, err
+	}
+
+	return toCustomResourceObjectDetail(raw, crd)
+}
+
+// UpdateCustomResourceObject validates the object against the schema of the CRD and replaces the
+// existing object with it. Objects without resourceVersion overwrite the latest version of the
+// object, otherwise conflicting changes are reported.
+func UpdateCustomResourceObject(client apiextensionsclientset.Interface, config *rest.Config, namespace,
+	crdName, name string, body []byte) (*types.CustomResourceObjectDetail, error) {
+	crd, err := client.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), crdName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	object, crd, err := toValidObject(crd, namespace, name, body)
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(4).Infof("Updating %s %s in %s namespace", crd.Spec.Names.Kind, name, namespace)
+	restClient, err := NewRESTClient(config, crd)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaced := crd.Spec.Scope == apiextensionsv1.NamespaceScoped
+	overwrite := len(object.GetResourceVersion()) == 0
+	var raw []byte
+	err = retry.OnError(retry.DefaultRetry, func(err error) bool { return overwrite && k8serrors.IsConflict(err) }, func() error {
+		if overwrite {
+			current, err := restClient.Get().
+				NamespaceIfScoped(namespace, namespaced).
+				Resource(crd.Spec.Names.Plural).
+				Name(name).
+				Do(context.TODO()).Raw()
+			if err != nil {
+				return err
+			}
+
+			existing := &unstructured.Unstructured{}
+			if err := existing.UnmarshalJSON(current); err != nil {
+				return err
+			}
+
+			object.SetResourceVersion(existing.GetResourceVersion())
+		}
+
+		data, err := object.MarshalJSON()
+		if err != nil {
+			return err
+		}
+
+		raw, err = restClient.Put().
+			NamespaceIfScoped(namespace, namespaced).
+			Resource(crd.Spec.Names.Plural).
+			Name(name).
+			Body(data).
+			Do(context.TODO()).Raw()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toCustomResourceObjectDetail(raw, crd)
+}
+
+// DeleteCustomResourceObject deletes the object of the CRD.
+func DeleteCustomResourceObject(client apiextensionsclientset.Interface, config *rest.Config, namespace,
+	crdName, name string) error {
+	crd, err := client.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), crdName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	served := removeNonServedVersions(*crd)
+	if !isServed(served) {
+		return errors.NewNotFound(fmt.Sprintf("could not find any served versions for the requested resource (%s)", crd.Name))
+	}
+
+	klog.V(4).Infof("Deleting %s %s in %s namespace", crd.Spec.Names.Kind, name, namespace)
+	restClient, err := NewRESTClient(config, &served)
+	if err != nil {
+		return err
+	}
+
+	return restClient.Delete().
+		NamespaceIfScoped(namespace, crd.Spec.Scope == apiextensionsv1.NamespaceScoped).
+		Resource(crd.Spec.Names.Plural).
+		Name(name).
+		Do(context.TODO()).Error()
+}
+
+func toCustomResourceObjectDetail(raw []byte, crd *apiextensionsv1.CustomResourceDefinition) (*types.CustomResourceObjectDetail, error) {
+	detail := &types.CustomResourceObjectDetail{Errors: []error{}}
+	if err := json.Unmarshal(raw, &detail.CustomResourceObject); err != nil {
+		return nil, err
+	}
+
+	if err := setColumnValues(raw, &detail.CustomResourceObject, getPrinterColumns(crd)); err != nil {
+		return nil, err
+	}
+
+	toCRDObject(&detail.CustomResourceObject, crd)
+	return detail, nil
+}
@@ -0,0 +1,175 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+func newTestCRD() *apiextensionsv1.CustomResourceDefinition {
+	schema := &apiextensionsv1.JSONSchemaProps{
+		Type: "object",
+		Properties: map[string]apiextensionsv1.JSONSchemaProps{
+			"spec": {
+				Type:     "object",
+				Required: []string{"image", "mode"},
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{
+					"image":    {Type: "string"},
+					"replicas": {Type: "integer", Minimum: &[]float64{1}[0]},
+					"mode": {
+						Type:    "string",
+						Enum:    []apiextensionsv1.JSON{{Raw: []byte(`"fast"`)}, {Raw: []byte(`"safe"`)}},
+						Default: &apiextensionsv1.JSON{Raw: []byte(`"safe"`)},
+					},
+				},
+			},
+			"status": {
+				Type: "object",
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{
+					"ready": {Type: "boolean"},
+				},
+			},
+		},
+	}
+
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metaV1.ObjectMeta{Name: "foos.samplecontroller.k8s.io"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "samplecontroller.k8s.io",
+			Scope: apiextensionsv1.NamespaceScoped,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: "Foo", Plural: "foos"},
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1alpha1", Served: false},
+				{
+					Name:    "v1",
+					Served:  true,
+					Storage: true,
+					Schema:  &apiextensionsv1.CustomResourceValidation{OpenAPIV3Schema: schema},
+					AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+						{Name: "Image", Type: "string", JSONPath: ".spec.image"},
+						{Name: "Replicas", Type: "integer", JSONPath: ".spec.replicas"},
+						{Name: "Ready", Type: "boolean", JSONPath: ".status.ready", Priority: 1},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestToValidObject(t *testing.T) {
+	cases := []struct {
+		body     string
+		name     string
+		problems []string
+	}{
+		{`{"metadata": {"name": "foo"}, "spec": {"image": "nginx", "replicas": 2}}`, "", nil},
+		{`{"apiVersion": "samplecontroller.k8s.io/v1", "kind": "Foo", "spec": {"image": "nginx"}}`, "foo", nil},
+		{`{"spec": {"image": "nginx"}}`, "", []string{"metadata.name or metadata.generateName is required"}},
+		{
+			`{"kind": "Bar", "metadata": {"name": "foo", "namespace": "other"}, "spec": {"replicas": 0, "mode": "slow", "size": 1}}`, "",
+			[]string{"kind must be Foo", "metadata.namespace must be default", `unknown field "spec.size"`,
+				"spec.image in body is required", "spec.replicas in body should be greater than or equal to 1",
+				"spec.mode in body should be one of [fast safe]"},
+		},
+		{`{"metadata": {"name": "bar"}, "spec": {"image": "nginx"}}`, "foo", []string{"metadata.name must be foo, got bar"}},
+		{`{"apiVersion": "samplecontroller.k8s.io/v1alpha1", "metadata": {"name": "foo"}}`, "", []string{"version v1alpha1 of foos.samplecontroller.k8s.io is not served"}},
+		{`[]`, "", []string{"object must be a JSON object"}},
+	}
+
+	for _, c := range cases {
+		object, crd, err := toValidObject(newTestCRD(), "default", c.name, []byte(c.body))
+		if len(c.problems) == 0 {
+			if err != nil {
+				t.Errorf("toValidObject(%s) returned error: %v", c.body, err)
+				continue
+			}
+
+			if object.GetAPIVersion() != "samplecontroller.k8s.io/v1" || object.GetKind() != "Foo" ||
+				object.GetNamespace() != "default" || crd.Spec.Versions[0].Name != "v1" {
+				t.Errorf("toValidObject(%s) == %v, expected defaulted apiVersion, kind and namespace", c.body, object.Object)
+			}
+			continue
+		}
+
+		if !k8serrors.IsBadRequest(err) {
+			t.Errorf("toValidObject(%s) returned %v, expected bad request", c.body, err)
+			continue
+		}
+
+		for _, problem := range c.problems {
+			if !strings.Contains(err.Error(), problem) {
+				t.Errorf("toValidObject(%s) returned %q, expected it to contain %q", c.body, err, problem)
+			}
+		}
+	}
+}
+
+func TestGetColumnValues(t *testing.T) {
+	crd := removeNonServedVersions(*newTestCRD())
+	columns := getPrinterColumns(&crd)
+	object := map[string]interface{}{
+		"spec":   map[string]interface{}{"image": "nginx", "replicas": float64(3)},
+		"status": map[string]interface{}{"ready": "yes"},
+	}
+
+	expected := []interface{}{"nginx", int64(3), nil}
+	if actual := getColumnValues(columns, object); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("getColumnValues() == %#v, expected %#v", actual, expected)
+	}
+}
+
+func TestCreateCustomResourceObject(t *testing.T) {
+	var path, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		path, body = r.Method+" "+r.URL.Path, string(data)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"apiVersion": "samplecontroller.k8s.io/v1", "kind": "Foo",
+			"metadata": {"name": "foo", "namespace": "default"}, "spec": {"image": "nginx", "replicas": 2}}`))
+	}))
+	defer server.Close()
+
+	client := fake.NewSimpleClientset(newTestCRD())
+	detail, err := CreateCustomResourceObject(client, &rest.Config{Host: server.URL}, "default", "foos.samplecontroller.k8s.io",
+		[]byte(`{"metadata": {"name": "foo"}, "spec": {"image": "nginx", "replicas": 2}}`))
+	if err != nil {
+		t.Fatalf("CreateCustomResourceObject() returned error: %v", err)
+	}
+
+	if path != "POST /apis/samplecontroller.k8s.io/v1/namespaces/default/foos" {
+		t.Errorf("CreateCustomResourceObject() sent %s", path)
+	}
+
+	if !strings.Contains(body, `"kind":"Foo"`) || strings.Contains(body, `"mode"`) {
+		t.Errorf("CreateCustomResourceObject() sent %s, expected kind without defaults", body)
+	}
+
+	expected := []interface{}{"nginx", int64(2), nil}
+	if detail.ObjectMeta.Name != "foo" || !reflect.DeepEqual(detail.Columns, expected) {
+		t.Errorf("CreateCustomResourceObject() == %#v", detail)
+	}
+}
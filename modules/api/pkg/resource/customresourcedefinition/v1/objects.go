@@ -70,6 +70,15 @@ func GetCustomResourceObjectList(client apiextensionsclientset.Interface, config
 	if criticalError != nil {
 		return nil, criticalError
 	}
+
+	// Column values are evaluated before data select, so that the raw items are in the same order.
+	columns := getPrinterColumns(customResourceDefinition)
+	list.Columns = toPrinterColumns(columns)
+	err = setListColumnValues(raw, list.Items, columns)
+	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, criticalError
+	}
 	list.Errors = nonCriticalErrors
 
 	// Return only slice of data, pagination is done here.
@@ -116,6 +125,12 @@ func GetCustomResourceObjectDetail(client apiextensionsclientset.Interface, name
 	if criticalError != nil {
 		return nil, criticalError
 	}
+
+	err = setColumnValues(raw, &detail.CustomResourceObject, getPrinterColumns(customResourceDefinition))
+	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, criticalError
+	}
 	detail.Errors = nonCriticalErrors
 
 	toCRDObject(&detail.CustomResourceObject, customResourceDefinition)
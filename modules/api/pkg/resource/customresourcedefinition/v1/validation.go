@@ -0,0 +1,192 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/pruning"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+
+	"k8s.io/dashboard/errors"
+)
+
+// toValidObject decodes the object, fills in its apiVersion, kind, namespace and name when they are
+// missing and validates it against the structural schema of its version. It returns the CRD with
+// the version of the object first, so that the REST client is created for that version.
+//
+// Validation rules written in CEL are not evaluated here, they are left to the API server.
+func toValidObject(crd *apiextensionsv1.CustomResourceDefinition, namespace, name string,
+	body []byte) (*unstructured.Unstructured, *apiextensionsv1.CustomResourceDefinition, error) {
+	var content interface{}
+	if err := utiljson.Unmarshal(body, &content); err != nil {
+		return nil, nil, errors.NewBadRequest(fmt.Sprintf("invalid object: %s", err))
+	}
+
+	contentMap, ok := content.(map[string]interface{})
+	if !ok {
+		return nil, nil, errors.NewBadRequest("object must be a JSON object")
+	}
+
+	object := &unstructured.Unstructured{Object: contentMap}
+	problems := make([]string, 0)
+
+	crd, err := withObjectVersion(crd, object)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(object.GetKind()) == 0 {
+		object.SetKind(crd.Spec.Names.Kind)
+	} else if object.GetKind() != crd.Spec.Names.Kind {
+		problems = append(problems, fmt.Sprintf("kind must be %s, got %s", crd.Spec.Names.Kind, object.GetKind()))
+	}
+
+	if crd.Spec.Scope == apiextensionsv1.NamespaceScoped {
+		if len(object.GetNamespace()) == 0 {
+			object.SetNamespace(namespace)
+		} else if object.GetNamespace() != namespace {
+			problems = append(problems, fmt.Sprintf("metadata.namespace must be %s, got %s", namespace, object.GetNamespace()))
+		}
+	} else if len(object.GetNamespace()) > 0 {
+		problems = append(problems, fmt.Sprintf("metadata.namespace must be empty, %s is cluster scoped", crd.Name))
+	}
+
+	switch {
+	case len(name) == 0 && len(object.GetName()) == 0 && len(object.GetGenerateName()) == 0:
+		problems = append(problems, "metadata.name or metadata.generateName is required")
+	case len(name) > 0 && len(object.GetName()) == 0:
+		object.SetName(name)
+	case len(name) > 0 && object.GetName() != name:
+		problems = append(problems, fmt.Sprintf("metadata.name must be %s, got %s", name, object.GetName()))
+	}
+
+	schemaProblems, err := validateObjectSchema(&crd.Spec.Versions[0], object)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	problems = append(problems, schemaProblems...)
+	if len(problems) > 0 {
+		return nil, nil, errors.NewBadRequest(strings.Join(problems, "; "))
+	}
+
+	return object, crd, nil
+}
+
+// withObjectVersion returns a copy of the CRD with only served versions, the version of the object
+// being the first one. Objects without apiVersion get the first served version.
+func withObjectVersion(crd *apiextensionsv1.CustomResourceDefinition,
+	object *unstructured.Unstructured) (*apiextensionsv1.CustomResourceDefinition, error) {
+	served := removeNonServedVersions(*crd.DeepCopy())
+	if !isServed(served) {
+		return nil, errors.NewNotFound(fmt.Sprintf("could not find any served versions for the requested resource (%s)", crd.Name))
+	}
+
+	if len(object.GetAPIVersion()) == 0 {
+		object.SetAPIVersion(schema.GroupVersion{Group: crd.Spec.Group, Version: served.Spec.Versions[0].Name}.String())
+		return &served, nil
+	}
+
+	groupVersion, err := schema.ParseGroupVersion(object.GetAPIVersion())
+	if err != nil || groupVersion.Group != crd.Spec.Group {
+		return nil, errors.NewBadRequest(fmt.Sprintf("apiVersion must be in %s group, got %s", crd.Spec.Group, object.GetAPIVersion()))
+	}
+
+	for i, version := range served.Spec.Versions {
+		if version.Name == groupVersion.Version {
+			served.Spec.Versions[0], served.Spec.Versions[i] = served.Spec.Versions[i], served.Spec.Versions[0]
+			return &served, nil
+		}
+	}
+
+	return nil, errors.NewBadRequest(fmt.Sprintf("version %s of %s is not served", groupVersion.Version, crd.Name))
+}
+
+// validateObjectSchema reports unknown fields, which the API server would prune, and values not
+// matching the schema after defaults are applied, as the API server does. Validation is done with
+// the kube-openapi validator directly, the apiextensions validation package depends on the API
+// server libraries.
+func validateObjectSchema(version *apiextensionsv1.CustomResourceDefinitionVersion, object *unstructured.Unstructured) ([]string, error) {
+	if version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+		return nil, nil
+	}
+
+	internalSchema := &apiextensions.JSONSchemaProps{}
+	if err := apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(version.Schema.OpenAPIV3Schema, internalSchema, nil); err != nil {
+		return nil, err
+	}
+
+	structural, err := structuralschema.NewStructural(internalSchema)
+	if err != nil {
+		return nil, errors.NewInternal(fmt.Sprintf("schema of version %s is not structural: %s", version.Name, err))
+	}
+
+	content := runtime.DeepCopyJSON(object.Object)
+	problems := make([]string, 0)
+	unknownFields := pruning.PruneWithOptions(content, structural, true, structuralschema.UnknownFieldPathOptions{TrackUnknownFieldPaths: true})
+	for _, path := range unknownFields {
+		problems = append(problems, fmt.Sprintf("unknown field %q", path))
+	}
+
+	applyDefaults(content, structural)
+	result := validate.NewSchemaValidator(structural.ToKubeOpenAPI(), nil, "", strfmt.Default).Validate(content)
+	for _, validationError := range result.Errors {
+		problems = append(problems, validationError.Error())
+	}
+
+	return problems, nil
+}
+
+// applyDefaults sets defaults of the schema on missing and non-nullable null fields, the same way as
+// the API server does before validation.
+func applyDefaults(x interface{}, s *structuralschema.Structural) {
+	if s == nil {
+		return
+	}
+
+	switch x := x.(type) {
+	case map[string]interface{}:
+		for name, property := range s.Properties {
+			value, exists := x[name]
+			if property.Default.Object != nil && (!exists || (value == nil && !property.Nullable)) {
+				x[name] = runtime.DeepCopyJSONValue(property.Default.Object)
+			}
+
+			applyDefaults(x[name], &property)
+		}
+
+		if s.AdditionalProperties != nil && s.AdditionalProperties.Structural != nil {
+			for name, value := range x {
+				if _, exists := s.Properties[name]; !exists {
+					applyDefaults(value, s.AdditionalProperties.Structural)
+				}
+			}
+		}
+	case []interface{}:
+		for _, item := range x {
+			applyDefaults(item, s.Items)
+		}
+	}
+}
@@ -28,4 +28,8 @@ const (
 	FirstSeenProperty         = "firstSeen"
 	LastSeenProperty          = "lastSeen"
 	ReasonProperty            = "reason"
+
+	// UsageProperty is the highest usage of a limited resource in percents, e.g. of resource quotas.
+	// Usage of a single resource can be selected with the "usage.<resource name>" property.
+	UsageProperty = "usage"
 )
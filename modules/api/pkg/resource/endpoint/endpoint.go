@@ -16,6 +16,7 @@ package endpoint
 
 import (
 	v1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
@@ -24,6 +25,7 @@ import (
 
 	"k8s.io/dashboard/api/pkg/args"
 	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/errors"
 	"k8s.io/dashboard/types"
 )
 
@@ -34,33 +36,80 @@ type Endpoint struct {
 	// Hostname, either as a domain name or IP address.
 	Host string `json:"host"`
 
+	// Type of the address, e.g. IPv4, IPv6 or FQDN. Empty for endpoints read from the Endpoints API.
+	AddressType string `json:"addressType,omitempty"`
+
 	// Name of the node the endpoint is located
 	NodeName *string `json:"nodeName"`
 
+	// Zone the endpoint is located in.
+	Zone *string `json:"zone,omitempty"`
+
+	// Zones the endpoint should be consumed from when topology aware routing is enabled.
+	ZoneHints []string `json:"zoneHints,omitempty"`
+
 	// Status of the endpoint
 	Ready bool `json:"ready"`
 
+	// Serving is like ready, but is also set for terminating endpoints still able to serve traffic.
+	Serving bool `json:"serving"`
+
+	// Terminating is set for endpoints of terminating pods.
+	Terminating bool `json:"terminating"`
+
 	// Array of endpoint ports
 	Ports []v1.EndpointPort `json:"ports"`
 }
 
-// GetServiceEndpoints gets list of endpoints targeted by given label selector in given namespace.
+// GetServiceEndpoints gets endpoints of the service aggregated from its EndpointSlices. Servers
+// not serving discovery.k8s.io/v1, or users not allowed to list EndpointSlices, fall back to the
+// Endpoints of the service.
 func GetServiceEndpoints(client k8sClient.Interface, namespace, name string) (*EndpointList, error) {
 	endpointList := &EndpointList{
 		Endpoints: make([]Endpoint, 0),
 		ListMeta:  types.ListMeta{TotalItems: 0},
 	}
 
-	serviceEndpoints, err := GetEndpoints(client, namespace, name)
-	if err != nil {
+	slices, err := GetEndpointSlices(client, namespace, name)
+	if errors.IsNotFound(err) || errors.IsForbidden(err) {
+		klog.V(args.LogLevelVerbose).Infof("EndpointSlices are not available (%v), falling back to Endpoints of %s service in %s namespace", err, name, namespace)
+		serviceEndpoints, err := GetEndpoints(client, namespace, name)
+		if err != nil {
+			return endpointList, err
+		}
+
+		endpointList = toEndpointList(serviceEndpoints)
+	} else if err != nil {
 		return endpointList, err
+	} else {
+		endpointList = toEndpointListFromSlices(slices)
 	}
 
-	endpointList = toEndpointList(serviceEndpoints)
 	klog.V(args.LogLevelVerbose).Infof("Found %d endpoints related to %s service in %s namespace", len(endpointList.Endpoints), name, namespace)
 	return endpointList, nil
 }
 
+// GetEndpointSlices gets EndpointSlices of the service with given name.
+func GetEndpointSlices(client k8sClient.Interface, namespace, name string) ([]discovery.EndpointSlice, error) {
+	selector := labels.SelectorFromSet(labels.Set{discovery.LabelServiceName: name})
+	channels := &common.ResourceChannels{
+		EndpointSliceList: common.GetEndpointSliceListChannelWithOptions(client,
+			common.NewSameNamespaceQuery(namespace),
+			metaV1.ListOptions{
+				LabelSelector: selector.String(),
+				FieldSelector: fields.Everything().String(),
+			},
+			1),
+	}
+
+	sliceList := <-channels.EndpointSliceList.List
+	if err := <-channels.EndpointSliceList.Error; err != nil {
+		return nil, err
+	}
+
+	return sliceList.Items, nil
+}
+
 // GetEndpoints gets endpoints associated to resource with given name.
 func GetEndpoints(client k8sClient.Interface, namespace, name string) ([]v1.Endpoints, error) {
 	fieldSelector, err := fields.ParseSelector("metadata.name" + "=" + name)
@@ -93,6 +142,61 @@ func toEndpoint(address v1.EndpointAddress, ports []v1.EndpointPort, ready bool)
 		Host:     address.IP,
 		Ports:    ports,
 		Ready:    ready,
+		Serving:  ready,
 		NodeName: address.NodeName,
 	}
 }
+
+// toSliceEndpoint converts an endpoint of an EndpointSlice to Endpoint model object. Unknown ready
+// and serving conditions are interpreted as true, as required by the API.
+func toSliceEndpoint(endpoint discovery.Endpoint, addressType discovery.AddressType, ports []v1.EndpointPort) *Endpoint {
+	result := &Endpoint{
+		TypeMeta:    types.NewTypeMeta(types.ResourceKindEndpoint),
+		AddressType: string(addressType),
+		Ports:       ports,
+		Ready:       endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready,
+		Terminating: endpoint.Conditions.Terminating != nil && *endpoint.Conditions.Terminating,
+		NodeName:    endpoint.NodeName,
+		Zone:        endpoint.Zone,
+	}
+
+	if len(endpoint.Addresses) > 0 {
+		result.Host = endpoint.Addresses[0]
+	}
+
+	result.Serving = result.Ready
+	if endpoint.Conditions.Serving != nil {
+		result.Serving = *endpoint.Conditions.Serving
+	}
+
+	if endpoint.Hints != nil {
+		for _, zone := range endpoint.Hints.ForZones {
+			result.ZoneHints = append(result.ZoneHints, zone.Name)
+		}
+	}
+
+	return result
+}
+
+// toEndpointPorts converts ports of an EndpointSlice to the ports of the Endpoints API.
+func toEndpointPorts(ports []discovery.EndpointPort) []v1.EndpointPort {
+	result := make([]v1.EndpointPort, 0, len(ports))
+	for _, port := range ports {
+		endpointPort := v1.EndpointPort{Protocol: v1.ProtocolTCP, AppProtocol: port.AppProtocol}
+		if port.Name != nil {
+			endpointPort.Name = *port.Name
+		}
+
+		if port.Port != nil {
+			endpointPort.Port = *port.Port
+		}
+
+		if port.Protocol != nil {
+			endpointPort.Protocol = *port.Protocol
+		}
+
+		result = append(result, endpointPort)
+	}
+
+	return result
+}
@@ -0,0 +1,122 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package endpoint
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	v1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func newTestSlice(name, service string, endpoints ...discovery.Endpoint) *discovery.EndpointSlice {
+	return &discovery.EndpointSlice{
+		ObjectMeta: metaV1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+			Labels:    map[string]string{discovery.LabelServiceName: service},
+		},
+		AddressType: discovery.AddressTypeIPv4,
+		Endpoints:   endpoints,
+		Ports:       []discovery.EndpointPort{{Name: lo.ToPtr("http"), Port: lo.ToPtr(int32(8080))}},
+	}
+}
+
+func TestGetServiceEndpointsFromSlices(t *testing.T) {
+	ready := discovery.Endpoint{
+		Addresses: []string{"10.0.0.1"},
+		NodeName:  lo.ToPtr("node-1"),
+		Zone:      lo.ToPtr("zone-a"),
+		Hints:     &discovery.EndpointHints{ForZones: []discovery.ForZone{{Name: "zone-a"}}},
+	}
+	terminating := discovery.Endpoint{
+		Addresses: []string{"10.0.0.2"},
+		Conditions: discovery.EndpointConditions{
+			Ready:       lo.ToPtr(false),
+			Serving:     lo.ToPtr(true),
+			Terminating: lo.ToPtr(true),
+		},
+	}
+
+	client := fake.NewSimpleClientset(
+		newTestSlice("web-abc", "web", ready),
+		newTestSlice("web-def", "web", ready, terminating),
+		newTestSlice("api-abc", "api", discovery.Endpoint{Addresses: []string{"10.0.0.3"}}),
+	)
+
+	list, err := GetServiceEndpoints(client, "default", "web")
+	if err != nil {
+		t.Fatalf("GetServiceEndpoints() returned error: %v", err)
+	}
+
+	if list.ListMeta.TotalItems != 2 || len(list.Endpoints) != 2 {
+		t.Fatalf("expected two deduplicated endpoints, got %+v", list)
+	}
+
+	first, second := list.Endpoints[0], list.Endpoints[1]
+	if first.Host != "10.0.0.1" || !first.Ready || !first.Serving || first.Terminating || *first.NodeName != "node-1" ||
+		*first.Zone != "zone-a" || len(first.ZoneHints) != 1 || first.AddressType != "IPv4" {
+		t.Errorf("unexpected ready endpoint %+v", first)
+	}
+
+	if second.Host != "10.0.0.2" || second.Ready || !second.Serving || !second.Terminating {
+		t.Errorf("unexpected terminating endpoint %+v", second)
+	}
+
+	if port := first.Ports[0]; port.Name != "http" || port.Port != 8080 || port.Protocol != v1.ProtocolTCP {
+		t.Errorf("unexpected endpoint port %+v", port)
+	}
+}
+
+func TestGetServiceEndpointsFallback(t *testing.T) {
+	resource := schema.GroupResource{Group: discovery.GroupName, Resource: "endpointslices"}
+	cases := map[string]error{
+		"not served": k8serrors.NewNotFound(resource, ""),
+		"forbidden":  k8serrors.NewForbidden(resource, "", nil),
+	}
+
+	for name, sliceErr := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(&v1.Endpoints{
+				ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "web"},
+				Subsets: []v1.EndpointSubset{{
+					Addresses:         []v1.EndpointAddress{{IP: "10.0.0.1"}},
+					NotReadyAddresses: []v1.EndpointAddress{{IP: "10.0.0.2"}},
+					Ports:             []v1.EndpointPort{{Port: 8080}},
+				}},
+			})
+
+			client.PrependReactor("list", "endpointslices", func(clienttesting.Action) (bool, runtime.Object, error) {
+				return true, nil, sliceErr
+			})
+
+			list, err := GetServiceEndpoints(client, "default", "web")
+			if err != nil {
+				t.Fatalf("GetServiceEndpoints() returned error: %v", err)
+			}
+
+			if list.ListMeta.TotalItems != 2 || !list.Endpoints[0].Ready || !list.Endpoints[0].Serving || list.Endpoints[1].Ready {
+				t.Errorf("unexpected endpoints read from the Endpoints API %+v", list)
+			}
+		})
+	}
+}
@@ -15,7 +15,12 @@
 package endpoint
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	v1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
 
 	"k8s.io/dashboard/types"
 )
@@ -26,11 +31,10 @@ type EndpointList struct {
 	Endpoints []Endpoint `json:"endpoints"`
 }
 
-// toEndpointList converts array of api events to endpoint List structure
+// toEndpointList converts array of api endpoints to endpoint List structure
 func toEndpointList(endpoints []v1.Endpoints) *EndpointList {
 	endpointList := EndpointList{
 		Endpoints: make([]Endpoint, 0),
-		ListMeta:  types.ListMeta{TotalItems: len(endpoints)},
 	}
 
 	for _, endpoint := range endpoints {
@@ -44,5 +48,43 @@ func toEndpointList(endpoints []v1.Endpoints) *EndpointList {
 		}
 	}
 
+	endpointList.ListMeta = types.ListMeta{TotalItems: len(endpointList.Endpoints)}
 	return &endpointList
 }
+
+// toEndpointListFromSlices aggregates endpoints of all EndpointSlices of a service. Endpoints
+// present in multiple slices, e.g. while slices are rebalanced, are listed once.
+func toEndpointListFromSlices(slices []discovery.EndpointSlice) *EndpointList {
+	endpointList := EndpointList{
+		Endpoints: make([]Endpoint, 0),
+	}
+
+	sort.SliceStable(slices, func(i, j int) bool { return slices[i].Name < slices[j].Name })
+	seen := make(map[string]struct{})
+	for _, slice := range slices {
+		ports := toEndpointPorts(slice.Ports)
+		for _, sliceEndpoint := range slice.Endpoints {
+			endpoint := toSliceEndpoint(sliceEndpoint, slice.AddressType, ports)
+			key := endpointKey(endpoint)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+
+			seen[key] = struct{}{}
+			endpointList.Endpoints = append(endpointList.Endpoints, *endpoint)
+		}
+	}
+
+	endpointList.ListMeta = types.ListMeta{TotalItems: len(endpointList.Endpoints)}
+	return &endpointList
+}
+
+func endpointKey(endpoint *Endpoint) string {
+	ports := make([]string, 0, len(endpoint.Ports))
+	for _, port := range endpoint.Ports {
+		ports = append(ports, fmt.Sprintf("%s/%d/%s", port.Name, port.Port, port.Protocol))
+	}
+
+	sort.Strings(ports)
+	return endpoint.Host + "|" + strings.Join(ports, ",")
+}
@@ -0,0 +1,221 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// Reasons why a backend of a route could not be resolved. They match reasons of the ResolvedRefs
+// route condition of the Gateway API.
+const (
+	BackendReasonInvalidKind     = "InvalidKind"
+	BackendReasonRefNotPermitted = "RefNotPermitted"
+	BackendReasonBackendNotFound = "BackendNotFound"
+	BackendReasonUnknown         = "Unknown"
+)
+
+const (
+	coreGroup            = ""
+	serviceKind          = "Service"
+	defaultBackendWeight = 1
+)
+
+// RouteBackend is a backend referenced by a rule of a route, resolved to a Service.
+type RouteBackend struct {
+	// Rule is the index of the route rule referencing the backend.
+	Rule int `json:"rule"`
+
+	Group     string `json:"group"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Port      *int32 `json:"port,omitempty"`
+	Weight    int32  `json:"weight"`
+
+	// Resolved is true when the backend is a Service which exists, exposes the port and may be
+	// referenced from the namespace of the route. Otherwise reason and message tell why.
+	Resolved bool   `json:"resolved"`
+	Reason   string `json:"reason,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+// backendResolver resolves backends of routes, caching reference grants per namespace.
+type backendResolver struct {
+	client        kubernetes.Interface
+	dynamicClient dynamic.Interface
+
+	grantsInstalled *bool
+	grants          map[string][]referenceGrant
+
+	// List of non-critical errors, that occurred during resolution.
+	errors []error
+}
+
+func newBackendResolver(client kubernetes.Interface, dynamicClient dynamic.Interface) *backendResolver {
+	return &backendResolver{
+		client:        client,
+		dynamicClient: dynamicClient,
+		grants:        make(map[string][]referenceGrant),
+		errors:        make([]error, 0),
+	}
+}
+
+// resolve returns backends of all rules of the route.
+func (in *backendResolver) resolve(r *route, kind types.ResourceKind) ([]RouteBackend, error) {
+	result := make([]RouteBackend, 0)
+	for i, rule := range r.Spec.Rules {
+		for _, ref := range rule.BackendRefs {
+			backend, err := in.resolveBackend(r, kind, ref)
+			if err != nil {
+				return nil, err
+			}
+
+			backend.Rule = i
+			result = append(result, backend)
+		}
+	}
+
+	return result, nil
+}
+
+func (in *backendResolver) resolveBackend(r *route, kind types.ResourceKind, ref backendReference) (RouteBackend, error) {
+	backend := RouteBackend{
+		Group:     stringOrDefault(ref.Group, coreGroup),
+		Kind:      stringOrDefault(ref.Kind, serviceKind),
+		Namespace: stringOrDefault(ref.Namespace, r.Namespace),
+		Name:      ref.Name,
+		Port:      ref.Port,
+		Weight:    defaultBackendWeight,
+	}
+
+	if ref.Weight != nil {
+		backend.Weight = *ref.Weight
+	}
+
+	if !isServiceReference(ref) {
+		backend.Reason = BackendReasonInvalidKind
+		backend.Message = fmt.Sprintf("only Service backends are supported, got %s of %s group", backend.Kind, backend.Group)
+		return backend, nil
+	}
+
+	if backend.Namespace != r.Namespace {
+		permitted, err := in.permitted(routeKinds[kind], r.Namespace, backend)
+		if err != nil {
+			return backend, err
+		}
+
+		if !permitted {
+			backend.Reason = BackendReasonRefNotPermitted
+			backend.Message = fmt.Sprintf("reference to %s namespace is not permitted by any ReferenceGrant", backend.Namespace)
+			return backend, nil
+		}
+	}
+
+	service, err := in.client.CoreV1().Services(backend.Namespace).Get(context.TODO(), backend.Name, metaV1.GetOptions{})
+	if errors.IsNotFound(err) {
+		backend.Reason = BackendReasonBackendNotFound
+		backend.Message = fmt.Sprintf("Service %s/%s not found", backend.Namespace, backend.Name)
+		return backend, nil
+	}
+
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return backend, criticalError
+	}
+
+	if len(nonCriticalErrors) > 0 {
+		in.errors = errors.MergeErrors(in.errors, nonCriticalErrors)
+		backend.Reason = BackendReasonUnknown
+		backend.Message = nonCriticalErrors[0].Error()
+		return backend, nil
+	}
+
+	if backend.Port == nil {
+		backend.Reason = BackendReasonBackendNotFound
+		backend.Message = "port is required for Service backends"
+		return backend, nil
+	}
+
+	if !hasPort(service, *backend.Port) {
+		backend.Reason = BackendReasonBackendNotFound
+		backend.Message = fmt.Sprintf("Service %s/%s has no port %d", backend.Namespace, backend.Name, *backend.Port)
+		return backend, nil
+	}
+
+	backend.Resolved = true
+	return backend, nil
+}
+
+// permitted returns whether a reference grant in the backend namespace allows the route to reference it.
+func (in *backendResolver) permitted(routeKind, routeNamespace string, backend RouteBackend) (bool, error) {
+	if in.grantsInstalled == nil {
+		installed, err := common.CheckInstalled(in.client, referenceGrantResource)
+		if err != nil {
+			return false, err
+		}
+
+		in.grantsInstalled = &installed
+	}
+
+	if !*in.grantsInstalled {
+		return false, nil
+	}
+
+	grants, ok := in.grants[backend.Namespace]
+	if !ok {
+		var nonCriticalErrors []error
+		var err error
+		grants, nonCriticalErrors, err = list[referenceGrant](in.dynamicClient, referenceGrantResource, backend.Namespace)
+		if err != nil {
+			return false, err
+		}
+
+		in.errors = errors.MergeErrors(in.errors, nonCriticalErrors)
+		in.grants[backend.Namespace] = grants
+	}
+
+	for i := range grants {
+		if grants[i].permits(routeKind, routeNamespace, backend.Group, backend.Kind, backend.Name) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func isServiceReference(ref backendReference) bool {
+	return stringOrDefault(ref.Group, coreGroup) == coreGroup && stringOrDefault(ref.Kind, serviceKind) == serviceKind
+}
+
+func hasPort(service *v1.Service, port int32) bool {
+	for _, servicePort := range service.Spec.Ports {
+		if servicePort.Port == port {
+			return true
+		}
+	}
+
+	return false
+}
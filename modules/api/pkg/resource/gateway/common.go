@@ -0,0 +1,284 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// Group of the Gateway API CRDs.
+const Group = "gateway.networking.k8s.io"
+
+var (
+	gatewayClassResource   = schema.GroupVersionResource{Group: Group, Version: "v1", Resource: "gatewayclasses"}
+	gatewayResource        = schema.GroupVersionResource{Group: Group, Version: "v1", Resource: "gateways"}
+	httpRouteResource      = schema.GroupVersionResource{Group: Group, Version: "v1", Resource: "httproutes"}
+	grpcRouteResource      = schema.GroupVersionResource{Group: Group, Version: "v1", Resource: "grpcroutes"}
+	referenceGrantResource = schema.GroupVersionResource{Group: Group, Version: "v1beta1", Resource: "referencegrants"}
+)
+
+// routeKinds maps route resource kinds to Gateway API kinds used in references.
+var routeKinds = map[types.ResourceKind]string{
+	types.ResourceKindHTTPRoute: "HTTPRoute",
+	types.ResourceKindGRPCRoute: "GRPCRoute",
+}
+
+var routeResources = map[types.ResourceKind]schema.GroupVersionResource{
+	types.ResourceKindHTTPRoute: httpRouteResource,
+	types.ResourceKindGRPCRoute: grpcRouteResource,
+}
+
+// The types below mirror the parts of Gateway API resources used by Dashboard.
+
+type gatewayClass struct {
+	metaV1.TypeMeta   `json:",inline"`
+	metaV1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec struct {
+		ControllerName string  `json:"controllerName"`
+		Description    *string `json:"description,omitempty"`
+	} `json:"spec"`
+
+	Status struct {
+		Conditions []metaV1.Condition `json:"conditions,omitempty"`
+	} `json:"status"`
+}
+
+type gateway struct {
+	metaV1.TypeMeta   `json:",inline"`
+	metaV1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec struct {
+		GatewayClassName string     `json:"gatewayClassName"`
+		Listeners        []listener `json:"listeners"`
+	} `json:"spec"`
+
+	Status struct {
+		Addresses  []gatewayAddress   `json:"addresses,omitempty"`
+		Conditions []metaV1.Condition `json:"conditions,omitempty"`
+		Listeners  []listenerStatus   `json:"listeners,omitempty"`
+	} `json:"status"`
+}
+
+type listener struct {
+	Name     string  `json:"name"`
+	Hostname *string `json:"hostname,omitempty"`
+	Port     int32   `json:"port"`
+	Protocol string  `json:"protocol"`
+}
+
+type gatewayAddress struct {
+	Type  *string `json:"type,omitempty"`
+	Value string  `json:"value"`
+}
+
+type listenerStatus struct {
+	Name           string             `json:"name"`
+	AttachedRoutes int32              `json:"attachedRoutes"`
+	Conditions     []metaV1.Condition `json:"conditions,omitempty"`
+}
+
+// route is either an HTTPRoute or a GRPCRoute, their fields used by Dashboard are the same.
+type route struct {
+	metaV1.TypeMeta   `json:",inline"`
+	metaV1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec struct {
+		ParentRefs []parentReference `json:"parentRefs,omitempty"`
+		Hostnames  []string          `json:"hostnames,omitempty"`
+		Rules      []struct {
+			BackendRefs []backendReference `json:"backendRefs,omitempty"`
+		} `json:"rules,omitempty"`
+	} `json:"spec"`
+
+	Status struct {
+		Parents []routeParentStatus `json:"parents,omitempty"`
+	} `json:"status"`
+}
+
+type parentReference struct {
+	Group       *string `json:"group,omitempty"`
+	Kind        *string `json:"kind,omitempty"`
+	Namespace   *string `json:"namespace,omitempty"`
+	Name        string  `json:"name"`
+	SectionName *string `json:"sectionName,omitempty"`
+	Port        *int32  `json:"port,omitempty"`
+}
+
+type backendReference struct {
+	Group     *string `json:"group,omitempty"`
+	Kind      *string `json:"kind,omitempty"`
+	Name      string  `json:"name"`
+	Namespace *string `json:"namespace,omitempty"`
+	Port      *int32  `json:"port,omitempty"`
+	Weight    *int32  `json:"weight,omitempty"`
+}
+
+type routeParentStatus struct {
+	ParentRef      parentReference    `json:"parentRef"`
+	ControllerName string             `json:"controllerName"`
+	Conditions     []metaV1.Condition `json:"conditions,omitempty"`
+}
+
+type referenceGrant struct {
+	metaV1.TypeMeta   `json:",inline"`
+	metaV1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec struct {
+		From []ReferenceGrantFrom `json:"from"`
+		To   []ReferenceGrantTo   `json:"to"`
+	} `json:"spec"`
+}
+
+// list returns objects of the resource in the namespace converted to the given mirror type.
+// Non-critical errors are returned as the second value.
+func list[T any](dynamicClient dynamic.Interface, resource schema.GroupVersionResource, namespace string) ([]T, []error, error) {
+	objects, err := dynamicClient.Resource(resource).Namespace(namespace).List(context.TODO(), metaV1.ListOptions{})
+	return fromUnstructuredList[T](objects, err)
+}
+
+// listInAllNamespaces lists objects of the resource in all namespaces, falling back to the given
+// namespace when the user is not allowed to list them cluster-wide.
+func listInAllNamespaces[T any](dynamicClient dynamic.Interface, resource schema.GroupVersionResource, fallbackNamespace string) ([]T, []error, error) {
+	objects, err := dynamicClient.Resource(resource).List(context.TODO(), metaV1.ListOptions{})
+	if errors.IsForbidden(err) {
+		return list[T](dynamicClient, resource, fallbackNamespace)
+	}
+
+	return fromUnstructuredList[T](objects, err)
+}
+
+func fromUnstructuredList[T any](objects *unstructured.UnstructuredList, err error) ([]T, []error, error) {
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, nil, criticalError
+	}
+
+	result := make([]T, 0)
+	if objects == nil {
+		return result, nonCriticalErrors, nil
+	}
+
+	for i := range objects.Items {
+		item := new(T)
+		if err := common.FromUnstructured(&objects.Items[i], item); err != nil {
+			return nil, nil, err
+		}
+
+		result = append(result, *item)
+	}
+
+	return result, nonCriticalErrors, nil
+}
+
+// get returns the object of the resource converted to the given mirror type.
+func get[T any](dynamicClient dynamic.Interface, resource schema.GroupVersionResource, namespace, name string) (*T, error) {
+	obj, err := dynamicClient.Resource(resource).Namespace(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(T)
+	if err := common.FromUnstructured(obj, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func toConditions(conditions []metaV1.Condition) []common.Condition {
+	result := make([]common.Condition, 0, len(conditions))
+	for _, condition := range conditions {
+		result = append(result, common.Condition{
+			Type:               condition.Type,
+			Status:             v1.ConditionStatus(condition.Status),
+			LastTransitionTime: condition.LastTransitionTime,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		})
+	}
+
+	return result
+}
+
+// conditionStatus returns the status of the condition, or unknown when it is not reported.
+func conditionStatus(conditions []metaV1.Condition, conditionType string) v1.ConditionStatus {
+	for _, condition := range conditions {
+		if condition.Type == conditionType {
+			return v1.ConditionStatus(condition.Status)
+		}
+	}
+
+	return v1.ConditionUnknown
+}
+
+func stringOrDefault(value *string, defaultValue string) string {
+	if value == nil {
+		return defaultValue
+	}
+
+	return *value
+}
+
+// The code below allows to perform complex data section on Gateway API resources.
+
+// ObjectCell is a data cell of any Gateway API resource, which can be sorted by name, namespace
+// and creation timestamp.
+type ObjectCell struct {
+	ObjectMeta types.ObjectMeta
+	Index      int
+}
+
+func (in ObjectCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(in.ObjectMeta.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(in.ObjectMeta.CreationTimestamp.Time)
+	case dataselect.NamespaceProperty:
+		return dataselect.StdComparableString(in.ObjectMeta.Namespace)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+// selectItems applies the data select query to the items, returning selected items and the
+// number of items matching the filter.
+func selectItems[T any](items []T, objectMeta func(T) types.ObjectMeta, dsQuery *dataselect.DataSelectQuery) ([]T, int) {
+	cells := make([]dataselect.DataCell, len(items))
+	for i := range items {
+		cells[i] = ObjectCell{ObjectMeta: objectMeta(items[i]), Index: i}
+	}
+
+	selected, filteredTotal := dataselect.GenericDataSelectWithFilter(cells, dsQuery)
+	result := make([]T, len(selected))
+	for i := range selected {
+		result[i] = items[selected[i].(ObjectCell).Index]
+	}
+
+	return result, filteredTotal
+}
@@ -0,0 +1,239 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/types"
+)
+
+// GatewayList contains Gateways in the cluster.
+type GatewayList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Installed is false when the Gateway CRD is not installed. The list is empty then.
+	Installed bool `json:"installed"`
+
+	Items []Gateway `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// Gateway is a presentation layer view of gateway.networking.k8s.io/v1 Gateway.
+type Gateway struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+
+	GatewayClassName string `json:"gatewayClassName"`
+
+	// Addresses assigned to the Gateway by the controller.
+	Addresses []string `json:"addresses"`
+
+	// Accepted and Programmed are the statuses of the conditions with the same names.
+	Accepted   v1.ConditionStatus `json:"accepted"`
+	Programmed v1.ConditionStatus `json:"programmed"`
+}
+
+// GatewayDetail provides the presentation layer view of Gateway with the status of its
+// listeners and the routes attached to it.
+type GatewayDetail struct {
+	// Extends list item structure.
+	Gateway `json:",inline"`
+
+	Conditions []common.Condition `json:"conditions"`
+	Listeners  []Listener         `json:"listeners"`
+	Routes     []AttachedRoute    `json:"routes"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// Listener is a listener of a Gateway with the status reported for it.
+type Listener struct {
+	Name     string `json:"name"`
+	Hostname string `json:"hostname,omitempty"`
+	Port     int32  `json:"port"`
+	Protocol string `json:"protocol"`
+
+	// AttachedRoutes is the number of routes accepted by the listener, as reported by the controller.
+	AttachedRoutes int32              `json:"attachedRoutes"`
+	Conditions     []common.Condition `json:"conditions"`
+}
+
+// AttachedRoute is a route referencing a Gateway as its parent.
+type AttachedRoute struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+
+	// SectionName is the listener the route attaches to, empty for all listeners.
+	SectionName string `json:"sectionName,omitempty"`
+
+	// Accepted is the status of the Accepted condition reported for the Gateway in the route status.
+	Accepted v1.ConditionStatus `json:"accepted"`
+}
+
+// GetGatewayList returns Gateways from namespaces matching the query.
+func GetGatewayList(client kubernetes.Interface, dynamicClient dynamic.Interface, nsQuery *common.NamespaceQuery,
+	dsQuery *dataselect.DataSelectQuery) (*GatewayList, error) {
+	klog.V(4).Info("Getting list of gateways")
+	result := &GatewayList{Items: make([]Gateway, 0), Errors: make([]error, 0)}
+
+	installed, err := common.CheckInstalled(client, gatewayResource)
+	if err != nil || !installed {
+		return result, err
+	}
+
+	result.Installed = true
+	gateways, nonCriticalErrors, err := list[gateway](dynamicClient, gatewayResource, nsQuery.ToRequestParam())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]Gateway, 0, len(gateways))
+	for i := range gateways {
+		if nsQuery.Matches(gateways[i].Namespace) {
+			items = append(items, toGateway(&gateways[i]))
+		}
+	}
+
+	result.Errors = nonCriticalErrors
+	result.Items, result.ListMeta.TotalItems = selectItems(items, func(g Gateway) types.ObjectMeta { return g.ObjectMeta }, dsQuery)
+	return result, nil
+}
+
+// GetGatewayDetail returns the Gateway with the status of its listeners and the routes attached to it.
+func GetGatewayDetail(client kubernetes.Interface, dynamicClient dynamic.Interface, namespace, name string) (*GatewayDetail, error) {
+	klog.V(4).Infof("Getting details of %s gateway in %s namespace", name, namespace)
+	if err := common.RequireInstalled(client, gatewayResource); err != nil {
+		return nil, err
+	}
+
+	g, err := get[gateway](dynamicClient, gatewayResource, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &GatewayDetail{
+		Gateway:    toGateway(g),
+		Conditions: toConditions(g.Status.Conditions),
+		Listeners:  toListeners(g),
+		Routes:     make([]AttachedRoute, 0),
+		Errors:     make([]error, 0),
+	}
+
+	for _, kind := range []types.ResourceKind{types.ResourceKindHTTPRoute, types.ResourceKindGRPCRoute} {
+		installed, err := common.CheckInstalled(client, routeResources[kind])
+		if err != nil {
+			return nil, err
+		}
+
+		if !installed {
+			continue
+		}
+
+		// Routes may attach to the Gateway from other namespaces.
+		routes, nonCriticalErrors, err := listInAllNamespaces[route](dynamicClient, routeResources[kind], namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Errors = append(result.Errors, nonCriticalErrors...)
+		for i := range routes {
+			result.Routes = append(result.Routes, getAttachedRoutes(&routes[i], kind, g)...)
+		}
+	}
+
+	return result, nil
+}
+
+func getAttachedRoutes(r *route, kind types.ResourceKind, g *gateway) []AttachedRoute {
+	result := make([]AttachedRoute, 0)
+	for _, ref := range r.Spec.ParentRefs {
+		if !refersToGateway(ref, r.Namespace, g) {
+			continue
+		}
+
+		attached := AttachedRoute{
+			ObjectMeta:  types.NewObjectMeta(r.ObjectMeta),
+			TypeMeta:    types.NewTypeMeta(kind),
+			SectionName: stringOrDefault(ref.SectionName, ""),
+			Accepted:    v1.ConditionUnknown,
+		}
+
+		for _, parent := range r.Status.Parents {
+			if refersToGateway(parent.ParentRef, r.Namespace, g) &&
+				stringOrDefault(parent.ParentRef.SectionName, "") == attached.SectionName {
+				attached.Accepted = conditionStatus(parent.Conditions, "Accepted")
+			}
+		}
+
+		result = append(result, attached)
+	}
+
+	return result
+}
+
+// refersToGateway returns whether the parent reference of a route from the namespace points to the Gateway.
+func refersToGateway(ref parentReference, routeNamespace string, g *gateway) bool {
+	return stringOrDefault(ref.Group, Group) == Group &&
+		stringOrDefault(ref.Kind, "Gateway") == "Gateway" &&
+		stringOrDefault(ref.Namespace, routeNamespace) == g.Namespace &&
+		ref.Name == g.Name
+}
+
+func toGateway(g *gateway) Gateway {
+	addresses := make([]string, 0, len(g.Status.Addresses))
+	for _, address := range g.Status.Addresses {
+		addresses = append(addresses, address.Value)
+	}
+
+	return Gateway{
+		ObjectMeta:       types.NewObjectMeta(g.ObjectMeta),
+		TypeMeta:         types.NewTypeMeta(types.ResourceKindGateway),
+		GatewayClassName: g.Spec.GatewayClassName,
+		Addresses:        addresses,
+		Accepted:         conditionStatus(g.Status.Conditions, "Accepted"),
+		Programmed:       conditionStatus(g.Status.Conditions, "Programmed"),
+	}
+}
+
+func toListeners(g *gateway) []Listener {
+	statuses := make(map[string]listenerStatus, len(g.Status.Listeners))
+	for _, status := range g.Status.Listeners {
+		statuses[status.Name] = status
+	}
+
+	result := make([]Listener, 0, len(g.Spec.Listeners))
+	for _, l := range g.Spec.Listeners {
+		status := statuses[l.Name]
+		result = append(result, Listener{
+			Name:           l.Name,
+			Hostname:       stringOrDefault(l.Hostname, ""),
+			Port:           l.Port,
+			Protocol:       l.Protocol,
+			AttachedRoutes: status.AttachedRoutes,
+			Conditions:     toConditions(status.Conditions),
+		})
+	}
+
+	return result
+}
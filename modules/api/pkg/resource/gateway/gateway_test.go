@@ -0,0 +1,318 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/types"
+)
+
+func newTestClients(t *testing.T, installed bool, objects ...map[string]interface{}) (*fake.Clientset, *dynamicfake.FakeDynamicClient) {
+	client := fake.NewSimpleClientset(
+		&v1.Service{
+			ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "web"},
+			Spec:       v1.ServiceSpec{Ports: []v1.ServicePort{{Port: 80}}},
+		},
+		&v1.Service{
+			ObjectMeta: metaV1.ObjectMeta{Namespace: "backend", Name: "api"},
+			Spec:       v1.ServiceSpec{Ports: []v1.ServicePort{{Port: 8080}}},
+		},
+		&v1.Service{
+			ObjectMeta: metaV1.ObjectMeta{Namespace: "other", Name: "api"},
+			Spec:       v1.ServiceSpec{Ports: []v1.ServicePort{{Port: 8080}}},
+		},
+	)
+
+	if installed {
+		client.Resources = []*metaV1.APIResourceList{
+			{
+				GroupVersion: Group + "/v1",
+				APIResources: []metaV1.APIResource{
+					{Name: "gatewayclasses", Kind: "GatewayClass"},
+					{Name: "gateways", Kind: "Gateway", Namespaced: true},
+					{Name: "httproutes", Kind: "HTTPRoute", Namespaced: true},
+				},
+			},
+			{
+				GroupVersion: Group + "/v1beta1",
+				APIResources: []metaV1.APIResource{{Name: "referencegrants", Kind: "ReferenceGrant", Namespaced: true}},
+			},
+		}
+	}
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		gatewayClassResource:   "GatewayClassList",
+		gatewayResource:        "GatewayList",
+		httpRouteResource:      "HTTPRouteList",
+		grpcRouteResource:      "GRPCRouteList",
+		referenceGrantResource: "ReferenceGrantList",
+	})
+
+	// Objects are created with explicit resources, as the fake tracker would guess "gatewaies" for Gateway.
+	resources := map[string]schema.GroupVersionResource{
+		"GatewayClass":   gatewayClassResource,
+		"Gateway":        gatewayResource,
+		"HTTPRoute":      httpRouteResource,
+		"GRPCRoute":      grpcRouteResource,
+		"ReferenceGrant": referenceGrantResource,
+	}
+
+	for _, obj := range objects {
+		u := &unstructured.Unstructured{Object: obj}
+		if _, err := dynamicClient.Resource(resources[u.GetKind()]).Namespace(u.GetNamespace()).
+			Create(context.TODO(), u, metaV1.CreateOptions{}); err != nil {
+			t.Fatalf("Create() returned error: %v", err)
+		}
+	}
+
+	return client, dynamicClient
+}
+
+func newTestObject(version, kind, namespace, name string, spec, status map[string]interface{}) map[string]interface{} {
+	metadata := map[string]interface{}{"name": name}
+	if namespace != "" {
+		metadata["namespace"] = namespace
+	}
+
+	return map[string]interface{}{
+		"apiVersion": Group + "/" + version,
+		"kind":       kind,
+		"metadata":   metadata,
+		"spec":       spec,
+		"status":     status,
+	}
+}
+
+func newTestCondition(conditionType, status string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":               conditionType,
+		"status":             status,
+		"reason":             conditionType,
+		"message":            "",
+		"lastTransitionTime": "2024-01-01T00:00:00Z",
+	}
+}
+
+func newTestGateway() map[string]interface{} {
+	return newTestObject("v1", "Gateway", "default", "public",
+		map[string]interface{}{
+			"gatewayClassName": "example",
+			"listeners": []interface{}{
+				map[string]interface{}{"name": "http", "port": int64(80), "protocol": "HTTP"},
+				map[string]interface{}{"name": "https", "port": int64(443), "protocol": "HTTPS", "hostname": "example.com"},
+			},
+		},
+		map[string]interface{}{
+			"addresses":  []interface{}{map[string]interface{}{"value": "10.0.0.1"}},
+			"conditions": []interface{}{newTestCondition("Accepted", "True"), newTestCondition("Programmed", "False")},
+			"listeners": []interface{}{
+				map[string]interface{}{
+					"name":           "http",
+					"attachedRoutes": int64(2),
+					"supportedKinds": []interface{}{},
+					"conditions":     []interface{}{newTestCondition("Accepted", "True")},
+				},
+			},
+		})
+}
+
+func newTestRoute(namespace, name string, backendRefs ...interface{}) map[string]interface{} {
+	return newTestObject("v1", "HTTPRoute", namespace, name,
+		map[string]interface{}{
+			"parentRefs": []interface{}{
+				map[string]interface{}{"name": "public", "namespace": "default", "sectionName": "http"},
+			},
+			"hostnames": []interface{}{"example.com"},
+			"rules":     []interface{}{map[string]interface{}{"backendRefs": backendRefs}},
+		},
+		map[string]interface{}{
+			"parents": []interface{}{
+				map[string]interface{}{
+					"parentRef":      map[string]interface{}{"name": "public", "namespace": "default", "sectionName": "http"},
+					"controllerName": "example.com/gateway",
+					"conditions":     []interface{}{newTestCondition("Accepted", "True")},
+				},
+			},
+		})
+}
+
+func newTestBackendRef(namespace, name string, port int64) map[string]interface{} {
+	ref := map[string]interface{}{"name": name, "port": port}
+	if namespace != "" {
+		ref["namespace"] = namespace
+	}
+
+	return ref
+}
+
+func TestGetGatewayListNotInstalled(t *testing.T) {
+	client, dynamicClient := newTestClients(t, false)
+
+	gateways, err := GetGatewayList(client, dynamicClient, common.NewNamespaceQuery(nil), dataselect.NoDataSelect)
+	if err != nil || gateways.Installed || len(gateways.Items) != 0 {
+		t.Errorf("GetGatewayList() = %+v, %v, expected empty list without CRDs", gateways, err)
+	}
+
+	routes, err := GetRouteList(client, dynamicClient, types.ResourceKindHTTPRoute, common.NewNamespaceQuery(nil), dataselect.NoDataSelect)
+	if err != nil || routes.Installed || len(routes.Items) != 0 {
+		t.Errorf("GetRouteList() = %+v, %v, expected empty list without CRDs", routes, err)
+	}
+
+	if _, err := GetGatewayDetail(client, dynamicClient, "default", "public"); err == nil {
+		t.Error("GetGatewayDetail() should fail without CRDs")
+	}
+}
+
+func TestGetGatewayDetail(t *testing.T) {
+	client, dynamicClient := newTestClients(t, true,
+		newTestGateway(),
+		newTestRoute("default", "web", newTestBackendRef("", "web", 80)),
+		newTestRoute("backend", "api", newTestBackendRef("", "api", 8080)),
+	)
+
+	detail, err := GetGatewayDetail(client, dynamicClient, "default", "public")
+	if err != nil {
+		t.Fatalf("GetGatewayDetail() returned error: %v", err)
+	}
+
+	if detail.Accepted != v1.ConditionTrue || detail.Programmed != v1.ConditionFalse ||
+		len(detail.Addresses) != 1 || detail.Addresses[0] != "10.0.0.1" {
+		t.Errorf("unexpected gateway status %+v", detail.Gateway)
+	}
+
+	if len(detail.Listeners) != 2 || detail.Listeners[0].AttachedRoutes != 2 || len(detail.Listeners[0].Conditions) != 1 ||
+		detail.Listeners[1].Hostname != "example.com" || len(detail.Listeners[1].Conditions) != 0 {
+		t.Errorf("unexpected listeners %+v", detail.Listeners)
+	}
+
+	if len(detail.Routes) != 2 {
+		t.Fatalf("expected two attached routes, got %+v", detail.Routes)
+	}
+
+	for _, r := range detail.Routes {
+		if r.SectionName != "http" || r.Accepted != v1.ConditionTrue || r.TypeMeta.Kind != types.ResourceKindHTTPRoute {
+			t.Errorf("unexpected attached route %+v", r)
+		}
+	}
+}
+
+func TestGetRouteDetail(t *testing.T) {
+	toOther := newTestObject("v1beta1", "ReferenceGrant", "other", "routes", map[string]interface{}{
+		"from": []interface{}{map[string]interface{}{"group": Group, "kind": "HTTPRoute", "namespace": "default"}},
+		"to":   []interface{}{map[string]interface{}{"group": "", "kind": "Service"}},
+	}, nil)
+
+	client, dynamicClient := newTestClients(t, true,
+		toOther,
+		newTestRoute("default", "web",
+			newTestBackendRef("", "web", 80),
+			newTestBackendRef("", "web", 443),
+			newTestBackendRef("", "missing", 80),
+			newTestBackendRef("backend", "api", 8080),
+			newTestBackendRef("other", "api", 8080),
+			map[string]interface{}{"group": "example.com", "kind": "Bucket", "name": "assets"},
+		),
+	)
+
+	detail, err := GetRouteDetail(client, dynamicClient, types.ResourceKindHTTPRoute, "default", "web")
+	if err != nil {
+		t.Fatalf("GetRouteDetail() returned error: %v", err)
+	}
+
+	if len(detail.Parents) != 1 || detail.Parents[0].ParentRef.Kind != "Gateway" || len(detail.Parents[0].Conditions) != 1 {
+		t.Errorf("unexpected parents %+v", detail.Parents)
+	}
+
+	expected := []struct {
+		resolved bool
+		reason   string
+	}{
+		{true, ""},
+		{false, BackendReasonBackendNotFound},
+		{false, BackendReasonBackendNotFound},
+		{false, BackendReasonRefNotPermitted},
+		{true, ""},
+		{false, BackendReasonInvalidKind},
+	}
+
+	if len(detail.Backends) != len(expected) {
+		t.Fatalf("expected %d backends, got %+v", len(expected), detail.Backends)
+	}
+
+	for i, e := range expected {
+		backend := detail.Backends[i]
+		if backend.Resolved != e.resolved || backend.Reason != e.reason || backend.Weight != 1 {
+			t.Errorf("backend %d: expected resolved %t with reason %q, got %+v", i, e.resolved, e.reason, backend)
+		}
+	}
+
+	if _, err := GetRouteDetail(client, dynamicClient, types.ResourceKindGRPCRoute, "default", "web"); err == nil {
+		t.Error("GetRouteDetail() should fail for GRPCRoute without CRD")
+	}
+}
+
+func TestGetServiceRouteList(t *testing.T) {
+	client, dynamicClient := newTestClients(t, true,
+		newTestRoute("default", "web", newTestBackendRef("", "web", 80)),
+		newTestRoute("default", "api", newTestBackendRef("backend", "api", 8080)),
+		newTestRoute("backend", "api", newTestBackendRef("", "api", 8080)),
+	)
+
+	routes, err := GetServiceRouteList(client, dynamicClient, "backend", "api", dataselect.NoDataSelect)
+	if err != nil {
+		t.Fatalf("GetServiceRouteList() returned error: %v", err)
+	}
+
+	if !routes.Installed || routes.ListMeta.TotalItems != 2 {
+		t.Errorf("expected two routes referencing backend/api, got %+v", routes)
+	}
+
+	for _, r := range routes.Items {
+		if r.ObjectMeta.Name != "api" {
+			t.Errorf("unexpected route %+v", r)
+		}
+	}
+}
+
+func TestGetGatewayClassDetail(t *testing.T) {
+	client, dynamicClient := newTestClients(t, true,
+		newTestObject("v1", "GatewayClass", "", "example",
+			map[string]interface{}{"controllerName": "example.com/gateway"},
+			map[string]interface{}{"conditions": []interface{}{newTestCondition("Accepted", "True")}}),
+		newTestGateway(),
+	)
+
+	detail, err := GetGatewayClassDetail(client, dynamicClient, "example")
+	if err != nil {
+		t.Fatalf("GetGatewayClassDetail() returned error: %v", err)
+	}
+
+	if detail.Accepted != v1.ConditionTrue || detail.ControllerName != "example.com/gateway" ||
+		len(detail.Gateways) != 1 || detail.Gateways[0].ObjectMeta.Name != "public" {
+		t.Errorf("unexpected gateway class %+v", detail)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/types"
+)
+
+// GatewayClassList contains Gateway classes in the cluster.
+type GatewayClassList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Installed is false when the GatewayClass CRD is not installed. The list is empty then.
+	Installed bool `json:"installed"`
+
+	Items []GatewayClass `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GatewayClass is a presentation layer view of gateway.networking.k8s.io/v1 GatewayClass.
+type GatewayClass struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+
+	ControllerName string `json:"controllerName"`
+	Description    string `json:"description,omitempty"`
+
+	// Accepted is the status of the Accepted condition set by the controller.
+	Accepted v1.ConditionStatus `json:"accepted"`
+}
+
+// GatewayClassDetail provides the presentation layer view of GatewayClass with its status and
+// the Gateways using it.
+type GatewayClassDetail struct {
+	// Extends list item structure.
+	GatewayClass `json:",inline"`
+
+	Conditions []common.Condition `json:"conditions"`
+	Gateways   []Gateway          `json:"gateways"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetGatewayClassList returns all Gateway classes.
+func GetGatewayClassList(client kubernetes.Interface, dynamicClient dynamic.Interface,
+	dsQuery *dataselect.DataSelectQuery) (*GatewayClassList, error) {
+	klog.V(4).Info("Getting list of gateway classes")
+	result := &GatewayClassList{Items: make([]GatewayClass, 0), Errors: make([]error, 0)}
+
+	installed, err := common.CheckInstalled(client, gatewayClassResource)
+	if err != nil || !installed {
+		return result, err
+	}
+
+	result.Installed = true
+	classes, nonCriticalErrors, err := list[gatewayClass](dynamicClient, gatewayClassResource, metaV1.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]GatewayClass, 0, len(classes))
+	for i := range classes {
+		items = append(items, toGatewayClass(&classes[i]))
+	}
+
+	result.Errors = nonCriticalErrors
+	result.Items, result.ListMeta.TotalItems = selectItems(items, func(c GatewayClass) types.ObjectMeta { return c.ObjectMeta }, dsQuery)
+	return result, nil
+}
+
+// GetGatewayClassDetail returns the Gateway class with Gateways using it.
+func GetGatewayClassDetail(client kubernetes.Interface, dynamicClient dynamic.Interface, name string) (*GatewayClassDetail, error) {
+	klog.V(4).Infof("Getting details of %s gateway class", name)
+	if err := common.RequireInstalled(client, gatewayClassResource); err != nil {
+		return nil, err
+	}
+
+	class, err := get[gatewayClass](dynamicClient, gatewayClassResource, "", name)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &GatewayClassDetail{
+		GatewayClass: toGatewayClass(class),
+		Conditions:   toConditions(class.Status.Conditions),
+		Gateways:     make([]Gateway, 0),
+		Errors:       make([]error, 0),
+	}
+
+	installed, err := common.CheckInstalled(client, gatewayResource)
+	if err != nil || !installed {
+		return result, err
+	}
+
+	gateways, nonCriticalErrors, err := list[gateway](dynamicClient, gatewayResource, metaV1.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Errors = nonCriticalErrors
+	for i := range gateways {
+		if gateways[i].Spec.GatewayClassName == name {
+			result.Gateways = append(result.Gateways, toGateway(&gateways[i]))
+		}
+	}
+
+	return result, nil
+}
+
+func toGatewayClass(class *gatewayClass) GatewayClass {
+	return GatewayClass{
+		ObjectMeta:     types.NewObjectMeta(class.ObjectMeta),
+		TypeMeta:       types.NewTypeMeta(types.ResourceKindGatewayClass),
+		ControllerName: class.Spec.ControllerName,
+		Description:    stringOrDefault(class.Spec.Description, ""),
+		Accepted:       conditionStatus(class.Status.Conditions, "Accepted"),
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/types"
+)
+
+// ReferenceGrantList contains reference grants in the cluster.
+type ReferenceGrantList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Installed is false when the ReferenceGrant CRD is not installed. The list is empty then.
+	Installed bool `json:"installed"`
+
+	Items []ReferenceGrant `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// ReferenceGrant is a presentation layer view of gateway.networking.k8s.io/v1beta1 ReferenceGrant,
+// which allows references from resources in other namespaces to resources in its namespace.
+type ReferenceGrant struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+
+	From []ReferenceGrantFrom `json:"from"`
+	To   []ReferenceGrantTo   `json:"to"`
+}
+
+// ReferenceGrantFrom describes resources allowed to reference the resources of the grant.
+type ReferenceGrantFrom struct {
+	Group     string `json:"group"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+}
+
+// ReferenceGrantTo describes resources which may be referenced. Empty name allows all resources of the kind.
+type ReferenceGrantTo struct {
+	Group string  `json:"group"`
+	Kind  string  `json:"kind"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// GetReferenceGrantList returns reference grants from namespaces matching the query.
+func GetReferenceGrantList(client kubernetes.Interface, dynamicClient dynamic.Interface, nsQuery *common.NamespaceQuery,
+	dsQuery *dataselect.DataSelectQuery) (*ReferenceGrantList, error) {
+	klog.V(4).Info("Getting list of reference grants")
+	result := &ReferenceGrantList{Items: make([]ReferenceGrant, 0), Errors: make([]error, 0)}
+
+	installed, err := common.CheckInstalled(client, referenceGrantResource)
+	if err != nil || !installed {
+		return result, err
+	}
+
+	result.Installed = true
+	grants, nonCriticalErrors, err := list[referenceGrant](dynamicClient, referenceGrantResource, nsQuery.ToRequestParam())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]ReferenceGrant, 0, len(grants))
+	for i := range grants {
+		if nsQuery.Matches(grants[i].Namespace) {
+			items = append(items, toReferenceGrant(&grants[i]))
+		}
+	}
+
+	result.Errors = nonCriticalErrors
+	result.Items, result.ListMeta.TotalItems = selectItems(items, func(g ReferenceGrant) types.ObjectMeta { return g.ObjectMeta }, dsQuery)
+	return result, nil
+}
+
+// GetReferenceGrantDetail returns the reference grant.
+func GetReferenceGrantDetail(client kubernetes.Interface, dynamicClient dynamic.Interface, namespace, name string) (*ReferenceGrant, error) {
+	klog.V(4).Infof("Getting details of %s reference grant in %s namespace", name, namespace)
+	if err := common.RequireInstalled(client, referenceGrantResource); err != nil {
+		return nil, err
+	}
+
+	grant, err := get[referenceGrant](dynamicClient, referenceGrantResource, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	result := toReferenceGrant(grant)
+	return &result, nil
+}
+
+// permits returns whether the grant allows the reference from the kind in the namespace to the resource.
+func (in *referenceGrant) permits(fromKind, fromNamespace, toGroup, toKind, toName string) bool {
+	fromAllowed := false
+	for _, from := range in.Spec.From {
+		if from.Group == Group && from.Kind == fromKind && from.Namespace == fromNamespace {
+			fromAllowed = true
+			break
+		}
+	}
+
+	if !fromAllowed {
+		return false
+	}
+
+	for _, to := range in.Spec.To {
+		if to.Group == toGroup && to.Kind == toKind && (to.Name == nil || *to.Name == "" || *to.Name == toName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toReferenceGrant(grant *referenceGrant) ReferenceGrant {
+	result := ReferenceGrant{
+		ObjectMeta: types.NewObjectMeta(grant.ObjectMeta),
+		TypeMeta:   types.NewTypeMeta(types.ResourceKindReferenceGrant),
+		From:       grant.Spec.From,
+		To:         grant.Spec.To,
+	}
+
+	if result.From == nil {
+		result.From = make([]ReferenceGrantFrom, 0)
+	}
+
+	if result.To == nil {
+		result.To = make([]ReferenceGrantTo, 0)
+	}
+
+	return result
+}
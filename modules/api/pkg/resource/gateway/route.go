@@ -0,0 +1,236 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// RouteList contains HTTPRoutes or GRPCRoutes in the cluster.
+type RouteList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Installed is false when the route CRD is not installed. The list is empty then.
+	Installed bool `json:"installed"`
+
+	Items []Route `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// Route is a presentation layer view of gateway.networking.k8s.io/v1 HTTPRoute or GRPCRoute.
+// The kind of the route is given by its type meta.
+type Route struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+
+	Hostnames []string `json:"hostnames"`
+
+	// ParentRefs are Gateways the route attaches to.
+	ParentRefs []ParentReference `json:"parentRefs"`
+}
+
+// RouteDetail provides the presentation layer view of route with its status per parent and
+// backends resolved to Services.
+type RouteDetail struct {
+	// Extends list item structure.
+	Route `json:",inline"`
+
+	Parents  []RouteParentStatus `json:"parents"`
+	Backends []RouteBackend      `json:"backends"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// ParentReference identifies a parent of a route. Defaults of the Gateway API are applied to
+// group, kind and namespace.
+type ParentReference struct {
+	Group       string `json:"group"`
+	Kind        string `json:"kind"`
+	Namespace   string `json:"namespace"`
+	Name        string `json:"name"`
+	SectionName string `json:"sectionName,omitempty"`
+	Port        *int32 `json:"port,omitempty"`
+}
+
+// RouteParentStatus is the status of a route reported by the controller of one of its parents.
+type RouteParentStatus struct {
+	ParentRef      ParentReference    `json:"parentRef"`
+	ControllerName string             `json:"controllerName"`
+	Conditions     []common.Condition `json:"conditions"`
+}
+
+// GetRouteList returns routes of the kind from namespaces matching the query.
+func GetRouteList(client kubernetes.Interface, dynamicClient dynamic.Interface, kind types.ResourceKind,
+	nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*RouteList, error) {
+	klog.V(4).Infof("Getting list of %s", kind)
+	resource, ok := routeResources[kind]
+	if !ok {
+		return nil, errors.NewBadRequest(fmt.Sprintf("unsupported route kind %s", kind))
+	}
+
+	result := &RouteList{Items: make([]Route, 0), Errors: make([]error, 0)}
+	installed, err := common.CheckInstalled(client, resource)
+	if err != nil || !installed {
+		return result, err
+	}
+
+	result.Installed = true
+	routes, nonCriticalErrors, err := list[route](dynamicClient, resource, nsQuery.ToRequestParam())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]Route, 0, len(routes))
+	for i := range routes {
+		if nsQuery.Matches(routes[i].Namespace) {
+			items = append(items, toRoute(&routes[i], kind))
+		}
+	}
+
+	result.Errors = nonCriticalErrors
+	result.Items, result.ListMeta.TotalItems = selectItems(items, func(r Route) types.ObjectMeta { return r.ObjectMeta }, dsQuery)
+	return result, nil
+}
+
+// GetRouteDetail returns the route of the kind with its status per parent and resolved backends.
+func GetRouteDetail(client kubernetes.Interface, dynamicClient dynamic.Interface, kind types.ResourceKind,
+	namespace, name string) (*RouteDetail, error) {
+	klog.V(4).Infof("Getting details of %s %s in %s namespace", kind, name, namespace)
+	resource, ok := routeResources[kind]
+	if !ok {
+		return nil, errors.NewBadRequest(fmt.Sprintf("unsupported route kind %s", kind))
+	}
+
+	if err := common.RequireInstalled(client, resource); err != nil {
+		return nil, err
+	}
+
+	r, err := get[route](dynamicClient, resource, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	parents := make([]RouteParentStatus, 0, len(r.Status.Parents))
+	for _, parent := range r.Status.Parents {
+		parents = append(parents, RouteParentStatus{
+			ParentRef:      toParentReference(parent.ParentRef, namespace),
+			ControllerName: parent.ControllerName,
+			Conditions:     toConditions(parent.Conditions),
+		})
+	}
+
+	resolver := newBackendResolver(client, dynamicClient)
+	backends, err := resolver.resolve(r, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RouteDetail{
+		Route:    toRoute(r, kind),
+		Parents:  parents,
+		Backends: backends,
+		Errors:   resolver.errors,
+	}, nil
+}
+
+func toRoute(r *route, kind types.ResourceKind) Route {
+	parentRefs := make([]ParentReference, 0, len(r.Spec.ParentRefs))
+	for _, ref := range r.Spec.ParentRefs {
+		parentRefs = append(parentRefs, toParentReference(ref, r.Namespace))
+	}
+
+	hostnames := r.Spec.Hostnames
+	if hostnames == nil {
+		hostnames = make([]string, 0)
+	}
+
+	return Route{
+		ObjectMeta: types.NewObjectMeta(r.ObjectMeta),
+		TypeMeta:   types.NewTypeMeta(kind),
+		Hostnames:  hostnames,
+		ParentRefs: parentRefs,
+	}
+}
+
+func toParentReference(ref parentReference, routeNamespace string) ParentReference {
+	return ParentReference{
+		Group:       stringOrDefault(ref.Group, Group),
+		Kind:        stringOrDefault(ref.Kind, "Gateway"),
+		Namespace:   stringOrDefault(ref.Namespace, routeNamespace),
+		Name:        ref.Name,
+		SectionName: stringOrDefault(ref.SectionName, ""),
+		Port:        ref.Port,
+	}
+}
+
+// GetServiceRouteList returns HTTPRoutes and GRPCRoutes with backends referencing the Service.
+func GetServiceRouteList(client kubernetes.Interface, dynamicClient dynamic.Interface, namespace, serviceName string,
+	dsQuery *dataselect.DataSelectQuery) (*RouteList, error) {
+	klog.V(4).Infof("Getting list of routes for %s service in %s namespace", serviceName, namespace)
+	result := &RouteList{Items: make([]Route, 0), Errors: make([]error, 0)}
+
+	items := make([]Route, 0)
+	for _, kind := range []types.ResourceKind{types.ResourceKindHTTPRoute, types.ResourceKindGRPCRoute} {
+		installed, err := common.CheckInstalled(client, routeResources[kind])
+		if err != nil {
+			return nil, err
+		}
+
+		if !installed {
+			continue
+		}
+
+		// Routes may reference the Service from other namespaces.
+		result.Installed = true
+		routes, nonCriticalErrors, err := listInAllNamespaces[route](dynamicClient, routeResources[kind], namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Errors = append(result.Errors, nonCriticalErrors...)
+		for i := range routes {
+			if referencesService(&routes[i], namespace, serviceName) {
+				items = append(items, toRoute(&routes[i], kind))
+			}
+		}
+	}
+
+	result.Items, result.ListMeta.TotalItems = selectItems(items, func(r Route) types.ObjectMeta { return r.ObjectMeta }, dsQuery)
+	return result, nil
+}
+
+func referencesService(r *route, namespace, serviceName string) bool {
+	for _, rule := range r.Spec.Rules {
+		for _, ref := range rule.BackendRefs {
+			if isServiceReference(ref) && ref.Name == serviceName && stringOrDefault(ref.Namespace, r.Namespace) == namespace {
+				return true
+			}
+		}
+	}
+
+	return false
+}
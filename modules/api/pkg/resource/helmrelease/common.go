@@ -0,0 +1,207 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmrelease
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+)
+
+const (
+	// ReleaseSecretType is the type of Secrets that Helm 3 uses to store releases.
+	ReleaseSecretType v1.SecretType = "helm.sh/release.v1"
+
+	// releaseDataKey is the Secret data key holding the encoded release.
+	releaseDataKey = "release"
+
+	// Labels set by Helm on every release Secret.
+	ownerLabel      = "owner"
+	ownerLabelValue = "helm"
+	nameLabel       = "name"
+	statusLabel     = "status"
+	versionLabel    = "version"
+)
+
+// gzipMagic is the header of gzip compressed data. Helm compresses release data since 3.0,
+// but older records may still be stored as plain JSON.
+var gzipMagic = []byte{0x1f, 0x8b, 0x08}
+
+// release mirrors the parts of Helm's release.Release that are shown by Dashboard. Field names
+// follow the JSON encoding used by Helm storage drivers.
+type release struct {
+	Name      string                 `json:"name"`
+	Info      *releaseInfo           `json:"info,omitempty"`
+	Chart     *chart                 `json:"chart,omitempty"`
+	Config    map[string]interface{} `json:"config,omitempty"`
+	Manifest  string                 `json:"manifest,omitempty"`
+	Version   int                    `json:"version,omitempty"`
+	Namespace string                 `json:"namespace,omitempty"`
+}
+
+type releaseInfo struct {
+	FirstDeployed string `json:"first_deployed,omitempty"`
+	LastDeployed  string `json:"last_deployed,omitempty"`
+	Deleted       string `json:"deleted"`
+	Description   string `json:"description,omitempty"`
+	Status        string `json:"status,omitempty"`
+	Notes         string `json:"notes,omitempty"`
+}
+
+type chart struct {
+	Metadata *chartMetadata `json:"metadata"`
+}
+
+type chartMetadata struct {
+	Name        string `json:"name,omitempty"`
+	Version     string `json:"version,omitempty"`
+	AppVersion  string `json:"appVersion,omitempty"`
+	Description string `json:"description,omitempty"`
+	Icon        string `json:"icon,omitempty"`
+}
+
+// decodeRelease decodes release data as stored by Helm: base64 encoded, optionally gzip
+// compressed JSON.
+func decodeRelease(data []byte) (*release, error) {
+	raw, err := decodeReleaseData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	rls := new(release)
+	if err := json.Unmarshal(raw, rls); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal release: %w", err)
+	}
+
+	return rls, nil
+}
+
+func decodeReleaseData(data []byte) ([]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode release data: %w", err)
+	}
+
+	if !bytes.HasPrefix(decoded, gzipMagic) {
+		return decoded, nil
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(decoded))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress release data: %w", err)
+	}
+	defer reader.Close()
+
+	return io.ReadAll(reader)
+}
+
+// releaseFromSecret decodes the release stored in the given Helm release Secret.
+func releaseFromSecret(secret *v1.Secret) (*release, error) {
+	data, ok := secret.Data[releaseDataKey]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s does not contain release data", secret.Namespace, secret.Name)
+	}
+
+	rls, err := decodeRelease(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Helm does not always persist the namespace inside of the release body.
+	if len(rls.Namespace) == 0 {
+		rls.Namespace = secret.Namespace
+	}
+
+	return rls, nil
+}
+
+func isReleaseSecret(secret *v1.Secret) bool {
+	return secret.Type == ReleaseSecretType && secret.Labels[ownerLabel] == ownerLabelValue
+}
+
+// parseTime parses timestamps written by Helm. Helm writes zero time as an empty string.
+func parseTime(value string) metaV1.Time {
+	if len(value) == 0 {
+		return metaV1.Time{}
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return metaV1.Time{}
+	}
+
+	return metaV1.NewTime(t)
+}
+
+func (in *release) chartMetadata() chartMetadata {
+	if in.Chart == nil || in.Chart.Metadata == nil {
+		return chartMetadata{}
+	}
+
+	return *in.Chart.Metadata
+}
+
+func (in *release) info() releaseInfo {
+	if in.Info == nil {
+		return releaseInfo{}
+	}
+
+	return *in.Info
+}
+
+// The code below allows to perform complex data section on []Release
+
+type ReleaseCell Release
+
+func (in ReleaseCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(in.ObjectMeta.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(in.ObjectMeta.CreationTimestamp.Time)
+	case dataselect.NamespaceProperty:
+		return dataselect.StdComparableString(in.ObjectMeta.Namespace)
+	case dataselect.StatusProperty:
+		return dataselect.StdComparableString(in.Status)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+func toCells(std []Release) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = ReleaseCell(std[i])
+	}
+	return cells
+}
+
+func fromCells(cells []dataselect.DataCell) []Release {
+	std := make([]Release, len(cells))
+	for i := range std {
+		std[i] = Release(cells[i].(ReleaseCell))
+	}
+	return std
+}
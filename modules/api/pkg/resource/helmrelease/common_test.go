@@ -0,0 +1,157 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmrelease
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testManifest = `---
+# Source: app/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: app
+---
+# Source: app/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+  namespace: other
+---
+# Source: app/templates/clusterrole.yaml
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: app
+`
+
+func encodeTestRelease(t *testing.T, rls *release, compress bool) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(rls)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if compress {
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		if _, err = writer.Write(data); err != nil {
+			t.Fatal(err)
+		}
+		if err = writer.Close(); err != nil {
+			t.Fatal(err)
+		}
+		data = buf.Bytes()
+	}
+
+	return []byte(base64.StdEncoding.EncodeToString(data))
+}
+
+func newTestRelease(name, namespace string, version int, status string, deployed time.Time) *release {
+	return &release{
+		Name:      name,
+		Namespace: namespace,
+		Version:   version,
+		Info: &releaseInfo{
+			FirstDeployed: deployed.Add(-time.Hour).Format(time.RFC3339Nano),
+			LastDeployed:  deployed.Format(time.RFC3339Nano),
+			Description:   "Upgrade complete",
+			Status:        status,
+			Notes:         "Thank you for installing app.",
+		},
+		Chart: &chart{Metadata: &chartMetadata{
+			Name:       "app",
+			Version:    fmt.Sprintf("1.0.%d", version),
+			AppVersion: "2.0.0",
+		}},
+		Config:   map[string]interface{}{"replicaCount": float64(version)},
+		Manifest: testManifest,
+	}
+}
+
+func newTestReleaseSecret(t *testing.T, rls *release) *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      fmt.Sprintf("sh.helm.release.v1.%s.v%d", rls.Name, rls.Version),
+			Namespace: rls.Namespace,
+			Labels: map[string]string{
+				ownerLabel:   ownerLabelValue,
+				nameLabel:    rls.Name,
+				statusLabel:  rls.Info.Status,
+				versionLabel: fmt.Sprint(rls.Version),
+			},
+		},
+		Type: ReleaseSecretType,
+		Data: map[string][]byte{releaseDataKey: encodeTestRelease(t, rls, true)},
+	}
+}
+
+func TestDecodeRelease(t *testing.T) {
+	deployed := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	expected := newTestRelease("app", "default", 1, "deployed", deployed)
+
+	for _, compress := range []bool{true, false} {
+		actual, err := decodeRelease(encodeTestRelease(t, expected, compress))
+		if err != nil {
+			t.Fatalf("decodeRelease(compress=%v) returned error: %v", compress, err)
+		}
+
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("decodeRelease(compress=%v) == \n%#v\nexpected \n%#v\n", compress, actual, expected)
+		}
+	}
+}
+
+func TestDecodeReleaseInvalidData(t *testing.T) {
+	if _, err := decodeRelease([]byte("not base64!")); err == nil {
+		t.Error("decodeRelease should return error for invalid base64 data")
+	}
+
+	if _, err := decodeRelease([]byte(base64.StdEncoding.EncodeToString([]byte("{")))); err == nil {
+		t.Error("decodeRelease should return error for invalid JSON data")
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	deployed := time.Date(2024, 1, 2, 3, 4, 5, 600, time.UTC)
+	cases := []struct {
+		value    string
+		expected metaV1.Time
+	}{
+		{"", metaV1.Time{}},
+		{"invalid", metaV1.Time{}},
+		{deployed.Format(time.RFC3339Nano), metaV1.NewTime(deployed)},
+	}
+
+	for _, c := range cases {
+		actual := parseTime(c.value)
+		if !actual.Equal(&c.expected) {
+			t.Errorf("parseTime(%q) == %v, expected %v", c.value, actual, c.expected)
+		}
+	}
+}
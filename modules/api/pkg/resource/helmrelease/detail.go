@@ -0,0 +1,173 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmrelease
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/errors"
+)
+
+// ReleaseDetail contains a single revision of a Helm release.
+type ReleaseDetail struct {
+	// Extends list item structure.
+	Release `json:",inline"`
+
+	// Description is a human-friendly description of the revision, e.g. "Install complete".
+	Description string `json:"description"`
+
+	// Notes are the rendered NOTES.txt of the chart.
+	Notes string `json:"notes"`
+
+	// ChartDescription is the description of the chart.
+	ChartDescription string `json:"chartDescription"`
+
+	// ChartIcon is the URL of the chart icon.
+	ChartIcon string `json:"chartIcon"`
+
+	// Values are the user-supplied values that were used to render this revision.
+	Values map[string]interface{} `json:"values"`
+
+	// Manifest is the rendered manifest of this revision.
+	Manifest string `json:"manifest"`
+
+	// Resources are references to the objects created from the manifest.
+	Resources []ReleaseResource `json:"resources"`
+
+	// History lists all stored revisions of the release, newest first.
+	History []ReleaseRevision `json:"history"`
+}
+
+// ReleaseRevision is a single entry of the release history.
+type ReleaseRevision struct {
+	Revision     int         `json:"revision"`
+	Updated      metaV1.Time `json:"updated"`
+	Status       string      `json:"status"`
+	Chart        string      `json:"chart"`
+	ChartVersion string      `json:"chartVersion"`
+	AppVersion   string      `json:"appVersion"`
+	Description  string      `json:"description"`
+}
+
+// GetReleaseDetail returns the latest revision of the release with given name.
+func GetReleaseDetail(client kubernetes.Interface, cfg *rest.Config, namespace, name string) (*ReleaseDetail, error) {
+	return GetReleaseRevisionDetail(client, cfg, namespace, name, 0)
+}
+
+// GetReleaseRevisionDetail returns the given revision of the release. Revision 0 means the latest
+// revision.
+func GetReleaseRevisionDetail(client kubernetes.Interface, cfg *rest.Config, namespace, name string, revision int) (*ReleaseDetail, error) {
+	mapper, err := newRESTMapper(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return getReleaseRevisionDetail(client, mapper, namespace, name, revision)
+}
+
+func getReleaseRevisionDetail(client kubernetes.Interface, mapper meta.RESTMapper, namespace, name string,
+	revision int) (*ReleaseDetail, error) {
+	klog.V(4).Infof("Getting details of %s Helm release in %s namespace", name, namespace)
+
+	history, err := getReleaseHistory(client, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	rls := findRevision(history, revision)
+	if rls == nil {
+		return nil, errors.NewNotFound(fmt.Sprintf("revision %d of release %s/%s not found", revision, namespace, name))
+	}
+
+	return toReleaseDetail(rls, history, mapper), nil
+}
+
+// getReleaseHistory returns all decodable revisions of the release sorted from the newest one.
+func getReleaseHistory(client kubernetes.Interface, namespace, name string) ([]*release, error) {
+	stored, err := getStoredHistory(client, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]*release, 0, len(stored))
+	for _, revision := range stored {
+		rls, err := revision.release()
+		if err != nil {
+			return nil, err
+		}
+
+		history = append(history, rls)
+	}
+
+	return history, nil
+}
+
+// findRevision returns the given revision from history sorted from the newest one. Revision 0
+// means the latest revision.
+func findRevision(history []*release, revision int) *release {
+	if revision == 0 && len(history) > 0 {
+		return history[0]
+	}
+
+	for _, rls := range history {
+		if rls.Version == revision {
+			return rls
+		}
+	}
+
+	return nil
+}
+
+func toReleaseDetail(rls *release, history []*release, mapper meta.RESTMapper) *ReleaseDetail {
+	metadata := rls.chartMetadata()
+	info := rls.info()
+
+	return &ReleaseDetail{
+		Release:          toRelease(rls),
+		Description:      info.Description,
+		Notes:            info.Notes,
+		ChartDescription: metadata.Description,
+		ChartIcon:        metadata.Icon,
+		Values:           rls.Config,
+		Manifest:         rls.Manifest,
+		Resources:        getReleaseResources(rls, mapper),
+		History:          toReleaseHistory(history),
+	}
+}
+
+func toReleaseHistory(history []*release) []ReleaseRevision {
+	result := make([]ReleaseRevision, 0, len(history))
+	for _, rls := range history {
+		metadata := rls.chartMetadata()
+		info := rls.info()
+		result = append(result, ReleaseRevision{
+			Revision:     rls.Version,
+			Updated:      parseTime(info.LastDeployed),
+			Status:       info.Status,
+			Chart:        metadata.Name,
+			ChartVersion: metadata.Version,
+			AppVersion:   metadata.AppVersion,
+			Description:  info.Description,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,134 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmrelease
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+func TestGetReleaseRevisionDetail(t *testing.T) {
+	deployed := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	corrupted := newTestReleaseSecret(t, newTestRelease("app", "default", 3, "pending-upgrade", deployed))
+	corrupted.Data[releaseDataKey] = []byte("corrupted")
+	client := fake.NewSimpleClientset(
+		newTestReleaseSecret(t, newTestRelease("app", "default", 1, "superseded", deployed)),
+		newTestReleaseSecret(t, newTestRelease("app", "default", 2, "deployed", deployed.Add(time.Hour))),
+		newTestReleaseSecret(t, newTestRelease("other", "default", 1, "deployed", deployed)),
+		corrupted,
+	)
+
+	cases := []struct {
+		revision         int
+		expectedRevision int
+		expectedValues   map[string]interface{}
+	}{
+		{0, 2, map[string]interface{}{"replicaCount": float64(2)}},
+		{1, 1, map[string]interface{}{"replicaCount": float64(1)}},
+	}
+
+	for _, c := range cases {
+		actual, err := getReleaseRevisionDetail(client, newTestRESTMapper(), "default", "app", c.revision)
+		if err != nil {
+			t.Fatalf("getReleaseRevisionDetail(%d) returned error: %v", c.revision, err)
+		}
+
+		if actual.Revision != c.expectedRevision {
+			t.Errorf("getReleaseRevisionDetail(%d) returned revision %d, expected %d", c.revision, actual.Revision, c.expectedRevision)
+		}
+
+		if !reflect.DeepEqual(actual.Values, c.expectedValues) {
+			t.Errorf("getReleaseRevisionDetail(%d) returned values %v, expected %v", c.revision, actual.Values, c.expectedValues)
+		}
+
+		if len(actual.History) != 2 || actual.History[0].Revision != 2 || actual.History[1].Revision != 1 {
+			t.Errorf("getReleaseRevisionDetail(%d) returned history %#v, expected revisions 2 and 1", c.revision, actual.History)
+		}
+	}
+
+	if _, err := getReleaseRevisionDetail(client, newTestRESTMapper(), "default", "app", 5); !errors.IsNotFound(err) {
+		t.Errorf("getReleaseRevisionDetail() should return not found error for unknown revision, got %v", err)
+	}
+
+	if _, err := getReleaseRevisionDetail(client, newTestRESTMapper(), "default", "missing", 0); !errors.IsNotFound(err) {
+		t.Errorf("getReleaseRevisionDetail() should return not found error for unknown release, got %v", err)
+	}
+}
+
+func TestGetReleaseResources(t *testing.T) {
+	rls := newTestRelease("app", "default", 1, "deployed", time.Now())
+	expected := []ReleaseResource{
+		{
+			ObjectMeta: types.ObjectMeta{Name: "app", Namespace: "default"},
+			TypeMeta:   types.NewTypeMeta(types.ResourceKindService),
+			APIVersion: "v1",
+		},
+		{
+			ObjectMeta: types.ObjectMeta{Name: "app", Namespace: "other"},
+			TypeMeta:   types.NewTypeMeta(types.ResourceKindDeployment),
+			APIVersion: "apps/v1",
+		},
+		{
+			ObjectMeta: types.ObjectMeta{Name: "app"},
+			TypeMeta:   types.NewTypeMeta(types.ResourceKindClusterRole),
+			APIVersion: "rbac.authorization.k8s.io/v1",
+		},
+	}
+
+	actual := getReleaseResources(rls, newTestRESTMapper())
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("getReleaseResources() == \n%#v\nexpected \n%#v\n", actual, expected)
+	}
+}
+
+func TestParseManifestScope(t *testing.T) {
+	manifest := `---
+apiVersion: example.com/v1
+kind: Tenant
+metadata:
+  name: cluster-scoped
+---
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: namespaced
+---
+apiVersion: example.com/v1
+kind: Unknown
+metadata:
+  name: unknown
+`
+	objects, err := parseManifest(manifest, "default", newTestRESTMapper())
+	if err != nil {
+		t.Fatalf("parseManifest() returned error: %v", err)
+	}
+
+	expected := map[string]string{"cluster-scoped": "", "namespaced": "default", "unknown": "default"}
+	for _, object := range objects {
+		if object.GetNamespace() != expected[object.GetName()] {
+			t.Errorf("parseManifest() set namespace %q of %s, expected %q", object.GetNamespace(), object.GetName(), expected[object.GetName()])
+		}
+	}
+
+	if len(objects) != len(expected) {
+		t.Errorf("parseManifest() returned %d objects, expected %d", len(objects), len(expected))
+	}
+}
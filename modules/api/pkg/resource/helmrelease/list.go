@@ -0,0 +1,186 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmrelease
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// ReleaseList is a response structure for a queried Helm release list.
+type ReleaseList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of releases. Each release is represented by its latest revision.
+	Releases []Release `json:"releases"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// Release is a single Helm release returned to the frontend.
+type Release struct {
+	// ObjectMeta holds the release name and namespace. Creation timestamp is the time of the first
+	// deployment of the release.
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+
+	// Chart is the name of the chart that was released.
+	Chart string `json:"chart"`
+
+	// ChartVersion is the version of the chart that was released.
+	ChartVersion string `json:"chartVersion"`
+
+	// AppVersion is the version of the application packaged by the chart.
+	AppVersion string `json:"appVersion"`
+
+	// Status is the Helm status of the release, e.g. deployed, failed or superseded.
+	Status string `json:"status"`
+
+	// Revision is the release revision number.
+	Revision int `json:"revision"`
+
+	// Updated is the time of the last deployment of this revision.
+	Updated metaV1.Time `json:"updated"`
+}
+
+// GetReleaseList returns the latest revision of every Helm release in the given namespaces.
+func GetReleaseList(client kubernetes.Interface, nsQuery *common.NamespaceQuery,
+	dsQuery *dataselect.DataSelectQuery) (*ReleaseList, error) {
+	klog.V(4).Infof("Getting list of Helm releases in %s namespace", nsQuery.ToRequestParam())
+
+	secrets, err := listReleaseSecrets(client, nsQuery.ToRequestParam(), labels.Everything())
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	var filtered []v1.Secret
+	for _, secret := range secrets {
+		if nsQuery.Matches(secret.Namespace) {
+			filtered = append(filtered, secret)
+		}
+	}
+
+	return toReleaseList(latestReleases(filtered), nonCriticalErrors, dsQuery), nil
+}
+
+// listReleaseSecrets lists Helm release Secrets from the given namespace that match the selector.
+func listReleaseSecrets(client kubernetes.Interface, namespace string, selector labels.Selector) ([]v1.Secret, error) {
+	requirement, err := labels.NewRequirement(ownerLabel, "=", []string{ownerLabelValue})
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := client.CoreV1().Secrets(namespace).List(context.TODO(), metaV1.ListOptions{
+		LabelSelector: selector.Add(*requirement).String(),
+		FieldSelector: fields.OneTermEqualSelector("type", string(ReleaseSecretType)).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]v1.Secret, 0, len(list.Items))
+	for _, secret := range list.Items {
+		if isReleaseSecret(&secret) {
+			result = append(result, secret)
+		}
+	}
+
+	return result, nil
+}
+
+// latestReleases decodes the newest revision of every release stored in given Secrets. Secrets
+// that cannot be decoded are skipped.
+func latestReleases(secrets []v1.Secret) []*release {
+	latest := make(map[string]*release)
+	order := make([]string, 0)
+
+	for i := range secrets {
+		rls, err := releaseFromSecret(&secrets[i])
+		if err != nil {
+			klog.V(3).InfoS("skipping undecodable Helm release secret", "namespace", secrets[i].Namespace, "name", secrets[i].Name, "error", err)
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%s", rls.Namespace, rls.Name)
+		current, exists := latest[key]
+		if !exists {
+			order = append(order, key)
+		}
+
+		if !exists || current.Version < rls.Version {
+			latest[key] = rls
+		}
+	}
+
+	result := make([]*release, 0, len(order))
+	for _, key := range order {
+		result = append(result, latest[key])
+	}
+
+	return result
+}
+
+func toReleaseList(releases []*release, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *ReleaseList {
+	result := &ReleaseList{
+		ListMeta: types.ListMeta{TotalItems: len(releases)},
+		Releases: make([]Release, 0),
+		Errors:   nonCriticalErrors,
+	}
+
+	items := make([]Release, 0, len(releases))
+	for _, rls := range releases {
+		items = append(items, toRelease(rls))
+	}
+
+	cells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(items), dsQuery)
+	result.Releases = append(result.Releases, fromCells(cells)...)
+	result.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+
+	return result
+}
+
+func toRelease(rls *release) Release {
+	metadata := rls.chartMetadata()
+	info := rls.info()
+
+	return Release{
+		ObjectMeta: types.ObjectMeta{
+			Name:              rls.Name,
+			Namespace:         rls.Namespace,
+			CreationTimestamp: parseTime(info.FirstDeployed),
+		},
+		TypeMeta:     types.NewTypeMeta(types.ResourceKindHelmRelease),
+		Chart:        metadata.Name,
+		ChartVersion: metadata.Version,
+		AppVersion:   metadata.AppVersion,
+		Status:       info.Status,
+		Revision:     rls.Version,
+		Updated:      parseTime(info.LastDeployed),
+	}
+}
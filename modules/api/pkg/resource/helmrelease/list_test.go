@@ -0,0 +1,114 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmrelease
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/types"
+)
+
+func TestGetReleaseList(t *testing.T) {
+	deployed := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	opaque := &v1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{Name: "opaque", Namespace: "default", Labels: map[string]string{ownerLabel: ownerLabelValue}},
+		Type:       v1.SecretTypeOpaque,
+	}
+
+	client := fake.NewSimpleClientset(
+		newTestReleaseSecret(t, newTestRelease("app", "default", 1, "superseded", deployed)),
+		newTestReleaseSecret(t, newTestRelease("app", "default", 2, "deployed", deployed.Add(time.Hour))),
+		newTestReleaseSecret(t, newTestRelease("db", "data", 1, "failed", deployed)),
+		opaque,
+	)
+
+	cases := []struct {
+		namespace *common.NamespaceQuery
+		expected  *ReleaseList
+	}{
+		{
+			common.NewSameNamespaceQuery("default"),
+			&ReleaseList{
+				ListMeta: types.ListMeta{TotalItems: 1},
+				Releases: []Release{{
+					ObjectMeta: types.ObjectMeta{
+						Name:              "app",
+						Namespace:         "default",
+						CreationTimestamp: metaV1.NewTime(deployed),
+					},
+					TypeMeta:     types.TypeMeta{Kind: types.ResourceKindHelmRelease},
+					Chart:        "app",
+					ChartVersion: "1.0.2",
+					AppVersion:   "2.0.0",
+					Status:       "deployed",
+					Revision:     2,
+					Updated:      metaV1.NewTime(deployed.Add(time.Hour)),
+				}},
+				Errors: []error{},
+			},
+		},
+		{
+			common.NewNamespaceQuery(nil),
+			&ReleaseList{
+				ListMeta: types.ListMeta{TotalItems: 2},
+				Errors:   []error{},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual, err := GetReleaseList(client, c.namespace, dataselect.NoDataSelect)
+		if err != nil {
+			t.Fatalf("GetReleaseList(%v) returned error: %v", c.namespace, err)
+		}
+
+		if c.expected.Releases == nil {
+			if actual.ListMeta != c.expected.ListMeta {
+				t.Errorf("GetReleaseList(%v) returned %d items, expected %d", c.namespace,
+					actual.ListMeta.TotalItems, c.expected.ListMeta.TotalItems)
+			}
+			continue
+		}
+
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("GetReleaseList(%v) == \n%#v\nexpected \n%#v\n", c.namespace, actual, c.expected)
+		}
+	}
+}
+
+func TestLatestReleasesSkipsInvalidSecrets(t *testing.T) {
+	deployed := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	secrets := []v1.Secret{
+		*newTestReleaseSecret(t, newTestRelease("app", "default", 3, "deployed", deployed)),
+		{
+			ObjectMeta: metaV1.ObjectMeta{Name: "broken", Namespace: "default"},
+			Type:       ReleaseSecretType,
+			Data:       map[string][]byte{releaseDataKey: []byte("broken")},
+		},
+	}
+
+	actual := latestReleases(secrets)
+	if len(actual) != 1 || actual[0].Version != 3 {
+		t.Errorf("latestReleases() == %#v, expected single release in revision 3", actual)
+	}
+}
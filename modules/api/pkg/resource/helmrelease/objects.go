@@ -0,0 +1,201 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmrelease
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// resourcePolicyAnnotation is the annotation used by charts to control what happens with an
+	// object when the release is uninstalled or the object is removed from the chart.
+	resourcePolicyAnnotation = "helm.sh/resource-policy"
+
+	// resourcePolicyKeep instructs to never delete the object.
+	resourcePolicyKeep = "keep"
+)
+
+// objectClient performs operations on live objects rendered in release manifests.
+type objectClient struct {
+	client dynamic.Interface
+	mapper meta.RESTMapper
+}
+
+func newObjectClient(cfg *rest.Config) (*objectClient, error) {
+	mapper, err := newRESTMapper(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &objectClient{client: dynamicClient, mapper: mapper}, nil
+}
+
+// newRESTMapper returns a mapper backed by the discovery API. Discovery is deferred until the
+// first mapping is requested.
+func newRESTMapper(cfg *rest.Config) (meta.RESTMapper, error) {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient)), nil
+}
+
+// resourceFor returns the dynamic resource interface for given object. Namespace of
+// cluster-scoped objects is cleared.
+func (in *objectClient) resourceFor(object *unstructured.Unstructured) (dynamic.ResourceInterface, error) {
+	gvk := object.GroupVersionKind()
+	mapping, err := in.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		object.SetNamespace("")
+		return in.client.Resource(mapping.Resource), nil
+	}
+
+	return in.client.Resource(mapping.Resource).Namespace(object.GetNamespace()), nil
+}
+
+// apply makes the live object match the modified object. Original is the object as it was
+// rendered in the currently deployed release and may be nil. Changes made to the live object
+// by other actors are preserved, same as Helm does with its three-way merge.
+func (in *objectClient) apply(original, modified *unstructured.Unstructured) error {
+	resource, err := in.resourceFor(modified)
+	if err != nil {
+		return err
+	}
+
+	live, err := resource.Get(context.TODO(), modified.GetName(), metaV1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		klog.V(2).InfoS("creating Helm release object", "kind", modified.GetKind(), "namespace", modified.GetNamespace(), "name", modified.GetName())
+		_, err = resource.Create(context.TODO(), modified, metaV1.CreateOptions{})
+		return err
+	}
+
+	if err != nil {
+		return err
+	}
+
+	patchType, patch, err := createThreeWayPatch(original, modified, live)
+	if err != nil {
+		return err
+	}
+
+	if string(patch) == "{}" {
+		klog.V(3).InfoS("Helm release object is up to date", "kind", modified.GetKind(), "namespace", modified.GetNamespace(), "name", modified.GetName())
+		return nil
+	}
+
+	klog.V(2).InfoS("patching Helm release object", "kind", modified.GetKind(), "namespace", modified.GetNamespace(), "name", modified.GetName(), "patch", string(patch))
+	_, err = resource.Patch(context.TODO(), modified.GetName(), patchType, patch, metaV1.PatchOptions{})
+	return err
+}
+
+// delete deletes the live object. Objects that are already gone are ignored.
+func (in *objectClient) delete(object *unstructured.Unstructured) error {
+	resource, err := in.resourceFor(object)
+	if err != nil {
+		return err
+	}
+
+	propagation := metaV1.DeletePropagationBackground
+	klog.V(2).InfoS("deleting Helm release object", "kind", object.GetKind(), "namespace", object.GetNamespace(), "name", object.GetName())
+	err = resource.Delete(context.TODO(), object.GetName(), metaV1.DeleteOptions{PropagationPolicy: &propagation})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// createThreeWayPatch creates a strategic merge patch for kinds known to the client scheme and
+// a JSON merge patch for all other kinds, e.g. custom resources.
+func createThreeWayPatch(original, modified, live *unstructured.Unstructured) (k8stypes.PatchType, []byte, error) {
+	modifiedData, err := modified.MarshalJSON()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to marshal modified data: %w", err)
+	}
+
+	liveData, err := live.MarshalJSON()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to marshal live data: %w", err)
+	}
+
+	originalData := []byte("{}")
+	if original != nil {
+		if originalData, err = original.MarshalJSON(); err != nil {
+			return "", nil, fmt.Errorf("failed to marshal original data: %w", err)
+		}
+	}
+
+	versionedObject, err := scheme.Scheme.New(modified.GroupVersionKind())
+	switch {
+	case runtime.IsNotRegisteredError(err):
+		patch, err := jsonmergepatch.CreateThreeWayJSONMergePatch(originalData, modifiedData, liveData)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed creating merge patch: %w", err)
+		}
+		return k8stypes.MergePatchType, patch, nil
+	case err != nil:
+		return "", nil, err
+	default:
+		patchMeta, err := strategicpatch.NewPatchMetaFromStruct(versionedObject)
+		if err != nil {
+			return "", nil, err
+		}
+
+		patch, err := strategicpatch.CreateThreeWayMergePatch(originalData, modifiedData, liveData, patchMeta, true)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed creating three way merge patch: %w", err)
+		}
+		return k8stypes.StrategicMergePatchType, patch, nil
+	}
+}
+
+// shouldKeep returns true when the object is annotated to be kept on uninstall.
+func shouldKeep(object *unstructured.Unstructured) bool {
+	return strings.ToLower(strings.TrimSpace(object.GetAnnotations()[resourcePolicyAnnotation])) == resourcePolicyKeep
+}
+
+// objectKey identifies an object in a manifest regardless of its version.
+func objectKey(object *unstructured.Unstructured) string {
+	gvk := object.GroupVersionKind()
+	return fmt.Sprintf("%s/%s/%s/%s", gvk.Group, gvk.Kind, object.GetNamespace(), object.GetName())
+}
@@ -0,0 +1,125 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmrelease
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/types"
+)
+
+// clusterScopedKinds lists well known kinds that are not namespaced. It is used to decide
+// whether an object lives in the release namespace when its kind is not served by the API
+// server, e.g. a custom resource whose definition was removed.
+var clusterScopedKinds = map[string]bool{
+	"APIService":                     true,
+	"ClusterRole":                    true,
+	"ClusterRoleBinding":             true,
+	"CSIDriver":                      true,
+	"CustomResourceDefinition":       true,
+	"IngressClass":                   true,
+	"MutatingWebhookConfiguration":   true,
+	"Namespace":                      true,
+	"Node":                           true,
+	"PersistentVolume":               true,
+	"PriorityClass":                  true,
+	"RuntimeClass":                   true,
+	"StorageClass":                   true,
+	"ValidatingWebhookConfiguration": true,
+}
+
+// ReleaseResource is a reference to a live object created by a release. It can be used to link
+// to the object, e.g. through the generic _raw endpoints.
+type ReleaseResource struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+
+	// APIVersion is the group version of the object as rendered in the manifest.
+	APIVersion string `json:"apiVersion"`
+}
+
+// getReleaseResources parses the release manifest and returns references to all objects in it.
+func getReleaseResources(rls *release, mapper meta.RESTMapper) []ReleaseResource {
+	objects, err := parseManifest(rls.Manifest, rls.Namespace, mapper)
+	if err != nil {
+		klog.V(3).InfoS("could not parse Helm release manifest", "namespace", rls.Namespace, "name", rls.Name, "error", err)
+	}
+
+	result := make([]ReleaseResource, 0, len(objects))
+	for _, object := range objects {
+		result = append(result, toReleaseResource(object))
+	}
+
+	return result
+}
+
+// parseManifest splits a multi-document manifest into objects. Helm does not set the namespace of
+// rendered objects, so objects of namespaced kinds that do not specify a namespace get the
+// default one. Objects parsed before an error occurred are returned along with the error.
+func parseManifest(manifest, defaultNamespace string, mapper meta.RESTMapper) ([]*unstructured.Unstructured, error) {
+	result := make([]*unstructured.Unstructured, 0)
+	decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(manifest), 4096)
+
+	for {
+		object := &unstructured.Unstructured{}
+		if err := decoder.Decode(&object.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				return result, nil
+			}
+			return result, err
+		}
+
+		// Skip empty documents, e.g. templates that rendered to comments only.
+		if len(object.Object) == 0 || len(object.GetKind()) == 0 {
+			continue
+		}
+
+		if len(object.GetNamespace()) == 0 && isNamespaced(mapper, object.GroupVersionKind()) {
+			object.SetNamespace(defaultNamespace)
+		}
+
+		result = append(result, object)
+	}
+}
+
+// isNamespaced checks the scope of the kind with the mapper. Kinds unknown to the mapper fall
+// back to the list of well known cluster-scoped kinds.
+func isNamespaced(mapper meta.RESTMapper, gvk schema.GroupVersionKind) bool {
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return !clusterScopedKinds[gvk.Kind]
+	}
+
+	return mapping.Scope.Name() == meta.RESTScopeNameNamespace
+}
+
+func toReleaseResource(object *unstructured.Unstructured) ReleaseResource {
+	return ReleaseResource{
+		ObjectMeta: types.ObjectMeta{
+			Name:      object.GetName(),
+			Namespace: object.GetNamespace(),
+		},
+		TypeMeta:   types.NewTypeMeta(types.ResourceKind(strings.ToLower(object.GetKind()))),
+		APIVersion: object.GetAPIVersion(),
+	}
+}
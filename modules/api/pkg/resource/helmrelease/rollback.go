@@ -0,0 +1,178 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmrelease
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/errors"
+)
+
+// RollbackSpec describes a rollback of a release to one of its previous revisions.
+type RollbackSpec struct {
+	// Revision to roll back to. 0 means the revision before the latest one.
+	Revision int `json:"revision"`
+}
+
+// RollbackRelease rolls the release back to given revision. Same as with Helm, a new revision
+// that is a copy of the target one is recorded in the release history and objects of the target
+// manifest are applied to the cluster. Chart hooks are not executed.
+func RollbackRelease(client kubernetes.Interface, cfg *rest.Config, namespace, name string,
+	spec *RollbackSpec) (*ReleaseDetail, error) {
+	objects, err := newObjectClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return rollbackRelease(client, objects, namespace, name, spec)
+}
+
+func rollbackRelease(client kubernetes.Interface, objects *objectClient, namespace, name string,
+	spec *RollbackSpec) (*ReleaseDetail, error) {
+	klog.V(2).InfoS("rolling back Helm release", "namespace", namespace, "name", name, "revision", spec.Revision)
+
+	history, err := getStoredHistory(client, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	current := history[0]
+	revision := spec.Revision
+	if revision == 0 {
+		revision = current.version() - 1
+	}
+
+	if revision < 1 {
+		return nil, errors.NewBadRequest(fmt.Sprintf("release %s/%s has no previous revision to roll back to", namespace, name))
+	}
+
+	var target *storedRelease
+	for _, stored := range history {
+		if stored.version() == revision {
+			target = stored
+			break
+		}
+	}
+
+	if target == nil {
+		return nil, errors.NewNotFound(fmt.Sprintf("revision %d of release %s/%s not found", revision, namespace, name))
+	}
+
+	currentRelease, err := current.release()
+	if err != nil {
+		return nil, err
+	}
+
+	targetRelease, err := target.release()
+	if err != nil {
+		return nil, err
+	}
+
+	rolledBack, err := target.deepCopy()
+	if err != nil {
+		return nil, err
+	}
+
+	info := rolledBack.info()
+	info["first_deployed"] = currentRelease.info().FirstDeployed
+	info["last_deployed"] = time.Now().Format(time.RFC3339Nano)
+	info["deleted"] = ""
+	rolledBack.setStatus(StatusPendingRollback, fmt.Sprintf("Rollback to %d", revision))
+
+	rolledBack, err = createReleaseRecord(client, namespace, name, current.version()+1, rolledBack)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyManifest(objects, currentRelease, targetRelease); err != nil {
+		current.setStatus(StatusSuperseded, "")
+		rolledBack.setStatus(StatusFailed, fmt.Sprintf("Rollback %q failed: %s", name, err))
+		for _, stored := range []*storedRelease{current, rolledBack} {
+			if updateErr := updateReleaseRecord(client, stored); updateErr != nil {
+				klog.ErrorS(updateErr, "could not record failed rollback", "namespace", namespace, "name", name)
+			}
+		}
+		return nil, err
+	}
+
+	for _, stored := range history {
+		if status, _ := stored.info()["status"].(string); status != StatusDeployed {
+			continue
+		}
+
+		stored.setStatus(StatusSuperseded, "")
+		if err := updateReleaseRecord(client, stored); err != nil {
+			return nil, err
+		}
+	}
+
+	rolledBack.setStatus(StatusDeployed, "")
+	if err := updateReleaseRecord(client, rolledBack); err != nil {
+		return nil, err
+	}
+
+	return getReleaseRevisionDetail(client, objects.mapper, namespace, name, 0)
+}
+
+// applyManifest makes the cluster match the target release. Objects that are present in the
+// current release but not in the target one are deleted unless they should be kept.
+func applyManifest(objects *objectClient, current, target *release) error {
+	currentObjects, err := parseManifest(current.Manifest, current.Namespace, objects.mapper)
+	if err != nil {
+		return err
+	}
+
+	targetObjects, err := parseManifest(target.Manifest, target.Namespace, objects.mapper)
+	if err != nil {
+		return err
+	}
+
+	originals := make(map[string]int, len(currentObjects))
+	for i, object := range currentObjects {
+		originals[objectKey(object)] = i
+	}
+
+	for _, object := range targetObjects {
+		key := objectKey(object)
+		var original *unstructured.Unstructured
+		if i, exists := originals[key]; exists {
+			original = currentObjects[i]
+			delete(originals, key)
+		}
+
+		if err := objects.apply(original, object); err != nil {
+			return err
+		}
+	}
+
+	for i := len(currentObjects) - 1; i >= 0; i-- {
+		object := currentObjects[i]
+		if _, removed := originals[objectKey(object)]; !removed || shouldKeep(object) {
+			continue
+		}
+
+		if err := objects.delete(object); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
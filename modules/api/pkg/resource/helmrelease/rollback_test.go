@@ -0,0 +1,200 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmrelease
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s.io/dashboard/errors"
+)
+
+var (
+	configMapGVR = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	serviceGVR   = schema.GroupVersionResource{Version: "v1", Resource: "services"}
+	widgetGVR    = schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+)
+
+const rollbackTargetManifest = `---
+apiVersion: v1
+kind: Service
+metadata:
+  name: app
+---
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: app
+spec:
+  size: 1
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: app-v1
+`
+
+const rollbackCurrentManifest = `---
+apiVersion: v1
+kind: Service
+metadata:
+  name: app
+---
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: app
+spec:
+  size: 2
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: app-v2
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: app-keep
+  annotations:
+    helm.sh/resource-policy: keep
+`
+
+func newTestObject(apiVersion, kind, namespace, name string, spec map[string]interface{}) *unstructured.Unstructured {
+	object := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata":   map[string]interface{}{"name": name, "namespace": namespace},
+	}}
+
+	if spec != nil {
+		object.Object["spec"] = spec
+	}
+
+	return object
+}
+
+func newTestRESTMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Service"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Tenant"}, meta.RESTScopeRoot)
+	return mapper
+}
+
+func newTestObjectClient(objects ...runtime.Object) *objectClient {
+	mapper := newTestRESTMapper()
+
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		configMapGVR: "ConfigMapList",
+		serviceGVR:   "ServiceList",
+		widgetGVR:    "WidgetList",
+	}, objects...)
+
+	return &objectClient{client: client, mapper: mapper}
+}
+
+func TestRollbackRelease(t *testing.T) {
+	deployed := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	target := newTestRelease("app", "default", 1, StatusSuperseded, deployed)
+	target.Manifest = rollbackTargetManifest
+	current := newTestRelease("app", "default", 2, StatusDeployed, deployed.Add(time.Hour))
+	current.Manifest = rollbackCurrentManifest
+
+	client := fake.NewSimpleClientset(newTestReleaseSecret(t, target), newTestReleaseSecret(t, current))
+
+	liveWidget := newTestObject("example.com/v1", "Widget", "default", "app", map[string]interface{}{
+		"size":  int64(2),
+		"owner": "someone-else",
+	})
+	objects := newTestObjectClient(
+		newTestObject("v1", "Service", "default", "app", nil),
+		liveWidget,
+		newTestObject("v1", "ConfigMap", "default", "app-v2", nil),
+		newTestObject("v1", "ConfigMap", "default", "app-keep", nil),
+	)
+
+	actual, err := rollbackRelease(client, objects, "default", "app", &RollbackSpec{})
+	if err != nil {
+		t.Fatalf("rollbackRelease() returned error: %v", err)
+	}
+
+	if actual.Revision != 3 || actual.Status != StatusDeployed || actual.Description != "Rollback to 1" {
+		t.Errorf("rollbackRelease() returned revision %d with status %q and description %q, expected revision 3 "+
+			"deployed with description \"Rollback to 1\"", actual.Revision, actual.Status, actual.Description)
+	}
+
+	if actual.Values["replicaCount"] != float64(1) {
+		t.Errorf("rollbackRelease() returned values %v, expected values of revision 1", actual.Values)
+	}
+
+	expectedStatuses := map[int]string{3: StatusDeployed, 2: StatusSuperseded, 1: StatusSuperseded}
+	for _, revision := range actual.History {
+		if expectedStatuses[revision.Revision] != revision.Status {
+			t.Errorf("revision %d has status %q, expected %q", revision.Revision, revision.Status, expectedStatuses[revision.Revision])
+		}
+	}
+
+	secret, err := client.CoreV1().Secrets("default").Get(context.TODO(), "sh.helm.release.v1.app.v3", metaV1.GetOptions{})
+	if err != nil {
+		t.Fatalf("rollbackRelease() should record revision 3: %v", err)
+	}
+
+	if secret.Labels[statusLabel] != StatusDeployed || secret.Labels[versionLabel] != "3" {
+		t.Errorf("rollbackRelease() recorded secret with labels %v", secret.Labels)
+	}
+
+	widget, err := objects.client.Resource(widgetGVR).Namespace("default").Get(context.TODO(), "app", metaV1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spec, _, _ := unstructured.NestedMap(widget.Object, "spec")
+	if spec["size"] != int64(1) || spec["owner"] != "someone-else" {
+		t.Errorf("rollbackRelease() should restore size and keep foreign changes, got spec %v", spec)
+	}
+
+	configMaps := objects.client.Resource(configMapGVR).Namespace("default")
+	for name, shouldExist := range map[string]bool{"app-v1": true, "app-v2": false, "app-keep": true} {
+		_, err := configMaps.Get(context.TODO(), name, metaV1.GetOptions{})
+		if exists := err == nil; exists != shouldExist {
+			t.Errorf("config map %s exists: %v, expected %v (error: %v)", name, exists, shouldExist, err)
+		}
+	}
+}
+
+func TestRollbackReleaseErrors(t *testing.T) {
+	deployed := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	client := fake.NewSimpleClientset(newTestReleaseSecret(t, newTestRelease("app", "default", 1, StatusDeployed, deployed)))
+	objects := newTestObjectClient()
+
+	if _, err := rollbackRelease(client, objects, "default", "app", &RollbackSpec{}); err == nil {
+		t.Error("rollbackRelease() should fail for release without previous revision")
+	}
+
+	if _, err := rollbackRelease(client, objects, "default", "app", &RollbackSpec{Revision: 7}); !errors.IsNotFound(err) {
+		t.Errorf("rollbackRelease() should return not found error for unknown revision, got %v", err)
+	}
+}
@@ -0,0 +1,284 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmrelease
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/errors"
+)
+
+// Release statuses as defined by Helm.
+const (
+	StatusDeployed        = "deployed"
+	StatusUninstalling    = "uninstalling"
+	StatusSuperseded      = "superseded"
+	StatusFailed          = "failed"
+	StatusPendingRollback = "pending-rollback"
+)
+
+const (
+	// Labels set by Helm on release Secrets next to the owner, name, status and version labels.
+	createdAtLabel  = "createdAt"
+	modifiedAtLabel = "modifiedAt"
+)
+
+// storedRelease is a single revision of a release as persisted in a Secret. The release body is
+// kept as a generic map, so that fields not known to Dashboard, e.g. chart templates or hooks,
+// are preserved when the release is written back.
+type storedRelease struct {
+	secret *v1.Secret
+	body   map[string]interface{}
+}
+
+// releaseSecretName returns the name of the Secret that stores given release revision.
+func releaseSecretName(name string, version int) string {
+	return fmt.Sprintf("sh.helm.release.v1.%s.v%d", name, version)
+}
+
+// encodeRelease encodes given release the same way as Helm storage drivers do: gzip compressed
+// JSON encoded with base64.
+func encodeRelease(rls interface{}) ([]byte, error) {
+	data, err := json.Marshal(rls)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal release: %w", err)
+	}
+
+	var buf bytes.Buffer
+	writer, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = writer.Write(data); err != nil {
+		return nil, err
+	}
+
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return []byte(base64.StdEncoding.EncodeToString(buf.Bytes())), nil
+}
+
+func storedReleaseFromSecret(secret *v1.Secret) (*storedRelease, error) {
+	data, ok := secret.Data[releaseDataKey]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s does not contain release data", secret.Namespace, secret.Name)
+	}
+
+	raw, err := decodeReleaseData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	body := make(map[string]interface{})
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal release: %w", err)
+	}
+
+	return &storedRelease{secret: secret, body: body}, nil
+}
+
+// release returns the typed view of the stored release.
+func (in *storedRelease) release() (*release, error) {
+	data, err := json.Marshal(in.body)
+	if err != nil {
+		return nil, err
+	}
+
+	rls := new(release)
+	if err := json.Unmarshal(data, rls); err != nil {
+		return nil, err
+	}
+
+	if len(rls.Namespace) == 0 {
+		rls.Namespace = in.secret.Namespace
+	}
+
+	return rls, nil
+}
+
+func (in *storedRelease) version() int {
+	switch version := in.body["version"].(type) {
+	case int:
+		return version
+	case float64:
+		// Numbers are decoded as float64 from generic JSON.
+		return int(version)
+	default:
+		return 0
+	}
+}
+
+func (in *storedRelease) info() map[string]interface{} {
+	info, ok := in.body["info"].(map[string]interface{})
+	if !ok {
+		info = make(map[string]interface{})
+		in.body["info"] = info
+	}
+
+	return info
+}
+
+func (in *storedRelease) setStatus(status, description string) {
+	info := in.info()
+	info["status"] = status
+	if len(description) > 0 {
+		info["description"] = description
+	}
+}
+
+// deepCopy returns a copy of the stored release that is not backed by any Secret.
+func (in *storedRelease) deepCopy() (*storedRelease, error) {
+	data, err := json.Marshal(in.body)
+	if err != nil {
+		return nil, err
+	}
+
+	body := make(map[string]interface{})
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, err
+	}
+
+	return &storedRelease{secret: in.secret.DeepCopy(), body: body}, nil
+}
+
+// getStoredHistory returns all decodable revisions of the release sorted from the newest one.
+// Secrets that cannot be decoded are skipped, the same as in the release list.
+func getStoredHistory(client kubernetes.Interface, namespace, name string) ([]*storedRelease, error) {
+	requirement, err := labels.NewRequirement(nameLabel, selection.Equals, []string{name})
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := listReleaseSecrets(client, namespace, labels.NewSelector().Add(*requirement))
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]*storedRelease, 0, len(secrets))
+	for i := range secrets {
+		stored, err := storedReleaseFromSecret(&secrets[i])
+		if err != nil {
+			klog.V(3).InfoS("skipping undecodable Helm release secret", "namespace", secrets[i].Namespace, "name", secrets[i].Name, "error", err)
+			continue
+		}
+
+		if releaseName, _ := stored.body["name"].(string); releaseName == name {
+			history = append(history, stored)
+		}
+	}
+
+	if len(history) == 0 {
+		return nil, errors.NewNotFound(fmt.Sprintf("release %s/%s not found", namespace, name))
+	}
+
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].version() > history[j].version()
+	})
+
+	return history, nil
+}
+
+// createReleaseRecord stores a new release revision.
+func createReleaseRecord(client kubernetes.Interface, namespace, name string, version int,
+	stored *storedRelease) (*storedRelease, error) {
+	stored.body["version"] = version
+	secret, err := newReleaseSecret(namespace, name, version, stored)
+	if err != nil {
+		return nil, err
+	}
+
+	secret.Labels[createdAtLabel] = strconv.FormatInt(time.Now().Unix(), 10)
+	created, err := client.CoreV1().Secrets(namespace).Create(context.TODO(), secret, metaV1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	stored.secret = created
+	return stored, nil
+}
+
+// updateReleaseRecord writes the release body and status back to its Secret.
+func updateReleaseRecord(client kubernetes.Interface, stored *storedRelease) error {
+	version, err := strconv.Atoi(stored.secret.Labels[versionLabel])
+	if err != nil {
+		return fmt.Errorf("invalid version label on secret %s/%s: %w", stored.secret.Namespace, stored.secret.Name, err)
+	}
+
+	secret, err := newReleaseSecret(stored.secret.Namespace, stored.secret.Labels[nameLabel], version, stored)
+	if err != nil {
+		return err
+	}
+
+	secret.ObjectMeta.ResourceVersion = stored.secret.ResourceVersion
+	secret.Labels[modifiedAtLabel] = strconv.FormatInt(time.Now().Unix(), 10)
+	updated, err := client.CoreV1().Secrets(secret.Namespace).Update(context.TODO(), secret, metaV1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	stored.secret = updated
+	return nil
+}
+
+// newReleaseSecret builds the Secret for given release revision. Custom labels of the Secret
+// the release was read from are preserved.
+func newReleaseSecret(namespace, name string, version int, stored *storedRelease) (*v1.Secret, error) {
+	data, err := encodeRelease(stored.body)
+	if err != nil {
+		return nil, err
+	}
+
+	secretLabels := make(map[string]string)
+	if stored.secret != nil {
+		for key, value := range stored.secret.Labels {
+			secretLabels[key] = value
+		}
+	}
+
+	delete(secretLabels, createdAtLabel)
+	delete(secretLabels, modifiedAtLabel)
+	secretLabels[ownerLabel] = ownerLabelValue
+	secretLabels[nameLabel] = name
+	secretLabels[statusLabel], _ = stored.info()["status"].(string)
+	secretLabels[versionLabel] = strconv.Itoa(version)
+
+	return &v1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      releaseSecretName(name, version),
+			Namespace: namespace,
+			Labels:    secretLabels,
+		},
+		Type: ReleaseSecretType,
+		Data: map[string][]byte{releaseDataKey: data},
+	}, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmrelease
+
+import (
+	"context"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+)
+
+// UninstallResult describes the outcome of a release uninstall.
+type UninstallResult struct {
+	// Deleted are the objects that were deleted together with the release.
+	Deleted []ReleaseResource `json:"deleted"`
+
+	// Kept are the objects that were left in the cluster because of the
+	// "helm.sh/resource-policy: keep" annotation.
+	Kept []ReleaseResource `json:"kept"`
+}
+
+// UninstallRelease deletes objects created by the release and removes the release history, the
+// same way "helm uninstall" does. Chart hooks are not executed.
+func UninstallRelease(client kubernetes.Interface, cfg *rest.Config, namespace, name string) (*UninstallResult, error) {
+	objects, err := newObjectClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return uninstallRelease(client, objects, namespace, name)
+}
+
+func uninstallRelease(client kubernetes.Interface, objects *objectClient, namespace, name string) (*UninstallResult, error) {
+	klog.V(2).InfoS("uninstalling Helm release", "namespace", namespace, "name", name)
+
+	history, err := getStoredHistory(client, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := history[0]
+	rls, err := latest.release()
+	if err != nil {
+		return nil, err
+	}
+
+	latest.setStatus(StatusUninstalling, "Deletion in progress (or silently failed)")
+	if err := updateReleaseRecord(client, latest); err != nil {
+		return nil, err
+	}
+
+	manifestObjects, err := parseManifest(rls.Manifest, rls.Namespace, objects.mapper)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &UninstallResult{
+		Deleted: make([]ReleaseResource, 0, len(manifestObjects)),
+		Kept:    make([]ReleaseResource, 0),
+	}
+
+	// Objects are deleted in reverse order of the manifest, which Helm sorts in install order.
+	for i := len(manifestObjects) - 1; i >= 0; i-- {
+		object := manifestObjects[i]
+		if shouldKeep(object) {
+			result.Kept = append(result.Kept, toReleaseResource(object))
+			continue
+		}
+
+		if err := objects.delete(object); err != nil {
+			return nil, err
+		}
+
+		result.Deleted = append(result.Deleted, toReleaseResource(object))
+	}
+
+	for _, stored := range history {
+		err := client.CoreV1().Secrets(namespace).Delete(context.TODO(), stored.secret.Name, metaV1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
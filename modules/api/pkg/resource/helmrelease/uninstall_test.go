@@ -0,0 +1,109 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmrelease
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+const uninstallManifest = `---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: data
+  annotations:
+    helm.sh/resource-policy: keep
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: app
+`
+
+func TestUninstallRelease(t *testing.T) {
+	deployed := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	previous := newTestRelease("app", "default", 1, StatusSuperseded, deployed)
+	latest := newTestRelease("app", "default", 2, StatusDeployed, deployed.Add(time.Hour))
+	latest.Manifest = uninstallManifest
+	other := newTestRelease("other", "default", 1, StatusDeployed, deployed)
+
+	client := fake.NewSimpleClientset(newTestReleaseSecret(t, previous), newTestReleaseSecret(t, latest),
+		newTestReleaseSecret(t, other))
+	// Service is already gone and should be skipped without error.
+	objects := newTestObjectClient(
+		newTestObject("v1", "ConfigMap", "default", "data", nil),
+		newTestObject("v1", "ConfigMap", "default", "config", nil),
+	)
+
+	actual, err := uninstallRelease(client, objects, "default", "app")
+	if err != nil {
+		t.Fatalf("uninstallRelease() returned error: %v", err)
+	}
+
+	expected := &UninstallResult{
+		Deleted: []ReleaseResource{
+			{
+				ObjectMeta: types.ObjectMeta{Name: "app", Namespace: "default"},
+				TypeMeta:   types.NewTypeMeta(types.ResourceKindService),
+				APIVersion: "v1",
+			},
+			{
+				ObjectMeta: types.ObjectMeta{Name: "config", Namespace: "default"},
+				TypeMeta:   types.NewTypeMeta(types.ResourceKindConfigMap),
+				APIVersion: "v1",
+			},
+		},
+		Kept: []ReleaseResource{{
+			ObjectMeta: types.ObjectMeta{Name: "data", Namespace: "default"},
+			TypeMeta:   types.NewTypeMeta(types.ResourceKindConfigMap),
+			APIVersion: "v1",
+		}},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("uninstallRelease() == \n%#v\nexpected \n%#v\n", actual, expected)
+	}
+
+	configMaps := objects.client.Resource(configMapGVR).Namespace("default")
+	if _, err := configMaps.Get(context.TODO(), "data", metaV1.GetOptions{}); err != nil {
+		t.Errorf("uninstallRelease() should keep annotated config map: %v", err)
+	}
+
+	if _, err := configMaps.Get(context.TODO(), "config", metaV1.GetOptions{}); err == nil {
+		t.Error("uninstallRelease() should delete config map")
+	}
+
+	if _, err := getReleaseRevisionDetail(client, newTestRESTMapper(), "default", "app", 0); !errors.IsNotFound(err) {
+		t.Errorf("uninstallRelease() should delete release history, got %v", err)
+	}
+
+	if _, err := getReleaseRevisionDetail(client, newTestRESTMapper(), "default", "other", 0); err != nil {
+		t.Errorf("uninstallRelease() should not touch other releases: %v", err)
+	}
+}
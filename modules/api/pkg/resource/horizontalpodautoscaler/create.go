@@ -0,0 +1,180 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horizontalpodautoscaler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	autoscaling "k8s.io/api/autoscaling/v2"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	client "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/event"
+	"k8s.io/dashboard/errors"
+)
+
+// defaultScaleTargetAPIVersions are used when the scale target reference has no API version.
+var defaultScaleTargetAPIVersions = map[string]string{
+	"Deployment":            "apps/v1",
+	"ReplicaSet":            "apps/v1",
+	"StatefulSet":           "apps/v1",
+	"ReplicationController": "v1",
+}
+
+// HorizontalPodAutoscalerSpec is a specification of a horizontal pod autoscaler to create or update.
+type HorizontalPodAutoscalerSpec struct {
+	// Name is only used when the autoscaler is created.
+	Name string `json:"name,omitempty"`
+
+	// ScaleTargetRef points to any workload supporting the scale subresource. API version can be
+	// omitted for built-in workloads.
+	ScaleTargetRef autoscaling.CrossVersionObjectReference `json:"scaleTargetRef"`
+
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	MaxReplicas int32  `json:"maxReplicas"`
+
+	// Metrics to scale on. Defaults to 80% average CPU utilization when empty.
+	Metrics []autoscaling.MetricSpec `json:"metrics,omitempty"`
+
+	// Behavior configures scale up and scale down policies. Kubernetes defaults are used when empty.
+	Behavior *autoscaling.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+// CreateHorizontalPodAutoscaler creates the horizontal pod autoscaler in the namespace.
+func CreateHorizontalPodAutoscaler(client client.Interface, namespace string, spec *HorizontalPodAutoscalerSpec) (*HorizontalPodAutoscalerDetail, error) {
+	if len(spec.Name) == 0 {
+		return nil, errors.NewBadRequest("name is required")
+	}
+
+	if err := validateSpec(client, spec); err != nil {
+		return nil, err
+	}
+
+	klog.V(4).Infof("Creating %s horizontal pod autoscaler for %s %s", spec.Name, spec.ScaleTargetRef.Kind, spec.ScaleTargetRef.Name)
+	hpa := &autoscaling.HorizontalPodAutoscaler{ObjectMeta: metaV1.ObjectMeta{Namespace: namespace, Name: spec.Name}}
+	spec.apply(hpa)
+
+	hpa, err := client.AutoscalingV2().HorizontalPodAutoscalers(namespace).Create(context.TODO(), hpa, metaV1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return getHorizontalPodAutoscalerDetail(hpa, emptyEventList()), nil
+}
+
+// UpdateHorizontalPodAutoscaler replaces the scale target, replica bounds, metrics and behavior of
+// the horizontal pod autoscaler.
+func UpdateHorizontalPodAutoscaler(client client.Interface, namespace, name string, spec *HorizontalPodAutoscalerSpec) (*HorizontalPodAutoscalerDetail, error) {
+	if err := validateSpec(client, spec); err != nil {
+		return nil, err
+	}
+
+	klog.V(4).Infof("Updating %s horizontal pod autoscaler", name)
+	var result *autoscaling.HorizontalPodAutoscaler
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		hpa, err := client.AutoscalingV2().HorizontalPodAutoscalers(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		spec.apply(hpa)
+		result, err = client.AutoscalingV2().HorizontalPodAutoscalers(namespace).Update(context.TODO(), hpa, metaV1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return getHorizontalPodAutoscalerDetail(result, emptyEventList()), nil
+}
+
+func (in *HorizontalPodAutoscalerSpec) apply(hpa *autoscaling.HorizontalPodAutoscaler) {
+	hpa.Spec.ScaleTargetRef = in.ScaleTargetRef
+	hpa.Spec.MinReplicas = in.MinReplicas
+	hpa.Spec.MaxReplicas = in.MaxReplicas
+	hpa.Spec.Metrics = in.Metrics
+	hpa.Spec.Behavior = in.Behavior
+}
+
+func validateSpec(client client.Interface, spec *HorizontalPodAutoscalerSpec) error {
+	problems := make([]string, 0)
+	if len(spec.ScaleTargetRef.Kind) == 0 || len(spec.ScaleTargetRef.Name) == 0 {
+		problems = append(problems, "scale target kind and name are required")
+	}
+
+	if spec.MaxReplicas < 1 {
+		problems = append(problems, "maxReplicas must be at least 1")
+	}
+
+	if spec.MinReplicas != nil && (*spec.MinReplicas < 1 || *spec.MinReplicas > spec.MaxReplicas) {
+		problems = append(problems, "minReplicas must be between 1 and maxReplicas")
+	}
+
+	if len(problems) > 0 {
+		return errors.NewBadRequest(strings.Join(problems, "; "))
+	}
+
+	return validateScaleTarget(client, &spec.ScaleTargetRef)
+}
+
+// validateScaleTarget makes sure that the kind of the scale target supports the scale subresource,
+// defaulting the API version of built-in workloads.
+func validateScaleTarget(client client.Interface, ref *autoscaling.CrossVersionObjectReference) error {
+	if len(ref.APIVersion) == 0 {
+		ref.APIVersion = defaultScaleTargetAPIVersions[ref.Kind]
+	}
+
+	if len(ref.APIVersion) == 0 {
+		return errors.NewBadRequest(fmt.Sprintf("scale target apiVersion is required for kind %s", ref.Kind))
+	}
+
+	resources, err := client.Discovery().ServerResourcesForGroupVersion(ref.APIVersion)
+	if k8serrors.IsNotFound(err) {
+		return errors.NewBadRequest(fmt.Sprintf("API version %s is not served", ref.APIVersion))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	resource := ""
+	for _, r := range resources.APIResources {
+		if r.Kind == ref.Kind && !strings.Contains(r.Name, "/") {
+			resource = r.Name
+		}
+	}
+
+	if len(resource) == 0 {
+		return errors.NewBadRequest(fmt.Sprintf("kind %s is not served by %s", ref.Kind, ref.APIVersion))
+	}
+
+	for _, r := range resources.APIResources {
+		if r.Name == resource+"/scale" {
+			return nil
+		}
+	}
+
+	return errors.NewBadRequest(fmt.Sprintf("%s %s does not support the scale subresource", ref.APIVersion, ref.Kind))
+}
+
+func emptyEventList() common.EventList {
+	return event.CreateEventList(nil, recentEventsQuery)
+}
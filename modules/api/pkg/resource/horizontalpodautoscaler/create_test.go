@@ -0,0 +1,108 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horizontalpodautoscaler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+	autoscaling "k8s.io/api/autoscaling/v2"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newCreateTestClient() *fake.Clientset {
+	client := fake.NewSimpleClientset()
+	client.Resources = []*metaV1.APIResourceList{
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metaV1.APIResource{
+				{Name: "deployments", Kind: "Deployment"},
+				{Name: "deployments/scale", Kind: "Scale"},
+				{Name: "daemonsets", Kind: "DaemonSet"},
+			},
+		},
+		{
+			GroupVersion: "example.com/v1",
+			APIResources: []metaV1.APIResource{
+				{Name: "workers", Kind: "Worker"},
+				{Name: "workers/scale", Kind: "Scale"},
+			},
+		},
+	}
+
+	return client
+}
+
+func TestCreateHorizontalPodAutoscaler(t *testing.T) {
+	client := newCreateTestClient()
+
+	detail, err := CreateHorizontalPodAutoscaler(client, "default", &HorizontalPodAutoscalerSpec{
+		Name:           "web",
+		ScaleTargetRef: autoscaling.CrossVersionObjectReference{Kind: "Deployment", Name: "web"},
+		MinReplicas:    lo.ToPtr(int32(2)),
+		MaxReplicas:    5,
+	})
+	if err != nil {
+		t.Fatalf("CreateHorizontalPodAutoscaler() returned error: %v", err)
+	}
+
+	if detail.ObjectMeta.Name != "web" || detail.MaxReplicas != 5 {
+		t.Errorf("unexpected created autoscaler %+v", detail)
+	}
+
+	hpa, _ := client.AutoscalingV2().HorizontalPodAutoscalers("default").Get(context.TODO(), "web", metaV1.GetOptions{})
+	if hpa.Spec.ScaleTargetRef.APIVersion != "apps/v1" {
+		t.Errorf("expected defaulted scale target API version, got %+v", hpa.Spec.ScaleTargetRef)
+	}
+
+	detail, err = UpdateHorizontalPodAutoscaler(client, "default", "web", &HorizontalPodAutoscalerSpec{
+		ScaleTargetRef: autoscaling.CrossVersionObjectReference{Kind: "Worker", Name: "queue", APIVersion: "example.com/v1"},
+		MaxReplicas:    20,
+	})
+	if err != nil {
+		t.Fatalf("UpdateHorizontalPodAutoscaler() returned error: %v", err)
+	}
+
+	if detail.ScaleTargetRef.Kind != "Worker" || detail.MaxReplicas != 20 || detail.MinReplicas != nil {
+		t.Errorf("unexpected updated autoscaler %+v", detail)
+	}
+}
+
+func TestValidateSpec(t *testing.T) {
+	client := newCreateTestClient()
+	cases := []struct {
+		spec  HorizontalPodAutoscalerSpec
+		valid bool
+	}{
+		{HorizontalPodAutoscalerSpec{ScaleTargetRef: autoscaling.CrossVersionObjectReference{Kind: "Deployment", Name: "web"}, MaxReplicas: 1}, true},
+		{HorizontalPodAutoscalerSpec{ScaleTargetRef: autoscaling.CrossVersionObjectReference{Kind: "Deployment"}, MaxReplicas: 1}, false},
+		{HorizontalPodAutoscalerSpec{ScaleTargetRef: autoscaling.CrossVersionObjectReference{Kind: "Deployment", Name: "web"}}, false},
+		{HorizontalPodAutoscalerSpec{ScaleTargetRef: autoscaling.CrossVersionObjectReference{Kind: "Deployment", Name: "web"},
+			MinReplicas: lo.ToPtr(int32(3)), MaxReplicas: 2}, false},
+		{HorizontalPodAutoscalerSpec{ScaleTargetRef: autoscaling.CrossVersionObjectReference{Kind: "DaemonSet", Name: "agent", APIVersion: "apps/v1"},
+			MaxReplicas: 1}, false},
+		{HorizontalPodAutoscalerSpec{ScaleTargetRef: autoscaling.CrossVersionObjectReference{Kind: "Worker", Name: "queue"}, MaxReplicas: 1}, false},
+		{HorizontalPodAutoscalerSpec{ScaleTargetRef: autoscaling.CrossVersionObjectReference{Kind: "Worker", Name: "queue", APIVersion: "example.com/v2"},
+			MaxReplicas: 1}, false},
+	}
+
+	for _, c := range cases {
+		if err := validateSpec(client, &c.spec); (err == nil) != c.valid {
+			t.Errorf("validateSpec(%+v) returned %v, expected valid %t", c.spec, err, c.valid)
+		}
+	}
+}
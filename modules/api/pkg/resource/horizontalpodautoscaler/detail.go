@@ -17,10 +17,23 @@ package horizontalpodautoscaler
 import (
 	"context"
 
-	autoscaling "k8s.io/api/autoscaling/v1"
+	autoscaling "k8s.io/api/autoscaling/v2"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	client "k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/api/pkg/resource/event"
+	"k8s.io/dashboard/errors"
+)
+
+// recentEventsQuery selects the most recent events of the horizontal pod autoscaler.
+var recentEventsQuery = dataselect.NewDataSelectQuery(
+	dataselect.NewPaginationQuery(10, 0),
+	dataselect.NewSortQuery([]string{"d", string(dataselect.LastSeenProperty)}),
+	dataselect.NoFilter,
+	dataselect.NoMetrics,
 )
 
 // HorizontalPodAutoscalerDetail provides the presentation layer view of Kubernetes Horizontal Pod Autoscaler resource.
@@ -31,25 +44,86 @@ type HorizontalPodAutoscalerDetail struct {
 	CurrentReplicas int32    `json:"currentReplicas"`
 	DesiredReplicas int32    `json:"desiredReplicas"`
 	LastScaleTime   *v1.Time `json:"lastScaleTime"`
+
+	// Metrics the autoscaler scales on, with their targets and current values.
+	Metrics []MetricStatus `json:"metrics"`
+
+	// Behavior configures scale up and scale down policies and stabilization windows.
+	Behavior *autoscaling.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+
+	// Conditions describe the state of the autoscaler, e.g. whether scaling is limited.
+	Conditions []common.Condition `json:"conditions"`
+
+	// Events are the most recent events of the autoscaler, e.g. rescales.
+	Events common.EventList `json:"events"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
 }
 
 // GetHorizontalPodAutoscalerDetail returns detailed information about a horizontal pod autoscaler
 func GetHorizontalPodAutoscalerDetail(client client.Interface, namespace string, name string) (*HorizontalPodAutoscalerDetail, error) {
 	klog.V(4).Infof("Getting details of %s horizontal pod autoscaler", name)
 
-	rawHorizontalPodAutoscaler, err := client.AutoscalingV1().HorizontalPodAutoscalers(namespace).Get(context.TODO(), name, v1.GetOptions{})
+	rawHorizontalPodAutoscaler, err := client.AutoscalingV2().HorizontalPodAutoscalers(namespace).Get(context.TODO(), name, v1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	return getHorizontalPodAutoscalerDetail(rawHorizontalPodAutoscaler), nil
+	events, err := getEvents(client, namespace, name)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	detail := getHorizontalPodAutoscalerDetail(rawHorizontalPodAutoscaler, events)
+	detail.Errors = nonCriticalErrors
+	return detail, nil
+}
+
+// getEvents returns events of the horizontal pod autoscaler. Events are selected by the kind too,
+// as autoscalers are usually named the same as their scale targets.
+func getEvents(client client.Interface, namespace, name string) (common.EventList, error) {
+	events, err := event.GetEvents(client, namespace, name)
+	if err != nil {
+		return emptyEventList(), err
+	}
+
+	filtered := events[:0]
+	for _, e := range events {
+		if e.InvolvedObject.Kind == "HorizontalPodAutoscaler" && e.InvolvedObject.Name == name {
+			filtered = append(filtered, e)
+		}
+	}
+
+	return event.CreateEventList(filtered, recentEventsQuery), nil
 }
 
-func getHorizontalPodAutoscalerDetail(hpa *autoscaling.HorizontalPodAutoscaler) *HorizontalPodAutoscalerDetail {
+func getHorizontalPodAutoscalerDetail(hpa *autoscaling.HorizontalPodAutoscaler, events common.EventList) *HorizontalPodAutoscalerDetail {
 	return &HorizontalPodAutoscalerDetail{
-		HorizontalPodAutoscaler: toHorizontalPodAutoScaler(hpa),
+		HorizontalPodAutoscaler: toHorizontalPodAutoScalerV2(hpa),
 		CurrentReplicas:         hpa.Status.CurrentReplicas,
 		DesiredReplicas:         hpa.Status.DesiredReplicas,
 		LastScaleTime:           hpa.Status.LastScaleTime,
+		Metrics:                 toMetricStatuses(hpa.Spec.Metrics, hpa.Status.CurrentMetrics),
+		Behavior:                hpa.Spec.Behavior,
+		Conditions:              getConditions(hpa.Status.Conditions),
+		Events:                  events,
 	}
 }
+
+func getConditions(hpaConditions []autoscaling.HorizontalPodAutoscalerCondition) []common.Condition {
+	conditions := make([]common.Condition, 0)
+
+	for _, condition := range hpaConditions {
+		conditions = append(conditions, common.Condition{
+			Type:               string(condition.Type),
+			Status:             condition.Status,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastTransitionTime: condition.LastTransitionTime,
+		})
+	}
+
+	return conditions
+}
@@ -18,16 +18,43 @@ import (
 	"reflect"
 	"testing"
 
-	autoscaling "k8s.io/api/autoscaling/v1"
+	"github.com/samber/lo"
+	autoscaling "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 
+	"k8s.io/dashboard/api/pkg/resource/common"
 	"k8s.io/dashboard/types"
 )
 
 // func GetHorizontalPodAutoscalerDetail(client *client.Client, namespace string, name string) (*HorizontalPodAutoscalerDetail, error)
 
 func TestGetHorizontalPodAutoscalerDetail(t *testing.T) {
+	behavior := &autoscaling.HorizontalPodAutoscalerBehavior{
+		ScaleDown: &autoscaling.HPAScalingRules{
+			StabilizationWindowSeconds: lo.ToPtr(int32(300)),
+			Policies:                   []autoscaling.HPAScalingPolicy{{Type: autoscaling.PercentScalingPolicy, Value: 10, PeriodSeconds: 60}},
+		},
+	}
+	metrics := []autoscaling.MetricSpec{
+		{
+			Type: autoscaling.ResourceMetricSourceType,
+			Resource: &autoscaling.ResourceMetricSource{
+				Name:   v1.ResourceCPU,
+				Target: autoscaling.MetricTarget{Type: autoscaling.UtilizationMetricType, AverageUtilization: lo.ToPtr(int32(70))},
+			},
+		},
+		{
+			Type: autoscaling.ExternalMetricSourceType,
+			External: &autoscaling.ExternalMetricSource{
+				Metric: autoscaling.MetricIdentifier{Name: "queue_length"},
+				Target: autoscaling.MetricTarget{Type: autoscaling.AverageValueMetricType, AverageValue: lo.ToPtr(resource.MustParse("30"))},
+			},
+		},
+	}
+
 	cases := []struct {
 		namespace, name string
 		expectedActions []string
@@ -36,7 +63,7 @@ func TestGetHorizontalPodAutoscalerDetail(t *testing.T) {
 	}{
 		{
 			"test-ns", "test-name",
-			[]string{"get"},
+			[]string{"get", "list"},
 			&autoscaling.HorizontalPodAutoscaler{
 				ObjectMeta: metaV1.ObjectMeta{Name: "test-name", Namespace: "test-ns"},
 				Spec: autoscaling.HorizontalPodAutoscalerSpec{
@@ -63,12 +90,98 @@ func TestGetHorizontalPodAutoscalerDetail(t *testing.T) {
 				},
 				CurrentReplicas: 1,
 				DesiredReplicas: 2,
+				Metrics:         []MetricStatus{},
+				Conditions:      []common.Condition{},
+				Events:          common.EventList{Events: []common.Event{}},
+				Errors:          []error{},
+			},
+		},
+		{
+			"test-ns", "web",
+			[]string{"get", "list"},
+			&autoscaling.HorizontalPodAutoscaler{
+				ObjectMeta: metaV1.ObjectMeta{Name: "web", Namespace: "test-ns"},
+				Spec: autoscaling.HorizontalPodAutoscalerSpec{
+					ScaleTargetRef: autoscaling.CrossVersionObjectReference{Kind: "Deployment", Name: "web", APIVersion: "apps/v1"},
+					MinReplicas:    lo.ToPtr(int32(2)),
+					MaxReplicas:    10,
+					Metrics:        metrics,
+					Behavior:       behavior,
+				},
+				Status: autoscaling.HorizontalPodAutoscalerStatus{
+					CurrentReplicas: 10,
+					DesiredReplicas: 10,
+					CurrentMetrics: []autoscaling.MetricStatus{{
+						Type: autoscaling.ResourceMetricSourceType,
+						Resource: &autoscaling.ResourceMetricStatus{
+							Name:    v1.ResourceCPU,
+							Current: autoscaling.MetricValueStatus{AverageUtilization: lo.ToPtr(int32(95))},
+						},
+					}},
+					Conditions: []autoscaling.HorizontalPodAutoscalerCondition{
+						{Type: autoscaling.ScalingLimited, Status: v1.ConditionTrue, Reason: "TooManyReplicas"},
+					},
+				},
+			},
+			&HorizontalPodAutoscalerDetail{
+				HorizontalPodAutoscaler: HorizontalPodAutoscaler{
+					ObjectMeta:                      types.ObjectMeta{Name: "web", Namespace: "test-ns"},
+					TypeMeta:                        types.TypeMeta{Kind: types.ResourceKindHorizontalPodAutoscaler},
+					ScaleTargetRef:                  ScaleTargetRef{Kind: "Deployment", Name: "web"},
+					MinReplicas:                     lo.ToPtr(int32(2)),
+					MaxReplicas:                     10,
+					CurrentCPUUtilizationPercentage: lo.ToPtr(int32(95)),
+					TargetCPUUtilizationPercentage:  lo.ToPtr(int32(70)),
+				},
+				CurrentReplicas: 10,
+				DesiredReplicas: 10,
+				Metrics: []MetricStatus{
+					{
+						Type:    autoscaling.ResourceMetricSourceType,
+						Name:    "cpu",
+						Target:  metrics[0].Resource.Target,
+						Current: &autoscaling.MetricValueStatus{AverageUtilization: lo.ToPtr(int32(95))},
+					},
+					{
+						Type:   autoscaling.ExternalMetricSourceType,
+						Name:   "queue_length",
+						Target: metrics[1].External.Target,
+					},
+				},
+				Behavior: behavior,
+				Conditions: []common.Condition{
+					{Type: string(autoscaling.ScalingLimited), Status: v1.ConditionTrue, Reason: "TooManyReplicas"},
+				},
+				Events: common.EventList{
+					ListMeta: types.ListMeta{TotalItems: 1},
+					Events: []common.Event{{
+						ObjectMeta:         types.ObjectMeta{Name: "web.rescale", Namespace: "test-ns"},
+						TypeMeta:           types.TypeMeta{Kind: types.ResourceKindEvent},
+						Reason:             "SuccessfulRescale",
+						Type:               v1.EventTypeNormal,
+						SubObjectName:      "web",
+						SubObjectKind:      "HorizontalPodAutoscaler",
+						SubObjectNamespace: "test-ns",
+					}},
+				},
+				Errors: []error{},
 			},
 		},
 	}
 
 	for _, c := range cases {
-		fakeClient := fake.NewSimpleClientset(c.hpa)
+		fakeClient := fake.NewSimpleClientset(c.hpa,
+			&v1.Event{
+				ObjectMeta:     metaV1.ObjectMeta{Name: "web.rescale", Namespace: "test-ns"},
+				InvolvedObject: v1.ObjectReference{Kind: "HorizontalPodAutoscaler", Name: "web", Namespace: "test-ns"},
+				Reason:         "SuccessfulRescale",
+				Type:           v1.EventTypeNormal,
+			},
+			&v1.Event{
+				ObjectMeta:     metaV1.ObjectMeta{Name: "web.scaled", Namespace: "test-ns"},
+				InvolvedObject: v1.ObjectReference{Kind: "Deployment", Name: "web", Namespace: "test-ns"},
+				Reason:         "ScalingReplicaSet",
+			})
 
 		actual, _ := GetHorizontalPodAutoscalerDetail(fakeClient, c.namespace, c.name)
 
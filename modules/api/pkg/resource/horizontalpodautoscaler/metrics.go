@@ -0,0 +1,162 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horizontalpodautoscaler
+
+import (
+	"fmt"
+
+	autoscaling "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"k8s.io/dashboard/types"
+)
+
+// MetricStatus is a metric the autoscaler scales on, with its target and current value.
+type MetricStatus struct {
+	Type autoscaling.MetricSourceType `json:"type"`
+
+	// Name of the resource, e.g. "cpu", or of the metric.
+	Name string `json:"name"`
+
+	// Container is only set for container resource metrics.
+	Container string `json:"container,omitempty"`
+
+	// DescribedObject is only set for object metrics.
+	DescribedObject *autoscaling.CrossVersionObjectReference `json:"describedObject,omitempty"`
+
+	// Selector of pods, object and external metrics.
+	Selector *metaV1.LabelSelector `json:"selector,omitempty"`
+
+	Target autoscaling.MetricTarget `json:"target"`
+
+	// Current is not set until the autoscaler computes the metric.
+	Current *autoscaling.MetricValueStatus `json:"current,omitempty"`
+}
+
+func toMetricStatuses(specs []autoscaling.MetricSpec, statuses []autoscaling.MetricStatus) []MetricStatus {
+	current := make(map[string]autoscaling.MetricValueStatus)
+	for _, status := range statuses {
+		if key, value, ok := metricStatusValue(status); ok {
+			current[key] = value
+		}
+	}
+
+	result := make([]MetricStatus, 0, len(specs))
+	for _, spec := range specs {
+		metric, ok := toMetricStatus(spec)
+		if !ok {
+			continue
+		}
+
+		if value, exists := current[metric.key()]; exists {
+			metric.Current = &value
+		}
+
+		result = append(result, metric)
+	}
+
+	return result
+}
+
+func toMetricStatus(spec autoscaling.MetricSpec) (MetricStatus, bool) {
+	metric := MetricStatus{Type: spec.Type}
+	switch {
+	case spec.Type == autoscaling.ResourceMetricSourceType && spec.Resource != nil:
+		metric.Name = string(spec.Resource.Name)
+		metric.Target = spec.Resource.Target
+	case spec.Type == autoscaling.ContainerResourceMetricSourceType && spec.ContainerResource != nil:
+		metric.Name = string(spec.ContainerResource.Name)
+		metric.Container = spec.ContainerResource.Container
+		metric.Target = spec.ContainerResource.Target
+	case spec.Type == autoscaling.PodsMetricSourceType && spec.Pods != nil:
+		metric.Name = spec.Pods.Metric.Name
+		metric.Selector = spec.Pods.Metric.Selector
+		metric.Target = spec.Pods.Target
+	case spec.Type == autoscaling.ObjectMetricSourceType && spec.Object != nil:
+		metric.Name = spec.Object.Metric.Name
+		metric.Selector = spec.Object.Metric.Selector
+		metric.DescribedObject = &spec.Object.DescribedObject
+		metric.Target = spec.Object.Target
+	case spec.Type == autoscaling.ExternalMetricSourceType && spec.External != nil:
+		metric.Name = spec.External.Metric.Name
+		metric.Selector = spec.External.Metric.Selector
+		metric.Target = spec.External.Target
+	default:
+		return metric, false
+	}
+
+	return metric, true
+}
+
+func metricStatusValue(status autoscaling.MetricStatus) (string, autoscaling.MetricValueStatus, bool) {
+	switch {
+	case status.Type == autoscaling.ResourceMetricSourceType && status.Resource != nil:
+		return metricKey(status.Type, string(status.Resource.Name), "", nil), status.Resource.Current, true
+	case status.Type == autoscaling.ContainerResourceMetricSourceType && status.ContainerResource != nil:
+		return metricKey(status.Type, string(status.ContainerResource.Name), status.ContainerResource.Container, nil),
+			status.ContainerResource.Current, true
+	case status.Type == autoscaling.PodsMetricSourceType && status.Pods != nil:
+		return metricKey(status.Type, status.Pods.Metric.Name, "", nil), status.Pods.Current, true
+	case status.Type == autoscaling.ObjectMetricSourceType && status.Object != nil:
+		return metricKey(status.Type, status.Object.Metric.Name, "", &status.Object.DescribedObject), status.Object.Current, true
+	case status.Type == autoscaling.ExternalMetricSourceType && status.External != nil:
+		return metricKey(status.Type, status.External.Metric.Name, "", nil), status.External.Current, true
+	default:
+		return "", autoscaling.MetricValueStatus{}, false
+	}
+}
+
+func (in MetricStatus) key() string {
+	return metricKey(in.Type, in.Name, in.Container, in.DescribedObject)
+}
+
+// metricKey identifies the metric to match current values reported in the status with the spec.
+func metricKey(metricType autoscaling.MetricSourceType, name, container string, object *autoscaling.CrossVersionObjectReference) string {
+	key := fmt.Sprintf("%s/%s/%s", metricType, name, container)
+	if object != nil {
+		key = fmt.Sprintf("%s/%s/%s", key, object.Kind, object.Name)
+	}
+
+	return key
+}
+
+func toHorizontalPodAutoScalerV2(hpa *autoscaling.HorizontalPodAutoscaler) HorizontalPodAutoscaler {
+	result := HorizontalPodAutoscaler{
+		ObjectMeta: types.NewObjectMeta(hpa.ObjectMeta),
+		TypeMeta:   types.NewTypeMeta(types.ResourceKindHorizontalPodAutoscaler),
+		ScaleTargetRef: ScaleTargetRef{
+			Kind: hpa.Spec.ScaleTargetRef.Kind,
+			Name: hpa.Spec.ScaleTargetRef.Name,
+		},
+		MinReplicas: hpa.Spec.MinReplicas,
+		MaxReplicas: hpa.Spec.MaxReplicas,
+	}
+
+	// CPU utilization fields mirror the autoscaling/v1 view of the autoscaler.
+	for _, metric := range toMetricStatuses(hpa.Spec.Metrics, hpa.Status.CurrentMetrics) {
+		if metric.Type != autoscaling.ResourceMetricSourceType || metric.Name != string(v1.ResourceCPU) ||
+			metric.Target.Type != autoscaling.UtilizationMetricType {
+			continue
+		}
+
+		result.TargetCPUUtilizationPercentage = metric.Target.AverageUtilization
+		if metric.Current != nil {
+			result.CurrentCPUUtilizationPercentage = metric.Current.AverageUtilization
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,178 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	batch "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sClient "k8s.io/client-go/kubernetes"
+
+	"k8s.io/dashboard/errors"
+)
+
+// IndexStatus is a status of a single completion index of an Indexed Job.
+type IndexStatus string
+
+const (
+	IndexStatusSucceeded IndexStatus = "Succeeded"
+	IndexStatusFailed    IndexStatus = "Failed"
+	IndexStatusRunning   IndexStatus = "Running"
+	IndexStatusPending   IndexStatus = "Pending"
+)
+
+// JobIndexList is a per index status breakdown of an Indexed Job.
+type JobIndexList struct {
+	Completions int32 `json:"completions"`
+
+	// CompletedIndexes and FailedIndexes are copied from the Job status, e.g. "1,3-5".
+	CompletedIndexes string  `json:"completedIndexes"`
+	FailedIndexes    *string `json:"failedIndexes,omitempty"`
+
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+	Running   int `json:"running"`
+	Pending   int `json:"pending"`
+
+	Indexes []JobIndex `json:"indexes"`
+}
+
+// JobIndex is a status of a single completion index with pods created for it.
+type JobIndex struct {
+	Index  int         `json:"index"`
+	Status IndexStatus `json:"status"`
+
+	// Pods created for the index, oldest first.
+	Pods []JobIndexPod `json:"pods"`
+}
+
+// JobIndexPod identifies a pod created for the index.
+type JobIndexPod struct {
+	Name  string      `json:"name"`
+	Phase v1.PodPhase `json:"phase"`
+}
+
+// GetJobIndexes returns statuses of all completion indexes of the Indexed Job.
+func GetJobIndexes(client k8sClient.Interface, namespace, name string) (*JobIndexList, error) {
+	job, err := client.BatchV1().Jobs(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Spec.CompletionMode == nil || *job.Spec.CompletionMode != batch.IndexedCompletion || job.Spec.Completions == nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("job %s is not an Indexed Job", name))
+	}
+
+	pods, err := getRawJobPods(client, name, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return toJobIndexList(job, pods), nil
+}
+
+func toJobIndexList(job *batch.Job, pods []v1.Pod) *JobIndexList {
+	completions := int(*job.Spec.Completions)
+	result := &JobIndexList{
+		Completions:      *job.Spec.Completions,
+		CompletedIndexes: job.Status.CompletedIndexes,
+		FailedIndexes:    job.Status.FailedIndexes,
+		Indexes:          make([]JobIndex, completions),
+	}
+
+	for i := range result.Indexes {
+		result.Indexes[i] = JobIndex{Index: i, Pods: make([]JobIndexPod, 0)}
+	}
+
+	sort.SliceStable(pods, func(i, j int) bool {
+		return pods[i].CreationTimestamp.Before(&pods[j].CreationTimestamp)
+	})
+
+	active := make(map[int]bool)
+	for _, pod := range pods {
+		index, err := strconv.Atoi(pod.Annotations[batch.JobCompletionIndexAnnotation])
+		if err != nil || index < 0 || index >= completions {
+			continue
+		}
+
+		result.Indexes[index].Pods = append(result.Indexes[index].Pods, JobIndexPod{Name: pod.Name, Phase: pod.Status.Phase})
+		if pod.DeletionTimestamp == nil && (pod.Status.Phase == v1.PodRunning || pod.Status.Phase == v1.PodPending) {
+			active[index] = true
+		}
+	}
+
+	succeeded := parseIndexes(job.Status.CompletedIndexes, completions)
+	failed := make(map[int]bool)
+	if job.Status.FailedIndexes != nil {
+		failed = parseIndexes(*job.Status.FailedIndexes, completions)
+	}
+
+	for i := range result.Indexes {
+		switch {
+		case succeeded[i]:
+			result.Indexes[i].Status = IndexStatusSucceeded
+			result.Succeeded++
+		case failed[i]:
+			result.Indexes[i].Status = IndexStatusFailed
+			result.Failed++
+		case active[i]:
+			result.Indexes[i].Status = IndexStatusRunning
+			result.Running++
+		default:
+			result.Indexes[i].Status = IndexStatusPending
+			result.Pending++
+		}
+	}
+
+	return result
+}
+
+// parseIndexes parses comma-separated indexes and index ranges, e.g. "1,3-5", skipping invalid
+// ones and indexes not lower than the number of completions.
+func parseIndexes(indexes string, completions int) map[int]bool {
+	result := make(map[int]bool)
+	for _, interval := range strings.Split(indexes, ",") {
+		if len(interval) == 0 {
+			continue
+		}
+
+		bounds := strings.SplitN(interval, "-", 2)
+		first, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			continue
+		}
+
+		last := first
+		if len(bounds) == 2 {
+			if last, err = strconv.Atoi(bounds[1]); err != nil {
+				continue
+			}
+		}
+
+		for i := first; i <= last && i < completions; i++ {
+			if i >= 0 {
+				result[i] = true
+			}
+		}
+	}
+
+	return result
+}
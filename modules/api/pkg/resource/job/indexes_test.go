@@ -0,0 +1,90 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	batch "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newIndexPod(name, index string, phase v1.PodPhase, created time.Time) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{
+			Namespace:         "default",
+			Name:              name,
+			Labels:            map[string]string{"app": "render"},
+			Annotations:       map[string]string{batch.JobCompletionIndexAnnotation: index},
+			CreationTimestamp: metaV1.NewTime(created),
+		},
+		Status: v1.PodStatus{Phase: phase},
+	}
+}
+
+func TestGetJobIndexes(t *testing.T) {
+	created := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	indexed := createJob("render", "default", 5, map[string]string{"app": "render"})
+	indexed.Spec.CompletionMode = lo.ToPtr(batch.IndexedCompletion)
+	indexed.Status.CompletedIndexes = "0,2"
+	indexed.Status.FailedIndexes = lo.ToPtr("3")
+
+	client := fake.NewSimpleClientset(
+		indexed,
+		createJob("plain", "default", 1, map[string]string{"app": "plain"}),
+		newIndexPod("render-0", "0", v1.PodSucceeded, created),
+		newIndexPod("render-1-b", "1", v1.PodRunning, created.Add(time.Minute)),
+		newIndexPod("render-1-a", "1", v1.PodFailed, created),
+		newIndexPod("render-3", "3", v1.PodFailed, created),
+		newIndexPod("render-x", "7", v1.PodRunning, created),
+	)
+
+	result, err := GetJobIndexes(client, "default", "render")
+	if err != nil {
+		t.Fatalf("GetJobIndexes() returned error: %v", err)
+	}
+
+	if result.Succeeded != 2 || result.Failed != 1 || result.Running != 1 || result.Pending != 1 {
+		t.Errorf("unexpected index counts %+v", result)
+	}
+
+	expected := []IndexStatus{IndexStatusSucceeded, IndexStatusRunning, IndexStatusSucceeded, IndexStatusFailed, IndexStatusPending}
+	for i, index := range result.Indexes {
+		if index.Status != expected[i] {
+			t.Errorf("expected index %d to be %s, got %s", i, expected[i], index.Status)
+		}
+	}
+
+	expectedPods := []JobIndexPod{{Name: "render-1-a", Phase: v1.PodFailed}, {Name: "render-1-b", Phase: v1.PodRunning}}
+	if !reflect.DeepEqual(result.Indexes[1].Pods, expectedPods) {
+		t.Errorf("expected pods of index 1 %v, got %v", expectedPods, result.Indexes[1].Pods)
+	}
+
+	if _, err := GetJobIndexes(client, "default", "plain"); err == nil {
+		t.Error("GetJobIndexes() should fail for non-indexed job")
+	}
+}
+
+func TestParseIndexes(t *testing.T) {
+	expected := map[int]bool{1: true, 3: true, 4: true, 5: true, 9: true}
+	if actual := parseIndexes("1,3-5,9-12,x,", 10); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("parseIndexes() = %v, expected %v", actual, expected)
+	}
+}
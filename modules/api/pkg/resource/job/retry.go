@@ -0,0 +1,200 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+	"strings"
+
+	batch "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/validation"
+	k8sClient "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/errors"
+)
+
+// controllerGeneratedLabels are set by the Job controller on Jobs and their pod templates and
+// cannot be reused by another Job.
+var controllerGeneratedLabels = []string{
+	batch.ControllerUidLabel,
+	batch.JobNameLabel,
+	"controller-uid",
+	"job-name",
+}
+
+// JobRetrySpec describes how a Job is cloned to run it again.
+type JobRetrySpec struct {
+	// Name of the new Job. A name derived from the original Job is generated when empty.
+	Name string `json:"name,omitempty"`
+
+	// Parallelism and Completions override values of the original Job when set.
+	Parallelism *int32 `json:"parallelism,omitempty"`
+	Completions *int32 `json:"completions,omitempty"`
+
+	// Env variables are added to all containers, replacing variables with the same name.
+	Env []v1.EnvVar `json:"env,omitempty"`
+}
+
+// RetryJob runs the Job again by creating a new Job with a copy of its spec. The new Job is not
+// owned by the owner of the original Job, e.g. a Cron Job.
+func RetryJob(client k8sClient.Interface, namespace, name string, spec *JobRetrySpec) (*JobDetail, error) {
+	if err := validateRetrySpec(spec); err != nil {
+		return nil, err
+	}
+
+	original, err := client.BatchV1().Jobs(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	clone := cloneJob(original, spec)
+	klog.V(4).Infof("Retrying %s job as %s", name, clone.Name)
+	created, err := client.BatchV1().Jobs(namespace).Create(context.TODO(), clone, metaV1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// The new Job has no pods yet.
+	detail := toJobDetail(created, common.GetPodInfo(0, created.Spec.Completions, nil), make([]error, 0))
+	return &detail, nil
+}
+
+func validateRetrySpec(spec *JobRetrySpec) error {
+	problems := make([]string, 0)
+	if len(spec.Name) > 0 {
+		problems = append(problems, validation.IsDNS1123Subdomain(spec.Name)...)
+	}
+
+	if spec.Parallelism != nil && *spec.Parallelism < 0 {
+		problems = append(problems, "parallelism must not be negative")
+	}
+
+	if spec.Completions != nil && *spec.Completions < 0 {
+		problems = append(problems, "completions must not be negative")
+	}
+
+	for _, env := range spec.Env {
+		if len(env.Name) == 0 {
+			problems = append(problems, "env variable name is required")
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.NewBadRequest(strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+func cloneJob(original *batch.Job, spec *JobRetrySpec) *batch.Job {
+	name := spec.Name
+	if len(name) == 0 {
+		// Job names cannot exceed 63 characters, as they are used in pod labels.
+		prefix := original.Name
+		if len(prefix) > 51 {
+			prefix = prefix[0:51]
+		}
+
+		name = prefix + "-retry-" + rand.String(5)
+	}
+
+	clone := &batch.Job{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:        name,
+			Namespace:   original.Namespace,
+			Labels:      withoutControllerLabels(original.Labels),
+			Annotations: withoutControllerAnnotations(original.Annotations),
+		},
+		Spec: *original.Spec.DeepCopy(),
+	}
+
+	// Selector is generated by the Job controller unless it was set manually.
+	if clone.Spec.ManualSelector == nil || !*clone.Spec.ManualSelector {
+		clone.Spec.Selector = nil
+		clone.Spec.Template.Labels = withoutControllerLabels(clone.Spec.Template.Labels)
+	}
+
+	if spec.Parallelism != nil {
+		clone.Spec.Parallelism = spec.Parallelism
+	}
+
+	if spec.Completions != nil {
+		clone.Spec.Completions = spec.Completions
+	}
+
+	for i := range clone.Spec.Template.Spec.Containers {
+		container := &clone.Spec.Template.Spec.Containers[i]
+		container.Env = mergeEnv(container.Env, spec.Env)
+	}
+
+	return clone
+}
+
+func withoutControllerLabels(labels map[string]string) map[string]string {
+	if labels == nil {
+		return nil
+	}
+
+	result := make(map[string]string, len(labels))
+	for key, value := range labels {
+		result[key] = value
+	}
+
+	for _, key := range controllerGeneratedLabels {
+		delete(result, key)
+	}
+
+	return result
+}
+
+// withoutControllerAnnotations drops annotations describing the original run, e.g. its scheduled
+// time.
+func withoutControllerAnnotations(annotations map[string]string) map[string]string {
+	if annotations == nil {
+		return nil
+	}
+
+	result := make(map[string]string, len(annotations))
+	for key, value := range annotations {
+		if !strings.HasPrefix(key, "batch.kubernetes.io/") {
+			result[key] = value
+		}
+	}
+
+	return result
+}
+
+func mergeEnv(env, overrides []v1.EnvVar) []v1.EnvVar {
+	for _, override := range overrides {
+		replaced := false
+		for i := range env {
+			if env[i].Name == override.Name {
+				env[i] = override
+				replaced = true
+			}
+		}
+
+		if !replaced {
+			env = append(env, override)
+		}
+	}
+
+	return env
+}
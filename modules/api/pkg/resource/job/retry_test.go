@@ -0,0 +1,105 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	batch "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRetryJob(t *testing.T) {
+	controllerLabels := map[string]string{
+		"app":                    "migrate",
+		batch.ControllerUidLabel: "1234",
+		batch.JobNameLabel:       "migrate",
+		"controller-uid":         "1234",
+		"job-name":               "migrate",
+	}
+
+	client := fake.NewSimpleClientset(&batch.Job{
+		ObjectMeta: metaV1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "migrate",
+			Labels:      controllerLabels,
+			Annotations: map[string]string{batch.CronJobScheduledTimestampAnnotation: "2024-03-01T10:00:00Z", "team": "db"},
+		},
+		Spec: batch.JobSpec{
+			Selector:    &metaV1.LabelSelector{MatchLabels: map[string]string{batch.ControllerUidLabel: "1234"}},
+			Completions: lo.ToPtr(int32(1)),
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metaV1.ObjectMeta{Labels: controllerLabels},
+				Spec: v1.PodSpec{Containers: []v1.Container{{
+					Name: "migrate",
+					Env:  []v1.EnvVar{{Name: "DRY_RUN", Value: "true"}, {Name: "TARGET", Value: "v2"}},
+				}}},
+			},
+		},
+	})
+
+	detail, err := RetryJob(client, "default", "migrate", &JobRetrySpec{
+		Parallelism: lo.ToPtr(int32(2)),
+		Completions: lo.ToPtr(int32(4)),
+		Env:         []v1.EnvVar{{Name: "DRY_RUN", Value: "false"}, {Name: "RETRY", Value: "1"}},
+	})
+	if err != nil {
+		t.Fatalf("RetryJob() returned error: %v", err)
+	}
+
+	if !strings.HasPrefix(detail.ObjectMeta.Name, "migrate-retry-") || *detail.Completions != 4 || *detail.Parallelism != 2 {
+		t.Errorf("unexpected retried job %+v", detail)
+	}
+
+	clone, _ := client.BatchV1().Jobs("default").Get(context.TODO(), detail.ObjectMeta.Name, metaV1.GetOptions{})
+	if clone.Spec.Selector != nil {
+		t.Errorf("expected generated selector to be removed, got %+v", clone.Spec.Selector)
+	}
+
+	expectedLabels := map[string]string{"app": "migrate"}
+	if !reflect.DeepEqual(clone.Labels, expectedLabels) || !reflect.DeepEqual(clone.Spec.Template.Labels, expectedLabels) {
+		t.Errorf("expected controller labels to be removed, got %v and %v", clone.Labels, clone.Spec.Template.Labels)
+	}
+
+	if !reflect.DeepEqual(clone.Annotations, map[string]string{"team": "db"}) {
+		t.Errorf("expected controller annotations to be removed, got %v", clone.Annotations)
+	}
+
+	expectedEnv := []v1.EnvVar{{Name: "DRY_RUN", Value: "false"}, {Name: "TARGET", Value: "v2"}, {Name: "RETRY", Value: "1"}}
+	if !reflect.DeepEqual(clone.Spec.Template.Spec.Containers[0].Env, expectedEnv) {
+		t.Errorf("unexpected env %v", clone.Spec.Template.Spec.Containers[0].Env)
+	}
+
+	detail, err = RetryJob(client, "default", "migrate", &JobRetrySpec{Name: "migrate-again"})
+	if err != nil || detail.ObjectMeta.Name != "migrate-again" {
+		t.Errorf("RetryJob() = %+v, %v, expected job with given name", detail, err)
+	}
+
+	for _, spec := range []*JobRetrySpec{
+		{Name: "Invalid_Name"},
+		{Parallelism: lo.ToPtr(int32(-1))},
+		{Env: []v1.EnvVar{{Value: "x"}}},
+	} {
+		if _, err := RetryJob(client, "default", "migrate", spec); err == nil {
+			t.Errorf("RetryJob(%+v) should fail", spec)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lease
+
+import (
+	"context"
+	"time"
+
+	coordination "k8s.io/api/coordination/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+// LeaseDetail provides the presentation layer view of Lease resource.
+type LeaseDetail struct {
+	// Extends list item structure.
+	Lease `json:",inline"`
+
+	// Strategy and PreferredHolder are set for leases of coordinated leader election.
+	Strategy        string `json:"strategy,omitempty"`
+	PreferredHolder string `json:"preferredHolder,omitempty"`
+}
+
+// GetLeaseDetail returns Lease resource.
+func GetLeaseDetail(client kubernetes.Interface, namespace, name string) (*LeaseDetail, error) {
+	klog.V(4).Infof("Getting details of %s lease in %s namespace", name, namespace)
+
+	rawLease, err := client.CoordinationV1().Leases(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	lease := toLeaseDetail(rawLease, time.Now())
+	return &lease, nil
+}
+
+func toLeaseDetail(lease *coordination.Lease, now time.Time) LeaseDetail {
+	detail := LeaseDetail{
+		Lease: toLease(lease, now),
+	}
+
+	if lease.Spec.Strategy != nil {
+		detail.Strategy = string(*lease.Spec.Strategy)
+	}
+
+	if lease.Spec.PreferredHolder != nil {
+		detail.PreferredHolder = *lease.Spec.PreferredHolder
+	}
+
+	return detail
+}
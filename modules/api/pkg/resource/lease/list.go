@@ -0,0 +1,138 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lease
+
+import (
+	"time"
+
+	coordination "k8s.io/api/coordination/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// LeaseList holds a list of Lease objects.
+type LeaseList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+	Items    []Lease        `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// Lease is a representation of a Kubernetes Lease object. Leases are used for leader election and
+// node heartbeats, so the holder and the time since the last renewal are the most useful details.
+type Lease struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+
+	HolderIdentity       string            `json:"holderIdentity"`
+	LeaseDurationSeconds *int32            `json:"leaseDurationSeconds,omitempty"`
+	AcquireTime          *metaV1.MicroTime `json:"acquireTime,omitempty"`
+	RenewTime            *metaV1.MicroTime `json:"renewTime,omitempty"`
+	LeaseTransitions     int32             `json:"leaseTransitions"`
+
+	// RenewAgeSeconds is the number of seconds since the last renewal. It is not set when the lease
+	// has never been renewed.
+	RenewAgeSeconds *int64 `json:"renewAgeSeconds,omitempty"`
+
+	// Expired is set when the lease was not renewed within its duration, e.g. when the leader is
+	// gone and nobody took over.
+	Expired bool `json:"expired"`
+}
+
+// GetLeaseList returns a list of all leases in the namespaces.
+func GetLeaseList(client kubernetes.Interface, nsQuery *common.NamespaceQuery,
+	dsQuery *dataselect.DataSelectQuery) (*LeaseList, error) {
+	klog.V(4).Infof("Getting list of leases in %s namespace", nsQuery.ToRequestParam())
+
+	channels := &common.ResourceChannels{
+		LeaseList: common.GetLeaseListChannel(client, nsQuery, 1),
+	}
+
+	return GetLeaseListFromChannels(channels, dsQuery)
+}
+
+// GetLeaseListFromChannels returns a list of all leases from the channels.
+func GetLeaseListFromChannels(channels *common.ResourceChannels,
+	dsQuery *dataselect.DataSelectQuery) (*LeaseList, error) {
+	leases := <-channels.LeaseList.List
+	err := <-channels.LeaseList.Error
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	var items []coordination.Lease
+	if leases != nil {
+		items = leases.Items
+	}
+
+	return toLeaseList(items, nonCriticalErrors, dsQuery, time.Now()), nil
+}
+
+func toLeaseList(leases []coordination.Lease, nonCriticalErrors []error,
+	dsQuery *dataselect.DataSelectQuery, now time.Time) *LeaseList {
+	leaseList := &LeaseList{
+		Items:    make([]Lease, 0),
+		ListMeta: types.ListMeta{TotalItems: len(leases)},
+		Errors:   nonCriticalErrors,
+	}
+
+	leaseCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(leases), dsQuery)
+	leases = fromCells(leaseCells)
+	leaseList.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+
+	for _, lease := range leases {
+		leaseList.Items = append(leaseList.Items, toLease(&lease, now))
+	}
+
+	return leaseList
+}
+
+func toLease(lease *coordination.Lease, now time.Time) Lease {
+	result := Lease{
+		ObjectMeta:           types.NewObjectMeta(lease.ObjectMeta),
+		TypeMeta:             types.NewTypeMeta(types.ResourceKindLease),
+		LeaseDurationSeconds: lease.Spec.LeaseDurationSeconds,
+		AcquireTime:          lease.Spec.AcquireTime,
+		RenewTime:            lease.Spec.RenewTime,
+	}
+
+	if lease.Spec.HolderIdentity != nil {
+		result.HolderIdentity = *lease.Spec.HolderIdentity
+	}
+
+	if lease.Spec.LeaseTransitions != nil {
+		result.LeaseTransitions = *lease.Spec.LeaseTransitions
+	}
+
+	if lease.Spec.RenewTime != nil {
+		age := int64(now.Sub(lease.Spec.RenewTime.Time) / time.Second)
+		result.RenewAgeSeconds = &age
+
+		if lease.Spec.LeaseDurationSeconds != nil {
+			expiry := lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+			result.Expired = now.After(expiry)
+		}
+	}
+
+	return result
+}
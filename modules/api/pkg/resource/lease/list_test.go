@@ -0,0 +1,120 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lease
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	coordination "k8s.io/api/coordination/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/types"
+)
+
+func TestToLease(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	holder := "controller-manager-1"
+	duration := int32(15)
+	transitions := int32(3)
+	renewed := func(ago time.Duration) *metaV1.MicroTime {
+		renewTime := metaV1.NewMicroTime(now.Add(-ago))
+		return &renewTime
+	}
+	age := func(seconds int64) *int64 { return &seconds }
+
+	cases := []struct {
+		info     string
+		lease    *coordination.Lease
+		expected Lease
+	}{
+		{
+			"never renewed",
+			&coordination.Lease{ObjectMeta: metaV1.ObjectMeta{Name: "empty"}},
+			Lease{
+				ObjectMeta: types.ObjectMeta{Name: "empty"},
+				TypeMeta:   types.TypeMeta{Kind: types.ResourceKindLease},
+			},
+		},
+		{
+			"renewed within duration",
+			&coordination.Lease{
+				ObjectMeta: metaV1.ObjectMeta{Name: "kube-controller-manager", Namespace: "kube-system"},
+				Spec: coordination.LeaseSpec{
+					HolderIdentity:       &holder,
+					LeaseDurationSeconds: &duration,
+					RenewTime:            renewed(5 * time.Second),
+					LeaseTransitions:     &transitions,
+				},
+			},
+			Lease{
+				ObjectMeta:           types.ObjectMeta{Name: "kube-controller-manager", Namespace: "kube-system"},
+				TypeMeta:             types.TypeMeta{Kind: types.ResourceKindLease},
+				HolderIdentity:       holder,
+				LeaseDurationSeconds: &duration,
+				RenewTime:            renewed(5 * time.Second),
+				LeaseTransitions:     3,
+				RenewAgeSeconds:      age(5),
+			},
+		},
+		{
+			"not renewed within duration",
+			&coordination.Lease{
+				ObjectMeta: metaV1.ObjectMeta{Name: "kube-scheduler", Namespace: "kube-system"},
+				Spec: coordination.LeaseSpec{
+					HolderIdentity:       &holder,
+					LeaseDurationSeconds: &duration,
+					RenewTime:            renewed(time.Minute),
+				},
+			},
+			Lease{
+				ObjectMeta:           types.ObjectMeta{Name: "kube-scheduler", Namespace: "kube-system"},
+				TypeMeta:             types.TypeMeta{Kind: types.ResourceKindLease},
+				HolderIdentity:       holder,
+				LeaseDurationSeconds: &duration,
+				RenewTime:            renewed(time.Minute),
+				RenewAgeSeconds:      age(60),
+				Expired:              true,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := toLease(c.lease, now)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("%s: toLease() == got\n%#v, expected\n %#v", c.info, actual, c.expected)
+		}
+	}
+}
+
+func TestGetLeaseList(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&coordination.Lease{ObjectMeta: metaV1.ObjectMeta{Name: "kube-scheduler", Namespace: "kube-system"}},
+		&coordination.Lease{ObjectMeta: metaV1.ObjectMeta{Name: "node-1", Namespace: "kube-node-lease"}},
+	)
+
+	actual, err := GetLeaseList(client, common.NewSameNamespaceQuery("kube-system"), dataselect.NoDataSelect)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if actual.ListMeta.TotalItems != 1 || actual.Items[0].ObjectMeta.Name != "kube-scheduler" {
+		t.Errorf("expected only kube-scheduler lease, got %#v", actual.Items)
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package limitrange
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/errors"
+)
+
+// LimitRangeSpec is a specification of a limit range to create or update.
+type LimitRangeSpec struct {
+	// Name is only used when the limit range is created.
+	Name string `json:"name,omitempty"`
+
+	Limits []LimitRangeItemSpec `json:"limits"`
+}
+
+// LimitRangeItemSpec are limits of a single type by resource name, e.g. {"memory": "512Mi"}.
+type LimitRangeItemSpec struct {
+	Type                 api.LimitType               `json:"type"`
+	Min                  map[api.ResourceName]string `json:"min,omitempty"`
+	Max                  map[api.ResourceName]string `json:"max,omitempty"`
+	Default              map[api.ResourceName]string `json:"default,omitempty"`
+	DefaultRequest       map[api.ResourceName]string `json:"defaultRequest,omitempty"`
+	MaxLimitRequestRatio map[api.ResourceName]string `json:"maxLimitRequestRatio,omitempty"`
+}
+
+// CreateLimitRange creates the limit range in the namespace.
+func CreateLimitRange(client kubernetes.Interface, namespace string, spec *LimitRangeSpec) (*LimitRange, error) {
+	if len(spec.Name) == 0 {
+		return nil, errors.NewBadRequest("name is required")
+	}
+
+	limits, err := validateSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(4).Infof("Creating %s limit range in %s namespace", spec.Name, namespace)
+	limitRange := &api.LimitRange{
+		ObjectMeta: metaV1.ObjectMeta{Namespace: namespace, Name: spec.Name},
+		Spec:       api.LimitRangeSpec{Limits: limits},
+	}
+
+	limitRange, err = client.CoreV1().LimitRanges(namespace).Create(context.TODO(), limitRange, metaV1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := toLimitRange(limitRange)
+	return &result, nil
+}
+
+// UpdateLimitRange replaces limits of the limit range. Existing pods are not affected.
+func UpdateLimitRange(client kubernetes.Interface, namespace, name string, spec *LimitRangeSpec) (*LimitRange, error) {
+	limits, err := validateSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(4).Infof("Updating %s limit range in %s namespace", name, namespace)
+	var result *api.LimitRange
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		limitRange, err := client.CoreV1().LimitRanges(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		limitRange.Spec.Limits = limits
+		result, err = client.CoreV1().LimitRanges(namespace).Update(context.TODO(), limitRange, metaV1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	limitRange := toLimitRange(result)
+	return &limitRange, nil
+}
+
+// validateSpec follows the validation of the API server, so that all problems are reported at once,
+// and returns parsed limits.
+func validateSpec(spec *LimitRangeSpec) ([]api.LimitRangeItem, error) {
+	problems := make([]string, 0)
+	if len(spec.Limits) == 0 {
+		problems = append(problems, "at least one limit is required")
+	}
+
+	types := make(map[api.LimitType]bool)
+	limits := make([]api.LimitRangeItem, 0, len(spec.Limits))
+	for _, item := range spec.Limits {
+		if types[item.Type] {
+			problems = append(problems, fmt.Sprintf("duplicate limits of %s type", item.Type))
+		}
+		types[item.Type] = true
+
+		limit, itemProblems := toLimitRangeItem(&item)
+		problems = append(problems, itemProblems...)
+		limits = append(limits, limit)
+	}
+
+	if len(problems) > 0 {
+		return nil, errors.NewBadRequest(strings.Join(problems, "; "))
+	}
+
+	return limits, nil
+}
+
+func toLimitRangeItem(spec *LimitRangeItemSpec) (api.LimitRangeItem, []string) {
+	problems := make([]string, 0)
+	parse := func(field string, values map[api.ResourceName]string) api.ResourceList {
+		result := make(api.ResourceList, len(values))
+		for _, name := range sortedResourceNames(values) {
+			quantity, err := resource.ParseQuantity(values[name])
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("invalid %s quantity %q of %s for %s type", field, values[name], name, spec.Type))
+				continue
+			}
+
+			if quantity.Sign() < 0 {
+				problems = append(problems, fmt.Sprintf("%s of %s for %s type must not be negative", field, name, spec.Type))
+				continue
+			}
+
+			result[name] = quantity
+		}
+
+		return result
+	}
+
+	item := api.LimitRangeItem{
+		Type:                 spec.Type,
+		Min:                  parse("min", spec.Min),
+		Max:                  parse("max", spec.Max),
+		Default:              parse("default", spec.Default),
+		DefaultRequest:       parse("defaultRequest", spec.DefaultRequest),
+		MaxLimitRequestRatio: parse("maxLimitRequestRatio", spec.MaxLimitRequestRatio),
+	}
+
+	switch spec.Type {
+	case api.LimitTypePod:
+		if len(spec.Default) > 0 || len(spec.DefaultRequest) > 0 {
+			problems = append(problems, fmt.Sprintf("default and defaultRequest are not supported for %s type", spec.Type))
+		}
+	case api.LimitTypePersistentVolumeClaim:
+		for _, values := range []map[api.ResourceName]string{spec.Min, spec.Max, spec.Default, spec.DefaultRequest, spec.MaxLimitRequestRatio} {
+			for name := range values {
+				if name != api.ResourceStorage {
+					problems = append(problems, fmt.Sprintf("only %s is supported for %s type, got %s", api.ResourceStorage, spec.Type, name))
+				}
+			}
+		}
+
+		if len(spec.Min) == 0 && len(spec.Max) == 0 {
+			problems = append(problems, fmt.Sprintf("min or max %s is required for %s type", api.ResourceStorage, spec.Type))
+		}
+	case api.LimitTypeContainer:
+	default:
+		problems = append(problems, fmt.Sprintf("unsupported limit type %q", spec.Type))
+	}
+
+	problems = append(problems, validateOrder(&item)...)
+	return item, problems
+}
+
+// validateOrder checks that min <= defaultRequest <= default <= max and that the max limit to request
+// ratio is at least 1 and at most max/min.
+func validateOrder(item *api.LimitRangeItem) []string {
+	problems := make([]string, 0)
+	ordered := []struct {
+		field  string
+		values api.ResourceList
+	}{
+		{"min", item.Min},
+		{"defaultRequest", item.DefaultRequest},
+		{"default", item.Default},
+		{"max", item.Max},
+	}
+
+	for i := range ordered {
+		for j := i + 1; j < len(ordered); j++ {
+			for _, name := range sortedResourceNames(ordered[i].values) {
+				lower := ordered[i].values[name]
+				upper, exists := ordered[j].values[name]
+				if exists && lower.Cmp(upper) > 0 {
+					problems = append(problems, fmt.Sprintf("%s %s of %s must be less than or equal to %s %s for %s type",
+						ordered[i].field, lower.String(), name, ordered[j].field, upper.String(), item.Type))
+				}
+			}
+		}
+	}
+
+	for _, name := range sortedResourceNames(item.MaxLimitRequestRatio) {
+		ratio := item.MaxLimitRequestRatio[name]
+		if ratio.Cmp(*resource.NewQuantity(1, resource.DecimalSI)) < 0 {
+			problems = append(problems, fmt.Sprintf("maxLimitRequestRatio of %s must be at least 1 for %s type", name, item.Type))
+			continue
+		}
+
+		minimum, minExists := item.Min[name]
+		maximum, maxExists := item.Max[name]
+		if minExists && maxExists && !minimum.IsZero() &&
+			ratio.AsApproximateFloat64() > maximum.AsApproximateFloat64()/minimum.AsApproximateFloat64() {
+			problems = append(problems, fmt.Sprintf("maxLimitRequestRatio of %s must be less than or equal to max/min for %s type", name, item.Type))
+		}
+	}
+
+	return problems
+}
+
+func sortedResourceNames[T any](resources map[api.ResourceName]T) []api.ResourceName {
+	names := make([]api.ResourceName, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
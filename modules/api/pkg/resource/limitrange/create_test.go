@@ -0,0 +1,123 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package limitrange
+
+import (
+	"strings"
+	"testing"
+
+	api "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+)
+
+func TestCreateLimitRange(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	spec := &LimitRangeSpec{
+		Name: "defaults",
+		Limits: []LimitRangeItemSpec{{
+			Type:           api.LimitTypeContainer,
+			Min:            map[api.ResourceName]string{api.ResourceMemory: "64Mi"},
+			DefaultRequest: map[api.ResourceName]string{api.ResourceMemory: "128Mi", api.ResourceCPU: "100m"},
+			Default:        map[api.ResourceName]string{api.ResourceMemory: "256Mi"},
+		}},
+	}
+
+	if _, err := CreateLimitRange(client, "default", spec); err != nil {
+		t.Fatalf("CreateLimitRange() returned error: %v", err)
+	}
+
+	dsQuery := dataselect.NewDataSelectQuery(dataselect.NoPagination, dataselect.NoSort,
+		dataselect.NewFilterQuery([]string{"name", "defaults"}), dataselect.NoMetrics)
+	list, err := GetLimitRangeList(client, common.NewNamespaceQuery(nil), dsQuery)
+	if err != nil {
+		t.Fatalf("GetLimitRangeList() returned error: %v", err)
+	}
+
+	if list.ListMeta.TotalItems != 1 {
+		t.Fatalf("GetLimitRangeList() returned %d items, expected 1", list.ListMeta.TotalItems)
+	}
+
+	expected := []LimitRangeItem{
+		{ResourceName: "cpu", ResourceType: "Container", DefaultRequest: "100m"},
+		{ResourceName: "memory", ResourceType: "Container", Min: "64Mi", Default: "256Mi", DefaultRequest: "128Mi"},
+	}
+	actual := list.Items[0].Limits
+	if len(actual) != len(expected) || actual[0] != expected[0] || actual[1] != expected[1] {
+		t.Errorf("GetLimitRangeList() limits == %#v, expected %#v", actual, expected)
+	}
+
+	spec.Limits[0].Default = map[api.ResourceName]string{api.ResourceMemory: "512Mi"}
+	limitRange, err := UpdateLimitRange(client, "default", "defaults", spec)
+	if err != nil {
+		t.Fatalf("UpdateLimitRange() returned error: %v", err)
+	}
+
+	if limitRange.Limits[1].Default != "512Mi" {
+		t.Errorf("UpdateLimitRange() limits == %#v, expected 512Mi memory default", limitRange.Limits)
+	}
+}
+
+func TestValidateLimitRangeSpec(t *testing.T) {
+	cases := []struct {
+		spec     *LimitRangeSpec
+		problems []string
+	}{
+		{&LimitRangeSpec{}, []string{"at least one limit is required"}},
+		{
+			&LimitRangeSpec{Limits: []LimitRangeItemSpec{
+				{Type: api.LimitTypePod, Default: map[api.ResourceName]string{api.ResourceCPU: "1"}},
+				{Type: api.LimitTypePod},
+				{Type: "Node"},
+			}},
+			[]string{"default and defaultRequest are not supported for Pod type", "duplicate limits of Pod type", `unsupported limit type "Node"`},
+		},
+		{
+			&LimitRangeSpec{Limits: []LimitRangeItemSpec{
+				{Type: api.LimitTypePersistentVolumeClaim, Max: map[api.ResourceName]string{api.ResourceCPU: "1"}},
+			}},
+			[]string{"only storage is supported for PersistentVolumeClaim type, got cpu"},
+		},
+		{
+			&LimitRangeSpec{Limits: []LimitRangeItemSpec{{
+				Type:                 api.LimitTypeContainer,
+				Min:                  map[api.ResourceName]string{api.ResourceCPU: "1", api.ResourceMemory: "1Gi"},
+				Max:                  map[api.ResourceName]string{api.ResourceCPU: "500m", api.ResourceMemory: "2Gi"},
+				DefaultRequest:       map[api.ResourceName]string{api.ResourceMemory: "lots"},
+				MaxLimitRequestRatio: map[api.ResourceName]string{api.ResourceMemory: "4", "ephemeral-storage": "0.5"},
+			}}},
+			[]string{"min 1 of cpu must be less than or equal to max 500m", `invalid defaultRequest quantity "lots"`,
+				"maxLimitRequestRatio of memory must be less than or equal to max/min",
+				"maxLimitRequestRatio of ephemeral-storage must be at least 1"},
+		},
+	}
+
+	for _, c := range cases {
+		_, err := validateSpec(c.spec)
+		if !k8serrors.IsBadRequest(err) {
+			t.Errorf("validateSpec(%#v) returned %v, expected bad request", c.spec, err)
+			continue
+		}
+
+		for _, problem := range c.problems {
+			if !strings.Contains(err.Error(), problem) {
+				t.Errorf("validateSpec(%#v) returned %q, expected it to contain %q", c.spec, err, problem)
+			}
+		}
+	}
+}
@@ -14,7 +14,11 @@
 
 package limitrange
 
-import api "k8s.io/api/core/v1"
+import (
+	"sort"
+
+	api "k8s.io/api/core/v1"
+)
 
 // limitRangesMap provides set of limit ranges by limit types and resource names
 type limitRangesMap map[api.LimitType]rangeMap
@@ -86,6 +90,7 @@ func toLimitRangesMap(rawLimitRange *api.LimitRange) limitRangesMap {
 	return limitRanges
 }
 
+// ToLimitRanges converts raw limit range to limit range items sorted by type and resource name
 func ToLimitRanges(rawLimitRange *api.LimitRange) []LimitRangeItem {
 	limitRangeMap := toLimitRangesMap(rawLimitRange)
 	limitRangeList := make([]LimitRangeItem, 0)
@@ -96,5 +101,11 @@ func ToLimitRanges(rawLimitRange *api.LimitRange) []LimitRangeItem {
 			limitRangeList = append(limitRangeList, *limit)
 		}
 	}
+	sort.Slice(limitRangeList, func(i, j int) bool {
+		if limitRangeList[i].ResourceType != limitRangeList[j].ResourceType {
+			return limitRangeList[i].ResourceType < limitRangeList[j].ResourceType
+		}
+		return limitRangeList[i].ResourceName < limitRangeList[j].ResourceName
+	})
 	return limitRangeList
 }
@@ -0,0 +1,115 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package limitrange
+
+import (
+	"context"
+
+	api "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// LimitRangeList holds a list of limit ranges.
+type LimitRangeList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+	Items    []LimitRange   `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// LimitRange is a limit range with its limits flattened by type and resource name.
+type LimitRange struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+	Limits     []LimitRangeItem `json:"limits"`
+}
+
+// GetLimitRangeList returns a list of limit ranges in the namespaces.
+func GetLimitRangeList(client kubernetes.Interface, nsQuery *common.NamespaceQuery,
+	dsQuery *dataselect.DataSelectQuery) (*LimitRangeList, error) {
+	klog.V(4).Infof("Getting list of limit ranges in %s namespace", nsQuery.ToRequestParam())
+
+	channels := &common.ResourceChannels{
+		LimitRangeList: common.GetLimitRangeListChannel(client, nsQuery, 1),
+	}
+
+	return GetLimitRangeListFromChannels(channels, dsQuery)
+}
+
+// GetLimitRangeListFromChannels returns a list of limit ranges from the channels.
+func GetLimitRangeListFromChannels(channels *common.ResourceChannels,
+	dsQuery *dataselect.DataSelectQuery) (*LimitRangeList, error) {
+	limitRanges := <-channels.LimitRangeList.List
+	err := <-channels.LimitRangeList.Error
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	var items []api.LimitRange
+	if limitRanges != nil {
+		items = limitRanges.Items
+	}
+
+	return toLimitRangeList(items, nonCriticalErrors, dsQuery), nil
+}
+
+// GetLimitRange returns the limit range in the namespace.
+func GetLimitRange(client kubernetes.Interface, namespace, name string) (*LimitRange, error) {
+	klog.V(4).Infof("Getting details of %s limit range in %s namespace", name, namespace)
+
+	raw, err := client.CoreV1().LimitRanges(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	limitRange := toLimitRange(raw)
+	return &limitRange, nil
+}
+
+func toLimitRangeList(limitRanges []api.LimitRange, nonCriticalErrors []error,
+	dsQuery *dataselect.DataSelectQuery) *LimitRangeList {
+	limitRangeList := &LimitRangeList{
+		Items:    make([]LimitRange, 0),
+		ListMeta: types.ListMeta{TotalItems: len(limitRanges)},
+		Errors:   nonCriticalErrors,
+	}
+
+	limitRangeCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(limitRanges), dsQuery)
+	limitRanges = fromCells(limitRangeCells)
+	limitRangeList.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+
+	for _, limitRange := range limitRanges {
+		limitRangeList.Items = append(limitRangeList.Items, toLimitRange(&limitRange))
+	}
+
+	return limitRangeList
+}
+
+func toLimitRange(limitRange *api.LimitRange) LimitRange {
+	return LimitRange{
+		ObjectMeta: types.NewObjectMeta(limitRange.ObjectMeta),
+		TypeMeta:   types.NewTypeMeta(types.ResourceKindLimitRange),
+		Limits:     ToLimitRanges(limitRange),
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sClient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	"k8s.io/kubectl/pkg/drain"
+
+	"k8s.io/dashboard/api/pkg/args"
+)
+
+// NodeMaintenanceStatus is the state of the Node changed by maintenance operations.
+type NodeMaintenanceStatus struct {
+	Name          string            `json:"name"`
+	Unschedulable bool              `json:"unschedulable"`
+	Taints        []v1.Taint        `json:"taints"`
+	Labels        map[string]string `json:"labels"`
+}
+
+func toNodeMaintenanceStatus(node *v1.Node) *NodeMaintenanceStatus {
+	result := &NodeMaintenanceStatus{
+		Name:          node.Name,
+		Unschedulable: node.Spec.Unschedulable,
+		Taints:        node.Spec.Taints,
+		Labels:        node.Labels,
+	}
+
+	if result.Taints == nil {
+		result.Taints = make([]v1.Taint, 0)
+	}
+
+	if result.Labels == nil {
+		result.Labels = make(map[string]string)
+	}
+
+	return result
+}
+
+// CordonNode marks the Node as unschedulable.
+func CordonNode(client k8sClient.Interface, name string) (*NodeMaintenanceStatus, error) {
+	klog.V(args.LogLevelVerbose).Infof("Cordoning %s node", name)
+	return setUnschedulable(client, name, true)
+}
+
+// UncordonNode marks the Node as schedulable.
+func UncordonNode(client k8sClient.Interface, name string) (*NodeMaintenanceStatus, error) {
+	klog.V(args.LogLevelVerbose).Infof("Uncordoning %s node", name)
+	return setUnschedulable(client, name, false)
+}
+
+func setUnschedulable(client k8sClient.Interface, name string, unschedulable bool) (*NodeMaintenanceStatus, error) {
+	node, err := client.CoreV1().Nodes().Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	helper := drain.NewCordonHelper(node)
+	if !helper.UpdateIfRequired(unschedulable) {
+		return toNodeMaintenanceStatus(node), nil
+	}
+
+	err, patchErr := helper.PatchOrReplaceWithContext(context.TODO(), client, false)
+	if patchErr != nil {
+		return nil, patchErr
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	node.Spec.Unschedulable = unschedulable
+	return toNodeMaintenanceStatus(node), nil
+}
+
+// updateNode applies the mutation to the latest version of the Node, retrying on conflicts.
+func updateNode(client k8sClient.Interface, name string, mutate func(node *v1.Node) error) (*NodeMaintenanceStatus, error) {
+	var result *v1.Node
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		node, err := client.CoreV1().Nodes().Get(context.TODO(), name, metaV1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if err := mutate(node); err != nil {
+			return err
+		}
+
+		result, err = client.CoreV1().Nodes().Update(context.TODO(), node, metaV1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toNodeMaintenanceStatus(result), nil
+}
@@ -17,12 +17,17 @@ package node
 import (
 	"context"
 	"fmt"
-	"os"
+	"strings"
 	"time"
 
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	k8sClient "k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/kubectl/pkg/drain"
 
 	"k8s.io/dashboard/api/pkg/args"
@@ -47,14 +52,21 @@ type NodeDrainSpec struct {
 
 	// Defaulted to true to proceed even when pods are using emptyDir volumes.
 	DeleteEmptyDirData *bool `json:"deleteEmptyDirData,omitempty"`
+
+	// DryRun only reports which pods would be evicted and which would block the drain. The Node
+	// is not cordoned and evictions are sent with server-side dry run.
+	// Defaulted to false.
+	DryRun *bool `json:"dryRun,omitempty"`
+}
+
+func (in *NodeDrainSpec) isDryRun() bool {
+	return in != nil && in.DryRun != nil && *in.DryRun
 }
 
 func newHelper(ctx context.Context, client k8sClient.Interface, spec *NodeDrainSpec) *drain.Helper {
 	helper := &drain.Helper{
 		Ctx:                 ctx,
 		Client:              client,
-		Out:                 os.Stdout,
-		ErrOut:              os.Stderr,
 		Force:               true,
 		Timeout:             2 * time.Minute,
 		GracePeriodSeconds:  -1,
@@ -86,29 +98,260 @@ func newHelper(ctx context.Context, client k8sClient.Interface, spec *NodeDrainS
 		helper.DeleteEmptyDirData = *spec.DeleteEmptyDirData
 	}
 
+	if spec.isDryRun() {
+		helper.DryRunStrategy = cmdutil.DryRunServer
+	}
+
 	return helper
 }
 
-// DrainNode drains the Node.
-func DrainNode(client k8sClient.Interface, name string, spec *NodeDrainSpec) error {
-	klog.V(args.LogLevelVerbose).Infof("Draining %s node", name)
+// StartNodeDrain starts draining the Node in the background and returns the initial state of
+// the operation. Progress can be followed with WatchNodeDrain.
+func StartNodeDrain(client k8sClient.Interface, name string, spec *NodeDrainSpec) (*NodeDrainStatus, error) {
+	node, err := client.CoreV1().Nodes().Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := genDrainOperationId()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	operation := newDrainOperation(id, name, spec.isDryRun(), cancel)
+	if err := drainOperations.Add(operation); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	klog.V(args.LogLevelVerbose).Infof("Draining %s node with operation %s (dry run: %t)", name, id, spec.isDryRun())
+	go operation.run(ctx, client, node, spec)
+
+	return operation.getStatus(), nil
+}
+
+// GetNodeDrain returns the state of the drain operation. The client is used to make sure that
+// the caller can access the Node.
+func GetNodeDrain(client k8sClient.Interface, name, id string) (*NodeDrainStatus, error) {
+	operation, err := getDrainOperation(client, name, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return operation.getStatus(), nil
+}
+
+// CancelNodeDrain stops the drain operation. Pods that are already evicted are not restored and
+// the Node stays cordoned.
+func CancelNodeDrain(client k8sClient.Interface, name, id string) (*NodeDrainStatus, error) {
+	operation, err := getDrainOperation(client, name, id)
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(args.LogLevelVerbose).Infof("Cancelling drain operation %s of %s node", id, name)
+	operation.cancel()
+	return operation.getStatus(), nil
+}
 
-	node, err := client.CoreV1().Nodes().Get(context.Background(), name, metaV1.GetOptions{})
+// WatchNodeDrain calls fn for every progress event of the drain operation until it finishes, the
+// context is done or fn returns an error.
+func WatchNodeDrain(ctx context.Context, client k8sClient.Interface, name, id string, fn func(DrainEvent) error) error {
+	operation, err := getDrainOperation(client, name, id)
 	if err != nil {
-		return fmt.Errorf("error getting node: %w", err)
+		return err
+	}
+
+	return operation.watch(ctx, fn)
+}
+
+func getDrainOperation(client k8sClient.Interface, name, id string) (*drainOperation, error) {
+	if _, err := client.CoreV1().Nodes().Get(context.TODO(), name, metaV1.GetOptions{}); err != nil {
+		return nil, err
+	}
+
+	return drainOperations.Get(name, id)
+}
+
+func (in *drainOperation) run(ctx context.Context, client k8sClient.Interface, node *v1.Node, spec *NodeDrainSpec) {
+	defer in.cancel()
+
+	helper := newHelper(ctx, client, spec)
+	// Progress written to Out is already reported by the callbacks below.
+	helper.Out = &lineWriter{fn: func(line string) { klog.V(args.LogLevelVerbose).Info(line) }}
+	helper.ErrOut = &lineWriter{fn: in.logError}
+	helper.OnPodDeletionOrEvictionStarted = func(pod *v1.Pod, usingEviction bool) {
+		in.setPod(pod.Namespace, pod.Name, podDrainPhase(usingEviction, PodDrainPhaseEvicting, PodDrainPhaseDeleting), "")
+	}
+	helper.OnPodDeletionOrEvictionFinished = func(pod *v1.Pod, usingEviction bool, err error) {
+		if err != nil {
+			in.setPod(pod.Namespace, pod.Name, PodDrainPhaseFailed, err.Error())
+			return
+		}
+
+		in.setPod(pod.Namespace, pod.Name, podDrainPhase(usingEviction, PodDrainPhaseEvicted, PodDrainPhaseDeleted), "")
 	}
 
-	helper := newHelper(context.Background(), client, spec)
+	var err error
+	if spec.isDryRun() {
+		err = in.dryRun(helper)
+	} else {
+		err = in.drain(helper, node)
+	}
+
+	switch {
+	case ctx.Err() == context.Canceled:
+		in.finish(DrainPhaseCancelled, nil)
+	case err != nil:
+		in.finish(DrainPhaseFailed, err)
+	default:
+		in.finish(DrainPhaseSucceeded, nil)
+	}
 
+	klog.V(args.LogLevelVerbose).Infof("Drain operation %s of %s node finished: %v", in.status.ID, in.status.Node, err)
+}
+
+// drain cordons the Node and evicts or deletes its Pods.
+func (in *drainOperation) drain(helper *drain.Helper, node *v1.Node) error {
 	if err := drain.RunCordonOrUncordon(helper, node, true); err != nil {
 		return fmt.Errorf("error cordoning node: %w", err)
 	}
+	in.log(fmt.Sprintf("node %s cordoned", node.Name))
+
+	pods, errs, err := in.getPodsForDeletion(helper)
+	if err != nil {
+		return err
+	}
 
-	if err := drain.RunNodeDrain(helper, name); err != nil {
+	if len(errs) > 0 {
+		return utilerrors.NewAggregate(errs)
+	}
+
+	if err := helper.DeleteOrEvictPods(pods); err != nil {
 		return fmt.Errorf("error draining node: %w", err)
 	}
 
-	klog.V(args.LogLevelVerbose).Infof("Successfully drained %s node", name)
+	return nil
+}
+
+// dryRun reports the Pods that would be evicted or deleted and the ones that would block the
+// drain. Evictions are sent with server-side dry run so PodDisruptionBudgets are checked.
+func (in *drainOperation) dryRun(helper *drain.Helper) error {
+	pods, errs, err := in.getPodsForDeletion(helper)
+	if err != nil {
+		return err
+	}
+
+	var gv schema.GroupVersion
+	if !helper.DisableEviction {
+		if gv, err = drain.CheckEvictionSupport(helper.Client); err != nil {
+			return err
+		}
+	}
+
+	for _, pod := range pods {
+		if gv.Empty() {
+			in.setPod(pod.Namespace, pod.Name, PodDrainPhaseWouldDelete, "")
+			continue
+		}
+
+		switch err := helper.EvictPod(pod, gv); {
+		case err == nil:
+			in.setPod(pod.Namespace, pod.Name, PodDrainPhaseWouldEvict, "")
+		case k8serrors.IsTooManyRequests(err):
+			in.setPod(pod.Namespace, pod.Name, PodDrainPhaseBlocked, err.Error())
+		case k8serrors.IsNotFound(err):
+			in.setPod(pod.Namespace, pod.Name, PodDrainPhaseSkipped, "pod no longer exists")
+		default:
+			in.setPod(pod.Namespace, pod.Name, PodDrainPhaseFailed, err.Error())
+		}
+	}
+
+	if len(errs) > 0 {
+		in.log(fmt.Sprintf("drain would fail: %v", utilerrors.NewAggregate(errs)))
+	}
 
 	return nil
 }
+
+// getPodsForDeletion records the state of all Pods on the Node and returns the ones to evict or
+// delete. Pods that cannot be deleted with the drain options are reported as blocked and
+// returned as errors.
+func (in *drainOperation) getPodsForDeletion(helper *drain.Helper) ([]v1.Pod, []error, error) {
+	list, errs := helper.GetPodsForDeletion(in.status.Node)
+	if list == nil {
+		return nil, nil, fmt.Errorf("error listing pods: %w", utilerrors.NewAggregate(errs))
+	}
+
+	pods := list.Pods()
+	for _, pod := range pods {
+		in.setPod(pod.Namespace, pod.Name, PodDrainPhasePending, "")
+	}
+
+	for _, warning := range strings.Split(list.Warnings(), "; ") {
+		message, refs := parsePodDeleteMessage(warning)
+		for _, ref := range refs {
+			phase := PodDrainPhaseSkipped
+			if containsPod(pods, ref[0], ref[1]) {
+				phase = PodDrainPhasePending
+			}
+
+			in.setPod(ref[0], ref[1], phase, message)
+		}
+	}
+
+	for _, err := range errs {
+		message, refs := parsePodDeleteMessage(strings.TrimPrefix(err.Error(), "cannot delete "))
+		for _, ref := range refs {
+			in.setPod(ref[0], ref[1], PodDrainPhaseBlocked, message)
+		}
+	}
+
+	return pods, errs, nil
+}
+
+func (in *drainOperation) logError(line string) {
+	if namespace, name, message, ok := parseEvictionRetry(line); ok {
+		in.setPod(namespace, name, PodDrainPhaseBlocked, message)
+		return
+	}
+
+	in.log(line)
+}
+
+// parsePodDeleteMessage splits a message of the drain helper in form of
+// "<message>: <namespace>/<name>, <namespace>/<name>" into the message and Pod references.
+func parsePodDeleteMessage(value string) (string, [][2]string) {
+	i := strings.LastIndex(value, ": ")
+	if i < 0 {
+		return value, nil
+	}
+
+	refs := make([][2]string, 0)
+	for _, ref := range strings.Split(value[i+2:], ", ") {
+		if namespace, name, ok := strings.Cut(ref, "/"); ok {
+			refs = append(refs, [2]string{namespace, name})
+		}
+	}
+
+	return value[:i], refs
+}
+
+func containsPod(pods []v1.Pod, namespace, name string) bool {
+	for _, pod := range pods {
+		if pod.Namespace == namespace && pod.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func podDrainPhase(usingEviction bool, eviction, deletion PodDrainPhase) PodDrainPhase {
+	if usingEviction {
+		return eviction
+	}
+
+	return deletion
+}
@@ -0,0 +1,247 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func newDrainTestPod(name string, owner *metaV1.OwnerReference) *v1.Pod {
+	pod := &v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: name},
+		Spec:       v1.PodSpec{NodeName: "node-1"},
+		Status:     v1.PodStatus{Phase: v1.PodRunning},
+	}
+
+	if owner != nil {
+		pod.OwnerReferences = []metaV1.OwnerReference{*owner}
+	}
+
+	return pod
+}
+
+func newDrainTestClient(objects ...runtime.Object) *fake.Clientset {
+	controller := true
+	objects = append(objects,
+		&v1.Node{ObjectMeta: metaV1.ObjectMeta{Name: "node-1"}},
+		&appsv1.DaemonSet{ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "agent"}},
+		newDrainTestPod("web", &metaV1.OwnerReference{Kind: "ReplicaSet", Name: "web", Controller: &controller}),
+		newDrainTestPod("agent", &metaV1.OwnerReference{Kind: "DaemonSet", Name: "agent", Controller: &controller}),
+	)
+
+	client := fake.NewClientset(objects...)
+	client.Resources = []*metaV1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metaV1.APIResource{{Name: "pods/eviction", Kind: "Eviction", Group: "policy", Version: "v1"}},
+	}}
+
+	return client
+}
+
+// reactToEviction calls fn for evictions of Pods and reports its error back to the client.
+func reactToEviction(client *fake.Clientset, fn func(eviction *policyv1.Eviction) error) {
+	client.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+
+		return true, nil, fn(action.(k8stesting.CreateAction).GetObject().(*policyv1.Eviction))
+	})
+}
+
+func waitForNodeDrain(t *testing.T, client *fake.Clientset, id string, fn func(DrainEvent) error) *NodeDrainStatus {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := WatchNodeDrain(ctx, client, "node-1", id, fn); err != nil {
+		t.Fatalf("WatchNodeDrain() returned error: %v", err)
+	}
+
+	status, err := GetNodeDrain(client, "node-1", id)
+	if err != nil {
+		t.Fatalf("GetNodeDrain() returned error: %v", err)
+	}
+
+	return status
+}
+
+func podPhases(status *NodeDrainStatus) map[string]PodDrainPhase {
+	result := make(map[string]PodDrainPhase)
+	for _, pod := range status.Pods {
+		result[pod.Name] = pod.Phase
+	}
+
+	return result
+}
+
+func ignoreDrainEvents(DrainEvent) error {
+	return nil
+}
+
+func TestStartNodeDrainDryRun(t *testing.T) {
+	client := newDrainTestClient(newDrainTestPod("bare", nil), newDrainTestPod("db", nil))
+	reactToEviction(client, func(eviction *policyv1.Eviction) error {
+		if len(eviction.DeleteOptions.DryRun) == 0 {
+			t.Errorf("expected dry run eviction of %s", eviction.Name)
+		}
+
+		if eviction.Name == "db" {
+			return k8serrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 0)
+		}
+
+		return nil
+	})
+
+	dryRun := true
+	status, err := StartNodeDrain(client, "node-1", &NodeDrainSpec{DryRun: &dryRun})
+	if err != nil {
+		t.Fatalf("StartNodeDrain() returned error: %v", err)
+	}
+
+	status = waitForNodeDrain(t, client, status.ID, ignoreDrainEvents)
+	if status.Phase != DrainPhaseSucceeded || !status.DryRun {
+		t.Errorf("expected succeeded dry run, got %+v", status)
+	}
+
+	expected := map[string]PodDrainPhase{
+		"web":   PodDrainPhaseWouldEvict,
+		"bare":  PodDrainPhaseWouldEvict,
+		"db":    PodDrainPhaseBlocked,
+		"agent": PodDrainPhaseSkipped,
+	}
+	if actual := podPhases(status); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected pods %v, got %v", expected, actual)
+	}
+
+	node, _ := client.CoreV1().Nodes().Get(context.TODO(), "node-1", metaV1.GetOptions{})
+	if node.Spec.Unschedulable {
+		t.Error("dry run should not cordon the node")
+	}
+}
+
+func TestStartNodeDrain(t *testing.T) {
+	client := newDrainTestClient()
+	reactToEviction(client, func(eviction *policyv1.Eviction) error {
+		return client.Tracker().Delete(v1.SchemeGroupVersion.WithResource("pods"), eviction.Namespace, eviction.Name)
+	})
+
+	status, err := StartNodeDrain(client, "node-1", nil)
+	if err != nil {
+		t.Fatalf("StartNodeDrain() returned error: %v", err)
+	}
+
+	var events []DrainEvent
+	status = waitForNodeDrain(t, client, status.ID, func(event DrainEvent) error {
+		events = append(events, event)
+		return nil
+	})
+
+	if status.Phase != DrainPhaseSucceeded || status.CompletionTime == nil {
+		t.Errorf("expected succeeded drain, got %+v", status)
+	}
+
+	expected := map[string]PodDrainPhase{"web": PodDrainPhaseEvicted, "agent": PodDrainPhaseSkipped}
+	if actual := podPhases(status); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected pods %v, got %v", expected, actual)
+	}
+
+	if last := events[len(events)-1]; last.Phase != DrainPhaseSucceeded {
+		t.Errorf("expected last event to finish the drain, got %+v", last)
+	}
+
+	node, _ := client.CoreV1().Nodes().Get(context.TODO(), "node-1", metaV1.GetOptions{})
+	if !node.Spec.Unschedulable {
+		t.Error("expected node to be cordoned")
+	}
+}
+
+func TestCancelNodeDrain(t *testing.T) {
+	client := newDrainTestClient()
+	release := make(chan struct{})
+	reactToEviction(client, func(eviction *policyv1.Eviction) error {
+		<-release
+		return fmt.Errorf("eviction interrupted")
+	})
+
+	status, err := StartNodeDrain(client, "node-1", nil)
+	if err != nil {
+		t.Fatalf("StartNodeDrain() returned error: %v", err)
+	}
+
+	// The fake client is locked while the eviction is in progress, so the operation is used
+	// directly until it finishes.
+	operation, err := drainOperations.Get("node-1", status.ID)
+	if err != nil {
+		t.Fatalf("drainOperations.Get() returned error: %v", err)
+	}
+
+	if err := drainOperations.Add(newDrainOperation("other", "node-1", false, func() {})); err == nil {
+		t.Error("expected only one running drain operation per node")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	err = operation.watch(ctx, func(event DrainEvent) error {
+		if event.Pod != nil && event.Pod.Phase == PodDrainPhaseEvicting {
+			operation.cancel()
+			close(release)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("watch() returned error: %v", err)
+	}
+
+	status, err = CancelNodeDrain(client, "node-1", status.ID)
+	if err != nil || status.Phase != DrainPhaseCancelled {
+		t.Errorf("CancelNodeDrain() = %+v, %v, expected cancelled drain", status, err)
+	}
+
+	if _, err := GetNodeDrain(client, "node-1", "missing"); err == nil {
+		t.Error("GetNodeDrain() should fail for missing operation")
+	}
+}
+
+func TestParseDrainMessages(t *testing.T) {
+	namespace, name, message, ok := parseEvictionRetry(`error when evicting pods/"db-0" -n "data" (will retry after 5s): ` +
+		`Cannot evict pod as it would violate the pod's disruption budget.`)
+	if !ok || namespace != "data" || name != "db-0" || message != "Cannot evict pod as it would violate the pod's disruption budget." {
+		t.Errorf("parseEvictionRetry() = %q, %q, %q, %t", namespace, name, message, ok)
+	}
+
+	if _, _, _, ok := parseEvictionRetry(`evicting pod data/db-0`); ok {
+		t.Error("parseEvictionRetry() should not match other messages")
+	}
+
+	message, refs := parsePodDeleteMessage("Pods with local storage (use --delete-emptydir-data to override): data/db-0, default/cache")
+	expected := [][2]string{{"data", "db-0"}, {"default", "cache"}}
+	if message != "Pods with local storage (use --delete-emptydir-data to override)" || !reflect.DeepEqual(refs, expected) {
+		t.Errorf("parsePodDeleteMessage() = %q, %v", message, refs)
+	}
+}
@@ -0,0 +1,331 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"k8s.io/dashboard/errors"
+)
+
+// DrainPhase is the phase of a node drain operation.
+type DrainPhase string
+
+const (
+	DrainPhaseRunning   DrainPhase = "Running"
+	DrainPhaseSucceeded DrainPhase = "Succeeded"
+	DrainPhaseFailed    DrainPhase = "Failed"
+	DrainPhaseCancelled DrainPhase = "Cancelled"
+)
+
+// PodDrainPhase is the phase of a single Pod in a node drain operation.
+type PodDrainPhase string
+
+const (
+	// PodDrainPhasePending means that the Pod will be evicted or deleted.
+	PodDrainPhasePending  PodDrainPhase = "Pending"
+	PodDrainPhaseEvicting PodDrainPhase = "Evicting"
+	PodDrainPhaseDeleting PodDrainPhase = "Deleting"
+	PodDrainPhaseEvicted  PodDrainPhase = "Evicted"
+	PodDrainPhaseDeleted  PodDrainPhase = "Deleted"
+	// PodDrainPhaseBlocked means that the Pod cannot be evicted, either because of a
+	// PodDisruptionBudget or because the drain options do not allow to delete it.
+	PodDrainPhaseBlocked PodDrainPhase = "Blocked"
+	PodDrainPhaseFailed  PodDrainPhase = "Failed"
+	// PodDrainPhaseSkipped means that the Pod stays on the Node, e.g. DaemonSet-managed Pods.
+	PodDrainPhaseSkipped PodDrainPhase = "Skipped"
+	// PodDrainPhaseWouldEvict and PodDrainPhaseWouldDelete are only used in dry run.
+	PodDrainPhaseWouldEvict  PodDrainPhase = "WouldEvict"
+	PodDrainPhaseWouldDelete PodDrainPhase = "WouldDelete"
+)
+
+// drainOperationRetention is how long finished drain operations can be still retrieved.
+const drainOperationRetention = time.Hour
+
+// PodDrainStatus is the state of a single Pod in a node drain operation.
+type PodDrainStatus struct {
+	Namespace string        `json:"namespace"`
+	Name      string        `json:"name"`
+	Phase     PodDrainPhase `json:"phase"`
+	Message   string        `json:"message,omitempty"`
+}
+
+// NodeDrainStatus is the state of a node drain operation.
+type NodeDrainStatus struct {
+	ID             string           `json:"id"`
+	Node           string           `json:"node"`
+	DryRun         bool             `json:"dryRun"`
+	Phase          DrainPhase       `json:"phase"`
+	StartTime      metaV1.Time      `json:"startTime"`
+	CompletionTime *metaV1.Time     `json:"completionTime,omitempty"`
+	Pods           []PodDrainStatus `json:"pods"`
+	Error          string           `json:"error,omitempty"`
+}
+
+// DrainEvent is a single progress update of a node drain operation. It carries either a Pod
+// state change, a log message or both. Phase is the phase of the operation after the event.
+type DrainEvent struct {
+	Time    metaV1.Time     `json:"time"`
+	Phase   DrainPhase      `json:"phase"`
+	Pod     *PodDrainStatus `json:"pod,omitempty"`
+	Message string          `json:"message,omitempty"`
+}
+
+// drainOperation tracks a node drain running in the background.
+type drainOperation struct {
+	lock   sync.RWMutex
+	status NodeDrainStatus
+	events []DrainEvent
+	// changed is closed and replaced every time an event is recorded.
+	changed chan struct{}
+	cancel  context.CancelFunc
+}
+
+func newDrainOperation(id, node string, dryRun bool, cancel context.CancelFunc) *drainOperation {
+	return &drainOperation{
+		status: NodeDrainStatus{
+			ID:        id,
+			Node:      node,
+			DryRun:    dryRun,
+			Phase:     DrainPhaseRunning,
+			StartTime: metaV1.Now(),
+			Pods:      make([]PodDrainStatus, 0),
+		},
+		events:  make([]DrainEvent, 0),
+		changed: make(chan struct{}),
+		cancel:  cancel,
+	}
+}
+
+// record appends the event and wakes up all watchers. Caller has to hold the lock.
+func (in *drainOperation) record(pod *PodDrainStatus, message string) {
+	in.events = append(in.events, DrainEvent{Time: metaV1.Now(), Phase: in.status.Phase, Pod: pod, Message: message})
+	close(in.changed)
+	in.changed = make(chan struct{})
+}
+
+// setPod updates the state of the Pod, adding it to the operation when it is not tracked yet.
+func (in *drainOperation) setPod(namespace, name string, phase PodDrainPhase, message string) {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+
+	i := in.findPod(namespace, name)
+	if i < 0 {
+		in.status.Pods = append(in.status.Pods, PodDrainStatus{Namespace: namespace, Name: name})
+		i = len(in.status.Pods) - 1
+	}
+
+	pod := &in.status.Pods[i]
+	// Eviction of a Pod blocked by a PodDisruptionBudget is retried until it succeeds, don't
+	// report every retry.
+	if pod.Phase == PodDrainPhaseBlocked && phase == PodDrainPhaseEvicting {
+		return
+	}
+
+	if pod.Phase == phase && pod.Message == message {
+		return
+	}
+
+	pod.Phase = phase
+	pod.Message = message
+	updated := *pod
+	in.record(&updated, "")
+}
+
+func (in *drainOperation) findPod(namespace, name string) int {
+	for i, pod := range in.status.Pods {
+		if pod.Namespace == namespace && pod.Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (in *drainOperation) log(message string) {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+	in.record(nil, message)
+}
+
+func (in *drainOperation) finish(phase DrainPhase, err error) {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+
+	now := metaV1.Now()
+	in.status.Phase = phase
+	in.status.CompletionTime = &now
+	message := fmt.Sprintf("drain %s", phase)
+	if err != nil {
+		in.status.Error = err.Error()
+		message = fmt.Sprintf("%s: %s", message, err.Error())
+	}
+
+	in.record(nil, message)
+}
+
+func (in *drainOperation) finished() bool {
+	in.lock.RLock()
+	defer in.lock.RUnlock()
+	return in.status.Phase != DrainPhaseRunning
+}
+
+func (in *drainOperation) expired(now time.Time) bool {
+	in.lock.RLock()
+	defer in.lock.RUnlock()
+	return in.status.CompletionTime != nil && now.Sub(in.status.CompletionTime.Time) > drainOperationRetention
+}
+
+func (in *drainOperation) getStatus() *NodeDrainStatus {
+	in.lock.RLock()
+	defer in.lock.RUnlock()
+
+	status := in.status
+	status.Pods = append(make([]PodDrainStatus, 0, len(in.status.Pods)), in.status.Pods...)
+	return &status
+}
+
+// watch calls fn for every event of the operation, starting with the ones already recorded.
+// It returns when the operation finishes, the context is done or fn returns an error.
+func (in *drainOperation) watch(ctx context.Context, fn func(DrainEvent) error) error {
+	next := 0
+	for {
+		in.lock.RLock()
+		events := in.events[next:]
+		changed := in.changed
+		done := in.status.Phase != DrainPhaseRunning
+		in.lock.RUnlock()
+
+		for _, event := range events {
+			if err := fn(event); err != nil {
+				return err
+			}
+		}
+
+		next += len(events)
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// drainOperationMap keeps track of node drain operations by their IDs.
+type drainOperationMap struct {
+	Operations map[string]*drainOperation
+	Lock       sync.RWMutex
+}
+
+var drainOperations = drainOperationMap{Operations: make(map[string]*drainOperation)}
+
+// Add registers the operation. Only one drain operation can run for a Node at a time.
+func (in *drainOperationMap) Add(operation *drainOperation) error {
+	in.Lock.Lock()
+	defer in.Lock.Unlock()
+
+	now := time.Now()
+	for id, existing := range in.Operations {
+		if existing.expired(now) {
+			delete(in.Operations, id)
+			continue
+		}
+
+		if existing.status.Node == operation.status.Node && !existing.finished() {
+			return errors.NewBadRequest(fmt.Sprintf("node %s is already being drained by operation %s",
+				operation.status.Node, existing.status.ID))
+		}
+	}
+
+	in.Operations[operation.status.ID] = operation
+	return nil
+}
+
+// Get returns the operation with the ID draining the Node.
+func (in *drainOperationMap) Get(node, id string) (*drainOperation, error) {
+	in.Lock.RLock()
+	defer in.Lock.RUnlock()
+
+	operation, exists := in.Operations[id]
+	if !exists || operation.status.Node != node {
+		return nil, errors.NewNotFound(fmt.Sprintf("drain operation %s of node %s not found", id, node))
+	}
+
+	return operation, nil
+}
+
+func genDrainOperationId() (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bytes), nil
+}
+
+// evictionRetryPattern matches the message the drain helper writes when an eviction is refused
+// with 429 Too Many Requests, i.e. when it would violate a PodDisruptionBudget.
+var evictionRetryPattern = regexp.MustCompile(`^error when evicting pods/"([^"]+)" -n "([^"]+)" \(will retry after [^)]+\): (.*)$`)
+
+// parseEvictionRetry returns the Pod whose eviction is blocked and the reason, if the line
+// reports a blocked eviction.
+func parseEvictionRetry(line string) (namespace, name, message string, ok bool) {
+	match := evictionRetryPattern.FindStringSubmatch(line)
+	if match == nil {
+		return "", "", "", false
+	}
+
+	return match[2], match[1], match[3], true
+}
+
+// lineWriter calls fn for every complete line written to it.
+type lineWriter struct {
+	lock   sync.Mutex
+	buffer []byte
+	fn     func(line string)
+}
+
+func (in *lineWriter) Write(p []byte) (int, error) {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+
+	in.buffer = append(in.buffer, p...)
+	for {
+		i := bytes.IndexByte(in.buffer, '\n')
+		if i < 0 {
+			break
+		}
+
+		if line := string(bytes.TrimSpace(in.buffer[:i])); len(line) > 0 {
+			in.fn(line)
+		}
+		in.buffer = in.buffer[i+1:]
+	}
+
+	return len(p), nil
+}
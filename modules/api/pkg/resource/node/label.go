@@ -0,0 +1,98 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	k8sClient "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/args"
+	"k8s.io/dashboard/errors"
+)
+
+// NodeLabelsSpec is a specification of Node label changes.
+type NodeLabelsSpec struct {
+	// Set adds labels or overwrites values of existing labels.
+	Set map[string]string `json:"set,omitempty"`
+
+	// Remove lists keys of labels to remove. Missing labels are ignored.
+	Remove []string `json:"remove,omitempty"`
+}
+
+// UpdateNodeLabels sets and removes labels of the Node.
+func UpdateNodeLabels(client k8sClient.Interface, name string, spec *NodeLabelsSpec) (*NodeMaintenanceStatus, error) {
+	if err := validateLabels(spec); err != nil {
+		return nil, err
+	}
+
+	klog.V(args.LogLevelVerbose).Infof("Updating labels of %s node", name)
+	return updateNode(client, name, func(node *v1.Node) error {
+		if node.Labels == nil {
+			node.Labels = make(map[string]string)
+		}
+
+		for _, key := range spec.Remove {
+			delete(node.Labels, key)
+		}
+
+		for key, value := range spec.Set {
+			node.Labels[key] = value
+		}
+
+		return nil
+	})
+}
+
+// validateLabels validates label keys and values the same way as the API server does.
+func validateLabels(spec *NodeLabelsSpec) error {
+	if len(spec.Set) == 0 && len(spec.Remove) == 0 {
+		return errors.NewBadRequest("no labels to set or remove")
+	}
+
+	problems := make([]string, 0)
+	keys := make([]string, 0, len(spec.Set))
+	for key := range spec.Set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, msg := range validation.IsQualifiedName(key) {
+			problems = append(problems, fmt.Sprintf("invalid key %q: %s", key, msg))
+		}
+
+		for _, msg := range validation.IsValidLabelValue(spec.Set[key]) {
+			problems = append(problems, fmt.Sprintf("invalid value %q of %q: %s", spec.Set[key], key, msg))
+		}
+	}
+
+	for _, key := range spec.Remove {
+		if _, exists := spec.Set[key]; exists {
+			problems = append(problems, fmt.Sprintf("label %q cannot be both set and removed", key))
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.NewBadRequest(strings.Join(problems, "; "))
+	}
+
+	return nil
+}
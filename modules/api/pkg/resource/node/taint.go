@@ -0,0 +1,160 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	k8sClient "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/args"
+	"k8s.io/dashboard/errors"
+)
+
+// TaintSpec is a specification of a Node taint.
+type TaintSpec struct {
+	Key    string         `json:"key"`
+	Value  string         `json:"value,omitempty"`
+	Effect v1.TaintEffect `json:"effect"`
+}
+
+var supportedTaintEffects = []v1.TaintEffect{v1.TaintEffectNoSchedule, v1.TaintEffectPreferNoSchedule, v1.TaintEffectNoExecute}
+
+// AddNodeTaint adds the taint to the Node. It fails when the Node already has a taint with the
+// same key and effect.
+func AddNodeTaint(client k8sClient.Interface, name string, spec *TaintSpec) (*NodeMaintenanceStatus, error) {
+	if err := validateTaint(spec); err != nil {
+		return nil, err
+	}
+
+	klog.V(args.LogLevelVerbose).Infof("Adding %s taint to %s node", spec, name)
+	return updateNode(client, name, func(node *v1.Node) error {
+		if findTaint(node.Spec.Taints, spec.Key, spec.Effect) >= 0 {
+			return errors.NewBadRequest(fmt.Sprintf("node %s already has taint %s:%s", name, spec.Key, spec.Effect))
+		}
+
+		node.Spec.Taints = append(node.Spec.Taints, spec.toTaint())
+		return nil
+	})
+}
+
+// UpdateNodeTaint updates the value of the Node taint with the same key and effect.
+func UpdateNodeTaint(client k8sClient.Interface, name string, spec *TaintSpec) (*NodeMaintenanceStatus, error) {
+	if err := validateTaint(spec); err != nil {
+		return nil, err
+	}
+
+	klog.V(args.LogLevelVerbose).Infof("Updating %s taint of %s node", spec, name)
+	return updateNode(client, name, func(node *v1.Node) error {
+		i := findTaint(node.Spec.Taints, spec.Key, spec.Effect)
+		if i < 0 {
+			return errors.NewNotFound(fmt.Sprintf("node %s has no taint %s:%s", name, spec.Key, spec.Effect))
+		}
+
+		if node.Spec.Taints[i].Value != spec.Value {
+			node.Spec.Taints[i] = spec.toTaint()
+		}
+
+		return nil
+	})
+}
+
+// RemoveNodeTaint removes taints with the key from the Node. When the effect is empty, taints
+// with the key and any effect are removed.
+func RemoveNodeTaint(client k8sClient.Interface, name, key string, effect v1.TaintEffect) (*NodeMaintenanceStatus, error) {
+	if len(effect) > 0 {
+		if err := validateTaintEffect(effect); err != nil {
+			return nil, errors.NewBadRequest(err.Error())
+		}
+	}
+
+	klog.V(args.LogLevelVerbose).Infof("Removing %s:%s taint from %s node", key, effect, name)
+	return updateNode(client, name, func(node *v1.Node) error {
+		taints := make([]v1.Taint, 0, len(node.Spec.Taints))
+		for _, taint := range node.Spec.Taints {
+			if taint.Key != key || (len(effect) > 0 && taint.Effect != effect) {
+				taints = append(taints, taint)
+			}
+		}
+
+		if len(taints) == len(node.Spec.Taints) {
+			return errors.NewNotFound(fmt.Sprintf("node %s has no taint %s:%s", name, key, effect))
+		}
+
+		node.Spec.Taints = taints
+		return nil
+	})
+}
+
+func (in *TaintSpec) String() string {
+	return fmt.Sprintf("%s=%s:%s", in.Key, in.Value, in.Effect)
+}
+
+func (in *TaintSpec) toTaint() v1.Taint {
+	taint := v1.Taint{Key: in.Key, Value: in.Value, Effect: in.Effect}
+	if in.Effect == v1.TaintEffectNoExecute {
+		now := metaV1.Now()
+		taint.TimeAdded = &now
+	}
+
+	return taint
+}
+
+func findTaint(taints []v1.Taint, key string, effect v1.TaintEffect) int {
+	for i, taint := range taints {
+		if taint.Key == key && taint.Effect == effect {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// validateTaint validates the taint the same way as the API server does.
+func validateTaint(spec *TaintSpec) error {
+	problems := make([]string, 0)
+	for _, msg := range validation.IsQualifiedName(spec.Key) {
+		problems = append(problems, fmt.Sprintf("invalid key %q: %s", spec.Key, msg))
+	}
+
+	for _, msg := range validation.IsValidLabelValue(spec.Value) {
+		problems = append(problems, fmt.Sprintf("invalid value %q: %s", spec.Value, msg))
+	}
+
+	if err := validateTaintEffect(spec.Effect); err != nil {
+		problems = append(problems, err.Error())
+	}
+
+	if len(problems) > 0 {
+		return errors.NewBadRequest(strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+func validateTaintEffect(effect v1.TaintEffect) error {
+	for _, supported := range supportedTaintEffects {
+		if effect == supported {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid effect %q: must be one of %v", effect, supportedTaintEffects)
+}
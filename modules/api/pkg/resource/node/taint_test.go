@@ -0,0 +1,166 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newMaintenanceTestClient() *fake.Clientset {
+	return fake.NewClientset(&v1.Node{
+		ObjectMeta: metaV1.ObjectMeta{Name: "node-1", Labels: map[string]string{"role": "worker", "zone": "a"}},
+		Spec: v1.NodeSpec{
+			Taints: []v1.Taint{
+				{Key: "dedicated", Value: "gpu", Effect: v1.TaintEffectNoSchedule},
+				{Key: "dedicated", Value: "gpu", Effect: v1.TaintEffectPreferNoSchedule},
+				{Key: "example.com/maintenance", Effect: v1.TaintEffectNoSchedule},
+			},
+		},
+	})
+}
+
+func taintKeys(taints []v1.Taint) []string {
+	result := make([]string, 0, len(taints))
+	for _, taint := range taints {
+		result = append(result, taint.Key+"="+taint.Value+":"+string(taint.Effect))
+	}
+
+	return result
+}
+
+func TestCordonNode(t *testing.T) {
+	client := newMaintenanceTestClient()
+
+	status, err := CordonNode(client, "node-1")
+	if err != nil || !status.Unschedulable {
+		t.Fatalf("CordonNode() = %+v, %v, expected unschedulable node", status, err)
+	}
+
+	node, _ := client.CoreV1().Nodes().Get(context.TODO(), "node-1", metaV1.GetOptions{})
+	if !node.Spec.Unschedulable {
+		t.Error("expected node to be cordoned")
+	}
+
+	status, err = UncordonNode(client, "node-1")
+	if err != nil || status.Unschedulable {
+		t.Fatalf("UncordonNode() = %+v, %v, expected schedulable node", status, err)
+	}
+
+	if _, err := CordonNode(client, "missing"); err == nil {
+		t.Error("CordonNode() should fail for missing node")
+	}
+}
+
+func TestNodeTaints(t *testing.T) {
+	client := newMaintenanceTestClient()
+
+	status, err := AddNodeTaint(client, "node-1", &TaintSpec{Key: "drain", Effect: v1.TaintEffectNoExecute})
+	if err != nil {
+		t.Fatalf("AddNodeTaint() returned error: %v", err)
+	}
+
+	if last := status.Taints[len(status.Taints)-1]; last.Key != "drain" || last.TimeAdded == nil {
+		t.Errorf("expected added NoExecute taint with time, got %+v", last)
+	}
+
+	if _, err := AddNodeTaint(client, "node-1", &TaintSpec{Key: "dedicated", Effect: v1.TaintEffectNoSchedule}); err == nil {
+		t.Error("AddNodeTaint() should fail for existing taint")
+	}
+
+	status, err = UpdateNodeTaint(client, "node-1", &TaintSpec{Key: "dedicated", Value: "cpu", Effect: v1.TaintEffectNoSchedule})
+	if err != nil {
+		t.Fatalf("UpdateNodeTaint() returned error: %v", err)
+	}
+
+	expected := []string{"dedicated=cpu:NoSchedule", "dedicated=gpu:PreferNoSchedule", "example.com/maintenance=:NoSchedule", "drain=:NoExecute"}
+	if actual := taintKeys(status.Taints); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected taints %v, got %v", expected, actual)
+	}
+
+	if _, err := UpdateNodeTaint(client, "node-1", &TaintSpec{Key: "missing", Effect: v1.TaintEffectNoSchedule}); err == nil {
+		t.Error("UpdateNodeTaint() should fail for missing taint")
+	}
+
+	status, err = RemoveNodeTaint(client, "node-1", "dedicated", "")
+	if err != nil {
+		t.Fatalf("RemoveNodeTaint() returned error: %v", err)
+	}
+
+	expected = []string{"example.com/maintenance=:NoSchedule", "drain=:NoExecute"}
+	if actual := taintKeys(status.Taints); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected taints %v, got %v", expected, actual)
+	}
+
+	if _, err := RemoveNodeTaint(client, "node-1", "drain", v1.TaintEffectNoSchedule); err == nil {
+		t.Error("RemoveNodeTaint() should fail for missing taint effect")
+	}
+}
+
+func TestValidateTaint(t *testing.T) {
+	cases := []struct {
+		spec  TaintSpec
+		valid bool
+	}{
+		{TaintSpec{Key: "example.com/gpu", Value: "true", Effect: v1.TaintEffectNoSchedule}, true},
+		{TaintSpec{Key: "gpu", Effect: v1.TaintEffectPreferNoSchedule}, true},
+		{TaintSpec{Key: "", Effect: v1.TaintEffectNoSchedule}, false},
+		{TaintSpec{Key: "-gpu", Effect: v1.TaintEffectNoSchedule}, false},
+		{TaintSpec{Key: "gpu", Value: "a b", Effect: v1.TaintEffectNoSchedule}, false},
+		{TaintSpec{Key: "gpu", Effect: "NoRun"}, false},
+		{TaintSpec{Key: "gpu"}, false},
+	}
+
+	for _, c := range cases {
+		if err := validateTaint(&c.spec); (err == nil) != c.valid {
+			t.Errorf("validateTaint(%+v) returned %v, expected valid %t", c.spec, err, c.valid)
+		}
+	}
+}
+
+func TestUpdateNodeLabels(t *testing.T) {
+	client := newMaintenanceTestClient()
+
+	status, err := UpdateNodeLabels(client, "node-1", &NodeLabelsSpec{
+		Set:    map[string]string{"role": "gpu", "example.com/rack": "r1"},
+		Remove: []string{"zone", "missing"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateNodeLabels() returned error: %v", err)
+	}
+
+	expected := map[string]string{"role": "gpu", "example.com/rack": "r1"}
+	if !reflect.DeepEqual(status.Labels, expected) {
+		t.Errorf("expected labels %v, got %v", expected, status.Labels)
+	}
+
+	invalid := []*NodeLabelsSpec{
+		{},
+		{Set: map[string]string{"bad key": "value"}},
+		{Set: map[string]string{"key": "bad value"}},
+		{Set: map[string]string{"key": "value"}, Remove: []string{"key"}},
+	}
+
+	for _, spec := range invalid {
+		if _, err := UpdateNodeLabels(client, "node-1", spec); err == nil {
+			t.Errorf("UpdateNodeLabels(%+v) should fail", spec)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// Overview summarizes the health of a namespace or of the whole cluster.
+type Overview struct {
+	// Workloads contains counts and status breakdowns of all workload kinds.
+	Workloads []WorkloadSummary `json:"workloads"`
+
+	// Resources contains counts of other namespaced kinds.
+	Resources []ResourceCount `json:"resources"`
+
+	Events EventSummary `json:"events"`
+
+	ResourceQuotas []QuotaUsage `json:"resourceQuotas"`
+
+	// Nodes is only set for overviews of the whole cluster.
+	Nodes *NodeSummary `json:"nodes,omitempty"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// WorkloadSummary contains the number of objects of a workload kind and their status.
+type WorkloadSummary struct {
+	Kind   types.ResourceKind    `json:"kind"`
+	Count  int                   `json:"count"`
+	Status common.ResourceStatus `json:"status"`
+}
+
+// ResourceCount contains the number of objects of a kind.
+type ResourceCount struct {
+	Kind  types.ResourceKind `json:"kind"`
+	Count int                `json:"count"`
+}
+
+// GetOverview returns the overview of namespaces selected by the query. Node readiness is only
+// included when all namespaces are selected.
+func GetOverview(client kubernetes.Interface, nsQuery *common.NamespaceQuery) (*Overview, error) {
+	klog.V(4).InfoS("Getting overview", "namespace", nsQuery.ToRequestParam())
+
+	// Pods and events are read by every workload list and events once more for the event summary.
+	const podReads, eventReads, replicaSetReads = 7, 8, 2
+	channels := &common.ResourceChannels{
+		PodList:                   common.GetPodListChannel(client, nsQuery, podReads),
+		EventList:                 common.GetEventListChannel(client, nsQuery, eventReads),
+		ReplicaSetList:            common.GetReplicaSetListChannel(client, nsQuery, replicaSetReads),
+		DeploymentList:            common.GetDeploymentListChannel(client, nsQuery, 1),
+		StatefulSetList:           common.GetStatefulSetListChannel(client, nsQuery, 1),
+		DaemonSetList:             common.GetDaemonSetListChannel(client, nsQuery, 1),
+		JobList:                   common.GetJobListChannel(client, nsQuery, 1),
+		CronJobList:               common.GetCronJobListChannel(client, nsQuery, 1),
+		ReplicationControllerList: common.GetReplicationControllerListChannel(client, nsQuery, 1),
+		ServiceList:               common.GetServiceListChannel(client, nsQuery, 1),
+		IngressList:               common.GetIngressListChannel(client, nsQuery, 1),
+		ConfigMapList:             common.GetConfigMapListChannel(client, nsQuery, 1),
+		SecretList:                common.GetSecretListChannel(client, nsQuery, 1),
+		PersistentVolumeClaimList: common.GetPersistentVolumeClaimListChannel(client, nsQuery, 1),
+		ResourceQuotaList:         common.GetResourceQuotaListChannel(client, nsQuery, 1),
+	}
+
+	// Namespace query without namespaces selects all of them.
+	if nsQuery.Matches(v1.NamespaceAll) {
+		channels.NodeList = common.GetNodeListChannel(client, 1)
+	}
+
+	return GetOverviewFromChannels(channels)
+}
+
+// GetOverviewFromChannels returns the overview reading required resource lists from the channels.
+// Pod and event lists must be readable 7 and 8 times respectively, the replica set list twice and
+// all other lists once. Nodes are skipped when the node list channel is not set.
+func GetOverviewFromChannels(channels *common.ResourceChannels) (*Overview, error) {
+	workloads, nonCriticalErrors, err := getWorkloads(channels)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Overview{Workloads: workloads}
+
+	result.Resources, nonCriticalErrors, err = getResourceCounts(channels, nonCriticalErrors)
+	if err != nil {
+		return nil, err
+	}
+
+	events := <-channels.EventList.List
+	nonCriticalErrors, err = errors.AppendError(<-channels.EventList.Error, nonCriticalErrors)
+	if err != nil {
+		return nil, err
+	}
+	result.Events = toEventSummary(events.Items)
+
+	quotas := <-channels.ResourceQuotaList.List
+	nonCriticalErrors, err = errors.AppendError(<-channels.ResourceQuotaList.Error, nonCriticalErrors)
+	if err != nil {
+		return nil, err
+	}
+	result.ResourceQuotas = toQuotaUsages(quotas.Items)
+
+	if channels.NodeList.List != nil {
+		nodes := <-channels.NodeList.List
+		nonCriticalErrors, err = errors.AppendError(<-channels.NodeList.Error, nonCriticalErrors)
+		if err != nil {
+			return nil, err
+		}
+		result.Nodes = toNodeSummary(nodes.Items)
+	}
+
+	result.Errors = nonCriticalErrors
+	return result, nil
+}
+
+func getResourceCounts(channels *common.ResourceChannels, nonCriticalErrors []error) ([]ResourceCount, []error, error) {
+	result := make([]ResourceCount, 0)
+	add := func(kind types.ResourceKind, count int, err error) error {
+		var criticalError error
+		nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+		result = append(result, ResourceCount{Kind: kind, Count: count})
+		return criticalError
+	}
+
+	services := <-channels.ServiceList.List
+	if err := add(types.ResourceKindService, len(services.Items), <-channels.ServiceList.Error); err != nil {
+		return nil, nil, err
+	}
+
+	ingresses := <-channels.IngressList.List
+	if err := add(types.ResourceKindIngress, len(ingresses.Items), <-channels.IngressList.Error); err != nil {
+		return nil, nil, err
+	}
+
+	configMaps := <-channels.ConfigMapList.List
+	if err := add(types.ResourceKindConfigMap, len(configMaps.Items), <-channels.ConfigMapList.Error); err != nil {
+		return nil, nil, err
+	}
+
+	secrets := <-channels.SecretList.List
+	if err := add(types.ResourceKindSecret, len(secrets.Items), <-channels.SecretList.Error); err != nil {
+		return nil, nil, err
+	}
+
+	claims := <-channels.PersistentVolumeClaimList.List
+	if err := add(types.ResourceKindPersistentVolumeClaim, len(claims.Items), <-channels.PersistentVolumeClaimList.Error); err != nil {
+		return nil, nil, err
+	}
+
+	return result, nonCriticalErrors, nil
+}
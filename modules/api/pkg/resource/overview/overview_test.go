@@ -0,0 +1,162 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/types"
+)
+
+func newPod(name string, phase v1.PodPhase) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: name, UID: k8stypes.UID(name)},
+		Status: v1.PodStatus{
+			Phase: phase,
+			Conditions: []v1.PodCondition{
+				{Type: v1.PodInitialized, Status: v1.ConditionTrue},
+				{Type: v1.PodReady, Status: v1.ConditionTrue},
+			},
+		},
+	}
+}
+
+func newNode(name string, ready v1.ConditionStatus, unschedulable bool) *v1.Node {
+	node := &v1.Node{
+		ObjectMeta: metaV1.ObjectMeta{Name: name},
+		Spec:       v1.NodeSpec{Unschedulable: unschedulable},
+	}
+
+	if len(ready) > 0 {
+		node.Status.Conditions = []v1.NodeCondition{{Type: v1.NodeReady, Status: ready}}
+	}
+
+	return node
+}
+
+func newTestClient() *fake.Clientset {
+	return fake.NewClientset(
+		newPod("running", v1.PodRunning),
+		newPod("failed", v1.PodFailed),
+		newPod("succeeded", v1.PodSucceeded),
+		&v1.Service{ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "web"}},
+		&v1.ConfigMap{ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "a"}},
+		&v1.ConfigMap{ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "b"}},
+		&v1.Event{ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "e1"}, Type: v1.EventTypeWarning, Reason: "BackOff",
+			InvolvedObject: v1.ObjectReference{Kind: "Deployment", Name: "web", UID: "web"}},
+		&v1.Event{ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "e2"}, Type: v1.EventTypeNormal, Reason: "Pulled"},
+		&v1.Event{ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "e3"}, Reason: "FailedMount",
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "failed", UID: "failed"}},
+		&v1.ResourceQuota{
+			ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "quota"},
+			Status: v1.ResourceQuotaStatus{
+				Hard: v1.ResourceList{v1.ResourcePods: resource.MustParse("10"), v1.ResourceLimitsCPU: resource.MustParse("2")},
+				Used: v1.ResourceList{v1.ResourcePods: resource.MustParse("4"), v1.ResourceLimitsCPU: resource.MustParse("500m")},
+			},
+		},
+		newNode("ready", v1.ConditionTrue, false),
+		newNode("not-ready", v1.ConditionFalse, true),
+		newNode("unknown", "", false),
+	)
+}
+
+func TestGetOverview(t *testing.T) {
+	overview, err := GetOverview(newTestClient(), common.NewNamespaceQuery(nil))
+	if err != nil {
+		t.Fatalf("GetOverview() returned error: %v", err)
+	}
+
+	var pods WorkloadSummary
+	for _, workload := range overview.Workloads {
+		if workload.Kind == types.ResourceKindPod {
+			pods = workload
+		}
+	}
+
+	expectedPods := WorkloadSummary{
+		Kind:   types.ResourceKindPod,
+		Count:  3,
+		Status: common.ResourceStatus{Running: 1, Failed: 1, Succeeded: 1},
+	}
+	if !reflect.DeepEqual(pods, expectedPods) {
+		t.Errorf("expected pods %+v, got %+v", expectedPods, pods)
+	}
+
+	if len(overview.Workloads) != 8 {
+		t.Errorf("expected all workload kinds, got %+v", overview.Workloads)
+	}
+
+	expectedResources := []ResourceCount{
+		{Kind: types.ResourceKindService, Count: 1},
+		{Kind: types.ResourceKindIngress, Count: 0},
+		{Kind: types.ResourceKindConfigMap, Count: 2},
+		{Kind: types.ResourceKindSecret, Count: 0},
+		{Kind: types.ResourceKindPersistentVolumeClaim, Count: 0},
+	}
+	if !reflect.DeepEqual(overview.Resources, expectedResources) {
+		t.Errorf("expected resources %+v, got %+v", expectedResources, overview.Resources)
+	}
+
+	expectedEvents := EventSummary{Total: 3, Warnings: 2, WarningReasons: map[string]int{"BackOff": 1, "FailedMount": 1}}
+	if !reflect.DeepEqual(overview.Events, expectedEvents) {
+		t.Errorf("expected events %+v, got %+v", expectedEvents, overview.Events)
+	}
+
+	expectedUsage := []ResourceUsage{
+		{Name: v1.ResourceLimitsCPU, Used: "500m", Hard: "2", Percentage: 25},
+		{Name: v1.ResourcePods, Used: "4", Hard: "10", Percentage: 40},
+	}
+	if len(overview.ResourceQuotas) != 1 || !reflect.DeepEqual(overview.ResourceQuotas[0].Resources, expectedUsage) {
+		t.Errorf("expected quota usage %+v, got %+v", expectedUsage, overview.ResourceQuotas)
+	}
+
+	expectedNodes := &NodeSummary{Total: 3, Ready: 1, NotReady: 1, Unknown: 1, Unschedulable: 1}
+	if !reflect.DeepEqual(overview.Nodes, expectedNodes) {
+		t.Errorf("expected nodes %+v, got %+v", expectedNodes, overview.Nodes)
+	}
+}
+
+func TestGetOverviewNamespace(t *testing.T) {
+	client := newTestClient()
+	client.PrependReactor("list", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, k8serrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "", fmt.Errorf("denied"))
+	})
+
+	overview, err := GetOverview(client, common.NewSameNamespaceQuery("default"))
+	if err != nil {
+		t.Fatalf("GetOverview() returned error: %v", err)
+	}
+
+	if overview.Nodes != nil {
+		t.Errorf("expected no node summary for a namespace, got %+v", overview.Nodes)
+	}
+
+	if len(overview.Errors) != 1 {
+		t.Errorf("expected forbidden secrets as non-critical error, got %v", overview.Errors)
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+
+	"k8s.io/dashboard/api/pkg/resource/event"
+	"k8s.io/dashboard/types"
+)
+
+// EventSummary contains counts of events.
+type EventSummary struct {
+	Total    int `json:"total"`
+	Warnings int `json:"warnings"`
+
+	// WarningReasons contains the number of warning events by reason.
+	WarningReasons map[string]int `json:"warningReasons"`
+}
+
+// QuotaUsage contains the usage of resources limited by a resource quota.
+type QuotaUsage struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+
+	// Resources are ordered by name.
+	Resources []ResourceUsage `json:"resources"`
+}
+
+// ResourceUsage contains the usage of a single resource of a quota.
+type ResourceUsage struct {
+	Name v1.ResourceName `json:"name"`
+	Used string          `json:"used"`
+	Hard string          `json:"hard"`
+
+	// Percentage of the hard limit that is used. Resources with zero hard limit are at 100% as
+	// soon as anything is used.
+	Percentage float64 `json:"percentage"`
+}
+
+// NodeSummary contains node readiness.
+type NodeSummary struct {
+	Total         int `json:"total"`
+	Ready         int `json:"ready"`
+	NotReady      int `json:"notReady"`
+	Unknown       int `json:"unknown"`
+	Unschedulable int `json:"unschedulable"`
+}
+
+func toEventSummary(events []v1.Event) EventSummary {
+	result := EventSummary{Total: len(events), WarningReasons: make(map[string]int)}
+	for _, e := range event.FillEventsType(events) {
+		if e.Type == v1.EventTypeWarning {
+			result.Warnings++
+			result.WarningReasons[e.Reason]++
+		}
+	}
+
+	return result
+}
+
+func toQuotaUsages(quotas []v1.ResourceQuota) []QuotaUsage {
+	result := make([]QuotaUsage, 0, len(quotas))
+	for _, quota := range quotas {
+		usage := QuotaUsage{
+			ObjectMeta: types.NewObjectMeta(quota.ObjectMeta),
+			TypeMeta:   types.NewTypeMeta(types.ResourceKindResourceQuota),
+			Resources:  make([]ResourceUsage, 0, len(quota.Status.Hard)),
+		}
+
+		for name, hard := range quota.Status.Hard {
+			used := quota.Status.Used[name]
+			usage.Resources = append(usage.Resources, ResourceUsage{
+				Name:       name,
+				Used:       used.String(),
+				Hard:       hard.String(),
+				Percentage: percentage(used.MilliValue(), hard.MilliValue()),
+			})
+		}
+
+		sort.Slice(usage.Resources, func(i, j int) bool { return usage.Resources[i].Name < usage.Resources[j].Name })
+		result = append(result, usage)
+	}
+
+	return result
+}
+
+func percentage(used, hard int64) float64 {
+	if hard == 0 {
+		if used > 0 {
+			return 100
+		}
+
+		return 0
+	}
+
+	return float64(used) / float64(hard) * 100
+}
+
+func toNodeSummary(nodes []v1.Node) *NodeSummary {
+	result := &NodeSummary{Total: len(nodes)}
+	for _, node := range nodes {
+		if node.Spec.Unschedulable {
+			result.Unschedulable++
+		}
+
+		switch nodeReadyStatus(node) {
+		case v1.ConditionTrue:
+			result.Ready++
+		case v1.ConditionFalse:
+			result.NotReady++
+		default:
+			result.Unknown++
+		}
+	}
+
+	return result
+}
+
+func nodeReadyStatus(node v1.Node) v1.ConditionStatus {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == v1.NodeReady {
+			return condition.Status
+		}
+	}
+
+	return v1.ConditionUnknown
+}
@@ -0,0 +1,92 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/cronjob"
+	"k8s.io/dashboard/api/pkg/resource/daemonset"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/api/pkg/resource/deployment"
+	"k8s.io/dashboard/api/pkg/resource/job"
+	"k8s.io/dashboard/api/pkg/resource/pod"
+	"k8s.io/dashboard/api/pkg/resource/replicaset"
+	"k8s.io/dashboard/api/pkg/resource/replicationcontroller"
+	"k8s.io/dashboard/api/pkg/resource/statefulset"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// getWorkloads reads workload lists from the channels, so that statuses are computed the same way
+// as on the list pages. Metrics are not collected.
+func getWorkloads(channels *common.ResourceChannels) ([]WorkloadSummary, []error, error) {
+	dsQuery := dataselect.NoDataSelect
+
+	deployments, err := deployment.GetDeploymentListFromChannels(channels, dsQuery, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	replicaSets, err := replicaset.GetReplicaSetListFromChannels(channels, dsQuery, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statefulSets, err := statefulset.GetStatefulSetListFromChannels(channels, dsQuery, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	daemonSets, err := daemonset.GetDaemonSetListFromChannels(channels, dsQuery, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	jobs, err := job.GetJobListFromChannels(channels, dsQuery, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cronJobs, err := cronjob.GetCronJobListFromChannels(channels, dsQuery)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	replicationControllers, err := replicationcontroller.GetReplicationControllerListFromChannels(channels, dsQuery, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pods, err := pod.GetPodListFromChannels(channels, dsQuery, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	workloads := []WorkloadSummary{
+		{Kind: types.ResourceKindDeployment, Count: deployments.ListMeta.TotalItems, Status: deployments.Status},
+		{Kind: types.ResourceKindReplicaSet, Count: replicaSets.ListMeta.TotalItems, Status: replicaSets.Status},
+		{Kind: types.ResourceKindStatefulSet, Count: statefulSets.ListMeta.TotalItems, Status: statefulSets.Status},
+		{Kind: types.ResourceKindDaemonSet, Count: daemonSets.ListMeta.TotalItems, Status: daemonSets.Status},
+		{Kind: types.ResourceKindJob, Count: jobs.ListMeta.TotalItems, Status: jobs.Status},
+		{Kind: types.ResourceKindCronJob, Count: cronJobs.ListMeta.TotalItems, Status: cronJobs.Status},
+		{Kind: types.ResourceKindReplicationController, Count: replicationControllers.ListMeta.TotalItems, Status: replicationControllers.Status},
+		{Kind: types.ResourceKindPod, Count: pods.ListMeta.TotalItems, Status: pods.Status},
+	}
+
+	nonCriticalErrors := errors.MergeErrors(deployments.Errors, replicaSets.Errors, statefulSets.Errors, daemonSets.Errors,
+		jobs.Errors, cronJobs.Errors, replicationControllers.Errors, pods.Errors)
+
+	return workloads, nonCriticalErrors, nil
+}
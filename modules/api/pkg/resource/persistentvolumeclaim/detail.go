@@ -21,12 +21,23 @@ import (
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
 )
 
 // PersistentVolumeClaimDetail provides the presentation layer view of Kubernetes Persistent Volume Claim resource.
 type PersistentVolumeClaimDetail struct {
 	// Extends list item structure.
 	PersistentVolumeClaim `json:",inline"`
+
+	// Requested resources differ from the capacity while the volume is being expanded.
+	Requested v1.ResourceList `json:"requested"`
+
+	Conditions []common.Condition `json:"conditions"`
+
+	// FileSystemResizePending is set when the volume has been expanded and its file system is
+	// resized once a pod using the claim starts.
+	FileSystemResizePending bool `json:"fileSystemResizePending"`
 }
 
 // GetPersistentVolumeClaimDetail returns detailed information about a persistent volume claim
@@ -42,7 +53,26 @@ func GetPersistentVolumeClaimDetail(client kubernetes.Interface, namespace strin
 }
 
 func getPersistentVolumeClaimDetail(pvc v1.PersistentVolumeClaim) *PersistentVolumeClaimDetail {
-	return &PersistentVolumeClaimDetail{
+	detail := &PersistentVolumeClaimDetail{
 		PersistentVolumeClaim: toPersistentVolumeClaim(pvc),
+		Requested:             pvc.Spec.Resources.Requests,
+		Conditions:            make([]common.Condition, 0, len(pvc.Status.Conditions)),
 	}
+
+	for _, condition := range pvc.Status.Conditions {
+		detail.Conditions = append(detail.Conditions, common.Condition{
+			Type:               string(condition.Type),
+			Status:             condition.Status,
+			LastProbeTime:      condition.LastProbeTime,
+			LastTransitionTime: condition.LastTransitionTime,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		})
+
+		if condition.Type == v1.PersistentVolumeClaimFileSystemResizePending && condition.Status == v1.ConditionTrue {
+			detail.FileSystemResizePending = true
+		}
+	}
+
+	return detail
 }
@@ -21,6 +21,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"k8s.io/dashboard/api/pkg/resource/common"
 	"k8s.io/dashboard/types"
 )
 
@@ -54,6 +55,7 @@ func TestGetPersistentVolumeClaimDetail(t *testing.T) {
 					Capacity:    nil,
 					AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
 				},
+				Conditions: []common.Condition{},
 			},
 		},
 	}
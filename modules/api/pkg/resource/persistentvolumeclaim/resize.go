@@ -0,0 +1,95 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package persistentvolumeclaim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/errors"
+)
+
+// ResizeSpec is a specification of the new size of a persistent volume claim.
+type ResizeSpec struct {
+	// Storage is the requested size, e.g. "20Gi". Volumes can only be expanded.
+	Storage resource.Quantity `json:"storage"`
+}
+
+// ResizePersistentVolumeClaim requests expansion of the volume bound to the claim. Only bound
+// claims of storage classes allowing volume expansion can be resized.
+func ResizePersistentVolumeClaim(client kubernetes.Interface, namespace, name string, spec *ResizeSpec) (*PersistentVolumeClaimDetail, error) {
+	pvc, err := client.CoreV1().PersistentVolumeClaims(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateResize(client, pvc, spec); err != nil {
+		return nil, err
+	}
+
+	klog.V(4).Infof("Resizing %s persistent volume claim to %s", name, spec.Storage.String())
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"resources": map[string]interface{}{
+				"requests": v1.ResourceList{v1.ResourceStorage: spec.Storage},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pvc, err = client.CoreV1().PersistentVolumeClaims(namespace).Patch(context.TODO(), name, types.MergePatchType, patch, metaV1.PatchOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return getPersistentVolumeClaimDetail(*pvc), nil
+}
+
+func validateResize(client kubernetes.Interface, pvc *v1.PersistentVolumeClaim, spec *ResizeSpec) error {
+	if pvc.Status.Phase != v1.ClaimBound {
+		return errors.NewBadRequest(fmt.Sprintf("persistent volume claim %s is not bound", pvc.Name))
+	}
+
+	current := pvc.Spec.Resources.Requests[v1.ResourceStorage]
+	if spec.Storage.Cmp(current) <= 0 {
+		return errors.NewBadRequest(fmt.Sprintf("storage must be greater than the current request %s, volumes cannot be shrunk",
+			current.String()))
+	}
+
+	if pvc.Spec.StorageClassName == nil || len(*pvc.Spec.StorageClassName) == 0 {
+		return errors.NewBadRequest(fmt.Sprintf("persistent volume claim %s has no storage class", pvc.Name))
+	}
+
+	storageClass, err := client.StorageV1().StorageClasses().Get(context.TODO(), *pvc.Spec.StorageClassName, metaV1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if storageClass.AllowVolumeExpansion == nil || !*storageClass.AllowVolumeExpansion {
+		return errors.NewBadRequest(fmt.Sprintf("storage class %s does not allow volume expansion", storageClass.Name))
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package persistentvolumeclaim
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newResizeTestClaim(name, storageClass string, phase v1.PersistentVolumeClaimPhase) *v1.PersistentVolumeClaim {
+	return &v1.PersistentVolumeClaim{
+		ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: name},
+		Spec: v1.PersistentVolumeClaimSpec{
+			StorageClassName: lo.ToPtr(storageClass),
+			Resources: v1.VolumeResourceRequirements{
+				Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse("10Gi")},
+			},
+		},
+		Status: v1.PersistentVolumeClaimStatus{
+			Phase: phase,
+			Conditions: []v1.PersistentVolumeClaimCondition{
+				{Type: v1.PersistentVolumeClaimFileSystemResizePending, Status: v1.ConditionTrue},
+			},
+		},
+	}
+}
+
+func TestResizePersistentVolumeClaim(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&storagev1.StorageClass{ObjectMeta: metaV1.ObjectMeta{Name: "expandable"}, AllowVolumeExpansion: lo.ToPtr(true)},
+		&storagev1.StorageClass{ObjectMeta: metaV1.ObjectMeta{Name: "fixed"}},
+		newResizeTestClaim("data", "expandable", v1.ClaimBound),
+		newResizeTestClaim("logs", "fixed", v1.ClaimBound),
+		newResizeTestClaim("pending", "expandable", v1.ClaimPending),
+	)
+
+	detail, err := ResizePersistentVolumeClaim(client, "default", "data", &ResizeSpec{Storage: resource.MustParse("20Gi")})
+	if err != nil {
+		t.Fatalf("ResizePersistentVolumeClaim() returned error: %v", err)
+	}
+
+	requested := detail.Requested[v1.ResourceStorage]
+	if requested.String() != "20Gi" || !detail.FileSystemResizePending || len(detail.Conditions) != 1 {
+		t.Errorf("unexpected resized claim %+v", detail)
+	}
+
+	cases := []struct {
+		name    string
+		storage string
+	}{
+		{"data", "5Gi"},
+		{"data", "20Gi"},
+		{"logs", "20Gi"},
+		{"pending", "20Gi"},
+		{"missing", "20Gi"},
+	}
+
+	for _, c := range cases {
+		if _, err := ResizePersistentVolumeClaim(client, "default", c.name, &ResizeSpec{Storage: resource.MustParse(c.storage)}); err == nil {
+			t.Errorf("ResizePersistentVolumeClaim(%s, %s) should fail", c.name, c.storage)
+		}
+	}
+}
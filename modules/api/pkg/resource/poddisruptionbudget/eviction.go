@@ -0,0 +1,312 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package poddisruptionbudget
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/errors"
+)
+
+// EvictionStatus is the outcome of a Pod eviction.
+type EvictionStatus string
+
+// List of eviction statuses.
+const (
+	EvictionStatusEvicted EvictionStatus = "evicted"
+	// EvictionStatusBlocked means that the eviction would violate a PodDisruptionBudget.
+	EvictionStatusBlocked EvictionStatus = "blocked"
+	EvictionStatusFailed  EvictionStatus = "failed"
+	// EvictionStatusDryRun means that the Pod would be evicted.
+	EvictionStatusDryRun EvictionStatus = "dryRun"
+)
+
+const (
+	defaultEvictionRetries = 3
+	maxEvictionRetries     = 10
+
+	defaultEvictionRetryInterval = 5 * time.Second
+	maxEvictionRetryInterval     = 30 * time.Second
+
+	// maxEvictionWait caps the total time spent waiting between retries, so that the request does
+	// not block for minutes. Evictions still blocked when it runs out are reported as blocked.
+	maxEvictionWait = 60 * time.Second
+)
+
+// EvictionSpec is a specification of a Pod eviction.
+type EvictionSpec struct {
+	// GracePeriodSeconds overrides the termination grace period of the Pod.
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty"`
+
+	// DryRun sends the eviction with server-side dry run, so PodDisruptionBudgets are checked but
+	// the Pod is not evicted.
+	DryRun bool `json:"dryRun"`
+}
+
+// BulkEvictionSpec is a specification of eviction of all Pods matching the label selector.
+type BulkEvictionSpec struct {
+	EvictionSpec `json:",inline"`
+
+	// LabelSelector in the standard format, e.g. "app=nginx". It is required to prevent accidental
+	// eviction of all Pods in the namespace.
+	LabelSelector string `json:"labelSelector"`
+
+	// Retries is how many times evictions blocked by a PodDisruptionBudget are retried.
+	// Defaulted to 3, at most 10.
+	Retries *int `json:"retries,omitempty"`
+
+	// RetryIntervalSeconds is the delay between retries. Defaulted to 5, at most 30. Retries stop
+	// early when the total delay would exceed 60 seconds.
+	RetryIntervalSeconds *int `json:"retryIntervalSeconds,omitempty"`
+}
+
+// EvictionResult is the result of eviction of a single Pod.
+type EvictionResult struct {
+	Namespace string         `json:"namespace"`
+	Name      string         `json:"name"`
+	Status    EvictionStatus `json:"status"`
+	Message   string         `json:"message,omitempty"`
+
+	// Attempts is the number of eviction requests sent for the Pod.
+	Attempts int `json:"attempts"`
+
+	// BlockingPodDisruptionBudgets are the budgets selecting the Pod that currently allow no
+	// disruptions. It is only set for blocked evictions.
+	BlockingPodDisruptionBudgets []PodDisruptionBudget `json:"blockingPodDisruptionBudgets,omitempty"`
+}
+
+// BulkEvictionResult contains results of evictions of all selected Pods.
+type BulkEvictionResult struct {
+	Evicted int              `json:"evicted"`
+	Blocked int              `json:"blocked"`
+	Failed  int              `json:"failed"`
+	Items   []EvictionResult `json:"items"`
+}
+
+// EvictPod evicts the Pod using the eviction subresource, so PodDisruptionBudgets are honored.
+// When the eviction is refused, the result has blocked status and lists the blocking budgets.
+func EvictPod(client kubernetes.Interface, namespace, name string, spec *EvictionSpec) (*EvictionResult, error) {
+	pod, err := client.CoreV1().Pods(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := evict(context.TODO(), client, pod, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Status == EvictionStatusBlocked {
+		budgets, err := listBudgets(context.TODO(), client, namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		result.BlockingPodDisruptionBudgets = blockingBudgets(budgets, pod)
+	}
+
+	return result, nil
+}
+
+// EvictPods evicts all Pods in the namespace matching the label selector. Evictions blocked by
+// PodDisruptionBudgets are retried after all other Pods were processed, giving evicted Pods time
+// to be replaced. It stops when the context is done, e.g. when the client disconnects.
+func EvictPods(ctx context.Context, client kubernetes.Interface, namespace string, spec *BulkEvictionSpec) (*BulkEvictionResult, error) {
+	if len(spec.LabelSelector) == 0 {
+		return nil, errors.NewBadRequest("label selector is required")
+	}
+
+	selector, err := labels.Parse(spec.LabelSelector)
+	if err != nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("invalid label selector: %s", err.Error()))
+	}
+
+	retries, interval, err := retryOptions(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, metaV1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(2).InfoS("evicting pods", "namespace", namespace, "selector", spec.LabelSelector, "pods", len(pods.Items), "dryRun", spec.DryRun)
+	result := &BulkEvictionResult{Items: make([]EvictionResult, len(pods.Items))}
+	pending := make([]int, len(pods.Items))
+	for i := range pending {
+		pending[i] = i
+	}
+
+	deadline := time.Now().Add(maxEvictionWait)
+	for attempt := 0; len(pending) > 0; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(interval):
+			}
+		}
+
+		blocked := make([]int, 0)
+		for _, i := range pending {
+			attempts := result.Items[i].Attempts
+			item, err := evict(ctx, client, &pods.Items[i], &spec.EvictionSpec)
+			if err != nil {
+				item = &EvictionResult{Namespace: pods.Items[i].Namespace, Name: pods.Items[i].Name,
+					Status: EvictionStatusFailed, Message: err.Error()}
+			}
+
+			item.Attempts = attempts + 1
+			result.Items[i] = *item
+			if item.Status == EvictionStatusBlocked {
+				blocked = append(blocked, i)
+			}
+		}
+
+		if attempt == retries || time.Now().Add(interval).After(deadline) {
+			break
+		}
+		pending = blocked
+	}
+
+	var budgets []policyv1.PodDisruptionBudget
+	for i, item := range result.Items {
+		switch item.Status {
+		case EvictionStatusEvicted, EvictionStatusDryRun:
+			result.Evicted++
+		case EvictionStatusFailed:
+			result.Failed++
+		case EvictionStatusBlocked:
+			result.Blocked++
+			if budgets == nil {
+				if budgets, err = listBudgets(ctx, client, namespace); err != nil {
+					return nil, err
+				}
+			}
+			result.Items[i].BlockingPodDisruptionBudgets = blockingBudgets(budgets, &pods.Items[i])
+		}
+	}
+
+	return result, nil
+}
+
+// evict sends a single eviction request. Refused evictions are reported by the blocked status,
+// other failures are returned as errors.
+func evict(ctx context.Context, client kubernetes.Interface, pod *v1.Pod, spec *EvictionSpec) (*EvictionResult, error) {
+	options := &metaV1.DeleteOptions{}
+	if spec != nil {
+		options.GracePeriodSeconds = spec.GracePeriodSeconds
+		if spec.DryRun {
+			options.DryRun = []string{metaV1.DryRunAll}
+		}
+	}
+
+	result := &EvictionResult{Namespace: pod.Namespace, Name: pod.Name, Status: EvictionStatusEvicted, Attempts: 1}
+	if len(options.DryRun) > 0 {
+		result.Status = EvictionStatusDryRun
+	}
+
+	err := client.PolicyV1().Evictions(pod.Namespace).Evict(ctx, &policyv1.Eviction{
+		ObjectMeta:    metaV1.ObjectMeta{Namespace: pod.Namespace, Name: pod.Name},
+		DeleteOptions: options,
+	})
+	switch {
+	case err == nil:
+		return result, nil
+	case k8serrors.IsTooManyRequests(err):
+		result.Status = EvictionStatusBlocked
+		result.Message = err.Error()
+		return result, nil
+	case k8serrors.IsNotFound(err) && result.Status == EvictionStatusEvicted:
+		// The Pod is already gone, which is the goal of the eviction.
+		result.Message = "pod no longer exists"
+		return result, nil
+	default:
+		return nil, err
+	}
+}
+
+func listBudgets(ctx context.Context, client kubernetes.Interface, namespace string) ([]policyv1.PodDisruptionBudget, error) {
+	list, err := client.PolicyV1().PodDisruptionBudgets(namespace).List(ctx, metaV1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return list.Items, nil
+}
+
+// blockingBudgets returns budgets selecting the Pod that allow no disruptions. If all of them
+// allow disruptions, e.g. because their status is not updated yet, all selecting budgets are
+// returned.
+func blockingBudgets(budgets []policyv1.PodDisruptionBudget, pod *v1.Pod) []PodDisruptionBudget {
+	selecting := make([]PodDisruptionBudget, 0)
+	blocking := make([]PodDisruptionBudget, 0)
+	for _, budget := range budgets {
+		if budget.Spec.Selector == nil {
+			continue
+		}
+
+		selector, err := metaV1.LabelSelectorAsSelector(budget.Spec.Selector)
+		if err != nil || !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+
+		item := toListItem(budget)
+		selecting = append(selecting, item)
+		if budget.Status.DisruptionsAllowed <= 0 {
+			blocking = append(blocking, item)
+		}
+	}
+
+	if len(blocking) == 0 {
+		return selecting
+	}
+
+	return blocking
+}
+
+func retryOptions(spec *BulkEvictionSpec) (int, time.Duration, error) {
+	retries := defaultEvictionRetries
+	if spec.Retries != nil {
+		retries = *spec.Retries
+	}
+
+	if retries < 0 || retries > maxEvictionRetries {
+		return 0, 0, errors.NewBadRequest(fmt.Sprintf("retries must be between 0 and %d", maxEvictionRetries))
+	}
+
+	interval := defaultEvictionRetryInterval
+	if spec.RetryIntervalSeconds != nil {
+		interval = time.Duration(*spec.RetryIntervalSeconds) * time.Second
+	}
+
+	if interval < 0 || interval > maxEvictionRetryInterval {
+		return 0, 0, errors.NewBadRequest(fmt.Sprintf("retry interval must be between 0 and %d seconds",
+			int(maxEvictionRetryInterval.Seconds())))
+	}
+
+	return retries, interval, nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package poddisruptionbudget
+
+import (
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func newEvictionTestClient(refusals map[string]int) *fake.Clientset {
+	client := fake.NewClientset(
+		&v1.Pod{ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "web-1", Labels: map[string]string{"app": "web"}}},
+		&v1.Pod{ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "web-2", Labels: map[string]string{"app": "web"}}},
+		&v1.Pod{ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "db-1", Labels: map[string]string{"app": "db"}}},
+		&policyv1.PodDisruptionBudget{
+			ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "web"},
+			Spec:       policyv1.PodDisruptionBudgetSpec{Selector: &metaV1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+			Status:     policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: 0},
+		},
+		&policyv1.PodDisruptionBudget{
+			ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "db"},
+			Spec:       policyv1.PodDisruptionBudgetSpec{Selector: &metaV1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+			Status:     policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: 1},
+		},
+	)
+
+	// Evictions of Pods in the map are refused the given number of times.
+	client.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+
+		name := action.(k8stesting.CreateAction).GetObject().(*policyv1.Eviction).Name
+		if refusals[name] > 0 {
+			refusals[name]--
+			return true, nil, k8serrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 0)
+		}
+
+		return true, nil, nil
+	})
+
+	return client
+}
+
+func TestEvictPod(t *testing.T) {
+	client := newEvictionTestClient(map[string]int{"web-1": 1})
+
+	result, err := EvictPod(client, "default", "web-1", &EvictionSpec{})
+	if err != nil {
+		t.Fatalf("EvictPod() returned error: %v", err)
+	}
+
+	if result.Status != EvictionStatusBlocked || len(result.BlockingPodDisruptionBudgets) != 1 ||
+		result.BlockingPodDisruptionBudgets[0].ObjectMeta.Name != "web" {
+		t.Errorf("expected eviction blocked by web budget, got %+v", result)
+	}
+
+	result, err = EvictPod(client, "default", "db-1", &EvictionSpec{DryRun: true})
+	if err != nil || result.Status != EvictionStatusDryRun {
+		t.Errorf("EvictPod() = %+v, %v, expected dry run eviction", result, err)
+	}
+
+	if _, err := EvictPod(client, "default", "missing", &EvictionSpec{}); err == nil {
+		t.Error("EvictPod() should fail for missing pod")
+	}
+}
+
+func TestEvictPods(t *testing.T) {
+	client := newEvictionTestClient(map[string]int{"web-1": 1, "web-2": 5})
+
+	result, err := EvictPods(context.TODO(), client, "default", &BulkEvictionSpec{
+		LabelSelector:        "app=web",
+		Retries:              lo.ToPtr(2),
+		RetryIntervalSeconds: lo.ToPtr(0),
+	})
+	if err != nil {
+		t.Fatalf("EvictPods() returned error: %v", err)
+	}
+
+	if result.Evicted != 1 || result.Blocked != 1 || result.Failed != 0 {
+		t.Errorf("expected one evicted and one blocked pod, got %+v", result)
+	}
+
+	for _, item := range result.Items {
+		switch item.Name {
+		case "web-1":
+			if item.Status != EvictionStatusEvicted || item.Attempts != 2 {
+				t.Errorf("expected web-1 evicted on second attempt, got %+v", item)
+			}
+		case "web-2":
+			if item.Status != EvictionStatusBlocked || item.Attempts != 3 || len(item.BlockingPodDisruptionBudgets) != 1 {
+				t.Errorf("expected web-2 blocked after all retries, got %+v", item)
+			}
+		}
+	}
+
+	invalid := []*BulkEvictionSpec{
+		{},
+		{LabelSelector: "app in (web"},
+		{LabelSelector: "app=web", Retries: lo.ToPtr(100)},
+		{LabelSelector: "app=web", RetryIntervalSeconds: lo.ToPtr(-1)},
+	}
+
+	for _, spec := range invalid {
+		if _, err := EvictPods(context.TODO(), client, "default", spec); err == nil {
+			t.Errorf("EvictPods(%+v) should fail", spec)
+		}
+	}
+}
+
+func TestEvictPodsCanceled(t *testing.T) {
+	client := newEvictionTestClient(map[string]int{"web-1": 5})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := EvictPods(ctx, client, "default", &BulkEvictionSpec{
+		LabelSelector:        "app=web",
+		RetryIntervalSeconds: lo.ToPtr(30),
+	})
+	if err != context.Canceled {
+		t.Errorf("EvictPods() with canceled context returned %v, expected %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorityclass
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	metricapi "k8s.io/dashboard/api/pkg/integration/metric/api"
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/api/pkg/resource/event"
+	"k8s.io/dashboard/api/pkg/resource/pod"
+	"k8s.io/dashboard/errors"
+)
+
+// PriorityClassDetail provides the presentation layer view of Priority Class resource.
+type PriorityClassDetail struct {
+	// Extends list item structure.
+	PriorityClass `json:",inline"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetPriorityClass returns Priority Class resource with the number of pods using it.
+func GetPriorityClass(client kubernetes.Interface, name string) (*PriorityClassDetail, error) {
+	klog.V(4).Infof("Getting details of %s priority class", name)
+
+	pc, err := client.SchedulingV1().PriorityClasses().Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := getPriorityClassPods(client, name)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return &PriorityClassDetail{
+		PriorityClass: toPriorityClass(pc, len(pods)),
+		Errors:        nonCriticalErrors,
+	}, nil
+}
+
+// GetPriorityClassPods returns pods using the priority class.
+func GetPriorityClassPods(client kubernetes.Interface, metricClient metricapi.MetricClient,
+	dsQuery *dataselect.DataSelectQuery, name string) (*pod.PodList, error) {
+	klog.V(4).Infof("Getting pods using %s priority class", name)
+
+	pods, err := getPriorityClassPods(client, name)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	events, err := event.GetPodsEvents(client, v1.NamespaceAll, pods)
+	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	podList := pod.ToPodList(pods, events, nonCriticalErrors, dsQuery, metricClient)
+	return &podList, nil
+}
+
+// getPriorityClassPods returns pods from all namespaces using the priority class. Pods can not be
+// selected by priority class name on the server side, so they are filtered here.
+func getPriorityClassPods(client kubernetes.Interface, name string) ([]v1.Pod, error) {
+	channels := &common.ResourceChannels{
+		PodList: common.GetPodListChannel(client, common.NewNamespaceQuery(nil), 1),
+	}
+
+	podList := <-channels.PodList.List
+	if err := <-channels.PodList.Error; err != nil {
+		return nil, err
+	}
+
+	pods := make([]v1.Pod, 0)
+	for _, item := range podList.Items {
+		if item.Spec.PriorityClassName == name {
+			pods = append(pods, item)
+		}
+	}
+
+	return pods, nil
+}
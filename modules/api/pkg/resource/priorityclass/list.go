@@ -0,0 +1,128 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorityclass
+
+import (
+	v1 "k8s.io/api/core/v1"
+	scheduling "k8s.io/api/scheduling/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// PriorityClassList holds a list of Priority Class objects in the cluster.
+type PriorityClassList struct {
+	ListMeta types.ListMeta  `json:"listMeta"`
+	Items    []PriorityClass `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// PriorityClass is a representation of a Kubernetes Priority Class object.
+type PriorityClass struct {
+	ObjectMeta       types.ObjectMeta `json:"objectMeta"`
+	TypeMeta         types.TypeMeta   `json:"typeMeta"`
+	Value            int32            `json:"value"`
+	GlobalDefault    bool             `json:"globalDefault"`
+	PreemptionPolicy string           `json:"preemptionPolicy"`
+	Description      string           `json:"description"`
+
+	// Pods is the number of pods using the priority class.
+	Pods int `json:"pods"`
+}
+
+// GetPriorityClassList returns a list of all priority class objects in the cluster with the
+// number of pods using each of them.
+func GetPriorityClassList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*PriorityClassList, error) {
+	klog.V(4).Infof("Getting list of priority classes in the cluster")
+
+	channels := &common.ResourceChannels{
+		PriorityClassList: common.GetPriorityClassListChannel(client, 1),
+		PodList:           common.GetPodListChannel(client, common.NewNamespaceQuery(nil), 1),
+	}
+
+	return GetPriorityClassListFromChannels(channels, dsQuery)
+}
+
+// GetPriorityClassListFromChannels returns a list of all priority class objects in the cluster.
+func GetPriorityClassListFromChannels(channels *common.ResourceChannels,
+	dsQuery *dataselect.DataSelectQuery) (*PriorityClassList, error) {
+	priorityClasses := <-channels.PriorityClassList.List
+	err := <-channels.PriorityClassList.Error
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	pods := <-channels.PodList.List
+	err = <-channels.PodList.Error
+	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	var podItems []v1.Pod
+	if pods != nil {
+		podItems = pods.Items
+	}
+
+	return toPriorityClassList(priorityClasses.Items, podItems, nonCriticalErrors, dsQuery), nil
+}
+
+func toPriorityClassList(priorityClasses []scheduling.PriorityClass, pods []v1.Pod, nonCriticalErrors []error,
+	dsQuery *dataselect.DataSelectQuery) *PriorityClassList {
+	priorityClassList := &PriorityClassList{
+		Items:    make([]PriorityClass, 0),
+		ListMeta: types.ListMeta{TotalItems: len(priorityClasses)},
+		Errors:   nonCriticalErrors,
+	}
+
+	podCounts := make(map[string]int)
+	for _, pod := range pods {
+		podCounts[pod.Spec.PriorityClassName]++
+	}
+
+	priorityClassCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(priorityClasses), dsQuery)
+	priorityClasses = fromCells(priorityClassCells)
+	priorityClassList.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+
+	for _, priorityClass := range priorityClasses {
+		priorityClassList.Items = append(priorityClassList.Items, toPriorityClass(&priorityClass, podCounts[priorityClass.Name]))
+	}
+
+	return priorityClassList
+}
+
+func toPriorityClass(priorityClass *scheduling.PriorityClass, pods int) PriorityClass {
+	preemptionPolicy := v1.PreemptLowerPriority
+	if priorityClass.PreemptionPolicy != nil {
+		preemptionPolicy = *priorityClass.PreemptionPolicy
+	}
+
+	return PriorityClass{
+		ObjectMeta:       types.NewObjectMeta(priorityClass.ObjectMeta),
+		TypeMeta:         types.NewTypeMeta(types.ResourceKindPriorityClass),
+		Value:            priorityClass.Value,
+		GlobalDefault:    priorityClass.GlobalDefault,
+		PreemptionPolicy: string(preemptionPolicy),
+		Description:      priorityClass.Description,
+		Pods:             pods,
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorityclass
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	scheduling "k8s.io/api/scheduling/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/types"
+)
+
+func newTestClient() *fake.Clientset {
+	never := v1.PreemptNever
+	return fake.NewSimpleClientset(
+		&scheduling.PriorityClass{
+			ObjectMeta:    metaV1.ObjectMeta{Name: "high"},
+			Value:         1000,
+			GlobalDefault: true,
+			Description:   "critical workloads",
+		},
+		&scheduling.PriorityClass{
+			ObjectMeta:       metaV1.ObjectMeta{Name: "batch"},
+			Value:            -10,
+			PreemptionPolicy: &never,
+		},
+		&v1.Pod{
+			ObjectMeta: metaV1.ObjectMeta{Name: "api", Namespace: "default"},
+			Spec:       v1.PodSpec{PriorityClassName: "high"},
+		},
+		&v1.Pod{
+			ObjectMeta: metaV1.ObjectMeta{Name: "db", Namespace: "data"},
+			Spec:       v1.PodSpec{PriorityClassName: "high"},
+		},
+		&v1.Pod{
+			ObjectMeta: metaV1.ObjectMeta{Name: "other", Namespace: "default"},
+		},
+	)
+}
+
+func TestGetPriorityClassList(t *testing.T) {
+	actual, err := GetPriorityClassList(newTestClient(), dataselect.NoDataSelect)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]PriorityClass{
+		"high": {
+			ObjectMeta:       types.ObjectMeta{Name: "high"},
+			TypeMeta:         types.TypeMeta{Kind: types.ResourceKindPriorityClass},
+			Value:            1000,
+			GlobalDefault:    true,
+			PreemptionPolicy: string(v1.PreemptLowerPriority),
+			Description:      "critical workloads",
+			Pods:             2,
+		},
+		"batch": {
+			ObjectMeta:       types.ObjectMeta{Name: "batch"},
+			TypeMeta:         types.TypeMeta{Kind: types.ResourceKindPriorityClass},
+			Value:            -10,
+			PreemptionPolicy: string(v1.PreemptNever),
+		},
+	}
+
+	if actual.ListMeta.TotalItems != len(expected) {
+		t.Errorf("expected %d priority classes, got %d", len(expected), actual.ListMeta.TotalItems)
+	}
+
+	for _, item := range actual.Items {
+		if !reflect.DeepEqual(item, expected[item.ObjectMeta.Name]) {
+			t.Errorf("GetPriorityClassList() == got\n%#v, expected\n %#v", item, expected[item.ObjectMeta.Name])
+		}
+	}
+}
+
+func TestGetPriorityClassPods(t *testing.T) {
+	client := newTestClient()
+	detail, err := GetPriorityClass(client, "high")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if detail.Pods != 2 {
+		t.Errorf("expected 2 pods using priority class, got %d", detail.Pods)
+	}
+
+	pods, err := GetPriorityClassPods(client, nil, dataselect.NoDataSelect, "high")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	names := make(map[string]bool)
+	for _, p := range pods.Pods {
+		names[p.ObjectMeta.Name] = true
+	}
+
+	if pods.ListMeta.TotalItems != 2 || !names["api"] || !names["db"] {
+		t.Errorf("expected pods api and db, got %v", names)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcequota
+
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+)
+
+// The code below allows to perform complex data section on []v1.ResourceQuota
+
+type ResourceQuotaCell v1.ResourceQuota
+
+func (in ResourceQuotaCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(in.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(in.CreationTimestamp.Time)
+	case dataselect.NamespaceProperty:
+		return dataselect.StdComparableString(in.Namespace)
+	case dataselect.UsageProperty:
+		quota := v1.ResourceQuota(in)
+		return dataselect.StdComparableInt(getMaxUsage(getUsage(&quota)))
+	}
+
+	if resourceName, ok := strings.CutPrefix(string(name), dataselect.UsageProperty+"."); ok {
+		quota := v1.ResourceQuota(in)
+		return dataselect.StdComparableInt(getUsage(&quota)[v1.ResourceName(resourceName)])
+	}
+
+	// if name is not supported then just return a constant dummy value, sort will have no effect.
+	return nil
+}
+
+func toCells(std []v1.ResourceQuota) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = ResourceQuotaCell(std[i])
+	}
+	return cells
+}
+
+func fromCells(cells []dataselect.DataCell) []v1.ResourceQuota {
+	std := make([]v1.ResourceQuota, len(cells))
+	for i := range std {
+		std[i] = v1.ResourceQuota(cells[i].(ResourceQuotaCell))
+	}
+	return std
+}
+
+// getUsage returns usage of each limited resource of the quota in percents, rounded down. Resources
+// limited to zero are reported as fully used.
+func getUsage(quota *v1.ResourceQuota) map[v1.ResourceName]int {
+	usage := make(map[v1.ResourceName]int, len(quota.Status.Hard))
+	for name, hard := range quota.Status.Hard {
+		used := quota.Status.Used[name]
+		if hard.IsZero() {
+			usage[name] = 100
+			continue
+		}
+
+		usage[name] = int(used.AsApproximateFloat64() * 100 / hard.AsApproximateFloat64())
+	}
+
+	return usage
+}
+
+func getMaxUsage(usage map[v1.ResourceName]int) int {
+	result := 0
+	for _, value := range usage {
+		result = max(result, value)
+	}
+
+	return result
+}
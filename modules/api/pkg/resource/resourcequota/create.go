@@ -0,0 +1,253 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcequota
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/errors"
+)
+
+// standardQuotaResources lists resources that can be limited by quotas without a prefix. Other
+// resources are limited with "requests.", "limits." or "count/" prefixes, e.g. "requests.nvidia.com/gpu".
+var standardQuotaResources = map[v1.ResourceName]bool{
+	v1.ResourceCPU:                    true,
+	v1.ResourceMemory:                 true,
+	v1.ResourceEphemeralStorage:       true,
+	v1.ResourceRequestsStorage:        true,
+	v1.ResourcePods:                   true,
+	v1.ResourceServices:               true,
+	v1.ResourceReplicationControllers: true,
+	v1.ResourceQuotas:                 true,
+	v1.ResourceSecrets:                true,
+	v1.ResourceConfigMaps:             true,
+	v1.ResourcePersistentVolumeClaims: true,
+	v1.ResourceServicesNodePorts:      true,
+	v1.ResourceServicesLoadBalancers:  true,
+}
+
+// quotaResourcePrefixes are prefixes of quota resources, which are followed by a resource name.
+var quotaResourcePrefixes = []string{"requests.", "limits.", "count/"}
+
+// conflictingScopes lists scopes which can not be used together.
+var conflictingScopes = map[v1.ResourceQuotaScope]v1.ResourceQuotaScope{
+	v1.ResourceQuotaScopeTerminating:    v1.ResourceQuotaScopeNotTerminating,
+	v1.ResourceQuotaScopeNotTerminating: v1.ResourceQuotaScopeTerminating,
+	v1.ResourceQuotaScopeBestEffort:     v1.ResourceQuotaScopeNotBestEffort,
+	v1.ResourceQuotaScopeNotBestEffort:  v1.ResourceQuotaScopeBestEffort,
+}
+
+// ResourceQuotaSpec is a specification of a resource quota to create or update.
+type ResourceQuotaSpec struct {
+	// Name is only used when the quota is created.
+	Name string `json:"name,omitempty"`
+
+	// Hard limits by resource name, e.g. {"requests.cpu": "4", "pods": "20"}.
+	Hard map[v1.ResourceName]string `json:"hard"`
+
+	Scopes        []v1.ResourceQuotaScope `json:"scopes,omitempty"`
+	ScopeSelector *v1.ScopeSelector       `json:"scopeSelector,omitempty"`
+}
+
+// CreateResourceQuota creates the resource quota in the namespace.
+func CreateResourceQuota(client kubernetes.Interface, namespace string, spec *ResourceQuotaSpec) (*ResourceQuota, error) {
+	if len(spec.Name) == 0 {
+		return nil, errors.NewBadRequest("name is required")
+	}
+
+	hard, err := validateSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(4).Infof("Creating %s resource quota in %s namespace", spec.Name, namespace)
+	quota := &v1.ResourceQuota{ObjectMeta: metaV1.ObjectMeta{Namespace: namespace, Name: spec.Name}}
+	spec.apply(quota, hard)
+
+	quota, err = client.CoreV1().ResourceQuotas(namespace).Create(context.TODO(), quota, metaV1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := toResourceQuota(quota)
+	return &result, nil
+}
+
+// UpdateResourceQuota replaces hard limits and scopes of the resource quota. Usage is recalculated
+// by the API server, so the returned status can lag behind the new limits.
+func UpdateResourceQuota(client kubernetes.Interface, namespace, name string, spec *ResourceQuotaSpec) (*ResourceQuota, error) {
+	hard, err := validateSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(4).Infof("Updating %s resource quota in %s namespace", name, namespace)
+	var result *v1.ResourceQuota
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		quota, err := client.CoreV1().ResourceQuotas(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		spec.apply(quota, hard)
+		result, err = client.CoreV1().ResourceQuotas(namespace).Update(context.TODO(), quota, metaV1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	quota := toResourceQuota(result)
+	return &quota, nil
+}
+
+func (in *ResourceQuotaSpec) apply(quota *v1.ResourceQuota, hard v1.ResourceList) {
+	quota.Spec.Hard = hard
+	quota.Spec.Scopes = in.Scopes
+	quota.Spec.ScopeSelector = in.ScopeSelector
+}
+
+// validateSpec follows the validation of the API server, so that all problems are reported at once,
+// and returns parsed hard limits.
+func validateSpec(spec *ResourceQuotaSpec) (v1.ResourceList, error) {
+	problems := make([]string, 0)
+	if len(spec.Hard) == 0 {
+		problems = append(problems, "at least one hard limit is required")
+	}
+
+	hard := make(v1.ResourceList, len(spec.Hard))
+	for _, name := range sortedResourceNames(spec.Hard) {
+		if !isQuotaResource(name) {
+			problems = append(problems, fmt.Sprintf("%s is not a resource that can be limited by quota", name))
+			continue
+		}
+
+		quantity, err := resource.ParseQuantity(spec.Hard[name])
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("invalid quantity %q of %s", spec.Hard[name], name))
+			continue
+		}
+
+		if quantity.Sign() < 0 {
+			problems = append(problems, fmt.Sprintf("%s must not be negative", name))
+			continue
+		}
+
+		hard[name] = quantity
+	}
+
+	scopes := make(map[v1.ResourceQuotaScope]bool)
+	for _, scope := range spec.Scopes {
+		scopes[scope] = true
+	}
+
+	if spec.ScopeSelector != nil {
+		for _, requirement := range spec.ScopeSelector.MatchExpressions {
+			scopes[requirement.ScopeName] = true
+			problems = append(problems, validateScopeSelectorRequirement(requirement)...)
+		}
+	}
+
+	for scope := range scopes {
+		if !isQuotaScope(scope) {
+			problems = append(problems, fmt.Sprintf("unsupported scope %s", scope))
+		}
+
+		if conflicting, exists := conflictingScopes[scope]; exists && scopes[conflicting] && scope < conflicting {
+			problems = append(problems, fmt.Sprintf("scopes %s and %s are mutually exclusive", scope, conflicting))
+		}
+	}
+
+	if scopes[v1.ResourceQuotaScopeBestEffort] {
+		for name := range spec.Hard {
+			if name != v1.ResourcePods && name != "count/pods" {
+				problems = append(problems, fmt.Sprintf("%s can not be limited in the %s scope, only pods can", name, v1.ResourceQuotaScopeBestEffort))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, errors.NewBadRequest(strings.Join(problems, "; "))
+	}
+
+	return hard, nil
+}
+
+func validateScopeSelectorRequirement(requirement v1.ScopedResourceSelectorRequirement) []string {
+	switch requirement.Operator {
+	case v1.ScopeSelectorOpIn, v1.ScopeSelectorOpNotIn:
+		if len(requirement.Values) == 0 {
+			return []string{fmt.Sprintf("values are required for %s operator of %s scope", requirement.Operator, requirement.ScopeName)}
+		}
+	case v1.ScopeSelectorOpExists, v1.ScopeSelectorOpDoesNotExist:
+		if len(requirement.Values) > 0 {
+			return []string{fmt.Sprintf("values must be empty for %s operator of %s scope", requirement.Operator, requirement.ScopeName)}
+		}
+	default:
+		return []string{fmt.Sprintf("unsupported operator %s of %s scope", requirement.Operator, requirement.ScopeName)}
+	}
+
+	if requirement.ScopeName != v1.ResourceQuotaScopePriorityClass && requirement.Operator != v1.ScopeSelectorOpExists {
+		return []string{fmt.Sprintf("%s scope supports only %s operator", requirement.ScopeName, v1.ScopeSelectorOpExists)}
+	}
+
+	return nil
+}
+
+func isQuotaResource(name v1.ResourceName) bool {
+	if standardQuotaResources[name] {
+		return true
+	}
+
+	for _, prefix := range quotaResourcePrefixes {
+		if suffix, ok := strings.CutPrefix(string(name), prefix); ok && len(suffix) > 0 {
+			return true
+		}
+	}
+
+	// Storage requests of a storage class, e.g. "gold.storageclass.storage.k8s.io/requests.storage".
+	return strings.Contains(string(name), ".storageclass.storage.k8s.io/")
+}
+
+func isQuotaScope(scope v1.ResourceQuotaScope) bool {
+	switch scope {
+	case v1.ResourceQuotaScopeTerminating, v1.ResourceQuotaScopeNotTerminating, v1.ResourceQuotaScopeBestEffort,
+		v1.ResourceQuotaScopeNotBestEffort, v1.ResourceQuotaScopePriorityClass, v1.ResourceQuotaScopeCrossNamespacePodAffinity:
+		return true
+	default:
+		return false
+	}
+}
+
+func sortedResourceNames[T any](resources map[v1.ResourceName]T) []v1.ResourceName {
+	names := make([]v1.ResourceName, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
@@ -0,0 +1,107 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcequota
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCreateAndUpdateResourceQuota(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	spec := &ResourceQuotaSpec{
+		Name: "compute",
+		Hard: map[v1.ResourceName]string{v1.ResourceRequestsCPU: "4", "requests.nvidia.com/gpu": "2"},
+	}
+
+	quota, err := CreateResourceQuota(client, "default", spec)
+	if err != nil {
+		t.Fatalf("CreateResourceQuota() returned error: %v", err)
+	}
+
+	if quota.ObjectMeta.Name != "compute" {
+		t.Errorf("CreateResourceQuota() created %s, expected compute", quota.ObjectMeta.Name)
+	}
+
+	spec.Hard = map[v1.ResourceName]string{v1.ResourcePods: "5"}
+	spec.Scopes = []v1.ResourceQuotaScope{v1.ResourceQuotaScopeBestEffort}
+	if _, err = UpdateResourceQuota(client, "default", "compute", spec); err != nil {
+		t.Fatalf("UpdateResourceQuota() returned error: %v", err)
+	}
+
+	raw, _ := client.CoreV1().ResourceQuotas("default").Get(context.TODO(), "compute", metaV1.GetOptions{})
+	if len(raw.Spec.Hard) != 1 || raw.Spec.Hard.Pods().String() != "5" || len(raw.Spec.Scopes) != 1 {
+		t.Errorf("UpdateResourceQuota() stored %#v", raw.Spec)
+	}
+}
+
+func TestValidateResourceQuotaSpec(t *testing.T) {
+	cases := []struct {
+		spec     *ResourceQuotaSpec
+		problems []string
+	}{
+		{&ResourceQuotaSpec{}, []string{"at least one hard limit is required"}},
+		{
+			&ResourceQuotaSpec{Hard: map[v1.ResourceName]string{"cpus": "1", v1.ResourceMemory: "a lot", v1.ResourcePods: "-1"}},
+			[]string{"cpus is not a resource", "invalid quantity \"a lot\" of memory", "pods must not be negative"},
+		},
+		{
+			&ResourceQuotaSpec{
+				Hard:   map[v1.ResourceName]string{v1.ResourceRequestsCPU: "1"},
+				Scopes: []v1.ResourceQuotaScope{v1.ResourceQuotaScopeBestEffort, v1.ResourceQuotaScopeNotBestEffort, "Weekend"},
+			},
+			[]string{"scopes BestEffort and NotBestEffort are mutually exclusive", "unsupported scope Weekend",
+				"requests.cpu can not be limited in the BestEffort scope"},
+		},
+		{
+			&ResourceQuotaSpec{
+				Hard: map[v1.ResourceName]string{v1.ResourcePods: "1"},
+				ScopeSelector: &v1.ScopeSelector{MatchExpressions: []v1.ScopedResourceSelectorRequirement{
+					{ScopeName: v1.ResourceQuotaScopePriorityClass, Operator: v1.ScopeSelectorOpIn},
+					{ScopeName: v1.ResourceQuotaScopeTerminating, Operator: v1.ScopeSelectorOpNotIn, Values: []string{"x"}},
+				}},
+			},
+			[]string{"values are required for In operator of PriorityClass scope", "Terminating scope supports only Exists operator"},
+		},
+	}
+
+	for _, c := range cases {
+		_, err := validateSpec(c.spec)
+		if !k8serrors.IsBadRequest(err) {
+			t.Errorf("validateSpec(%#v) returned %v, expected bad request", c.spec, err)
+			continue
+		}
+
+		for _, problem := range c.problems {
+			if !strings.Contains(err.Error(), problem) {
+				t.Errorf("validateSpec(%#v) returned %q, expected it to contain %q", c.spec, err, problem)
+			}
+		}
+	}
+
+	_, err := validateSpec(&ResourceQuotaSpec{Hard: map[v1.ResourceName]string{
+		"count/deployments.apps":                            "10",
+		"gold.storageclass.storage.k8s.io/requests.storage": "100Gi",
+	}})
+	if err != nil {
+		t.Errorf("validateSpec() returned unexpected error: %v", err)
+	}
+}
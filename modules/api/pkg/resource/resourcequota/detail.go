@@ -15,7 +15,12 @@
 package resourcequota
 
 import (
+	"context"
+
 	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
 
 	"k8s.io/dashboard/types"
 )
@@ -61,3 +66,16 @@ func ToResourceQuotaDetail(rawResourceQuota *v1.ResourceQuota) *ResourceQuotaDet
 		StatusList: statusList,
 	}
 }
+
+// GetResourceQuota returns the resource quota with usage of its limited resources.
+func GetResourceQuota(client kubernetes.Interface, namespace, name string) (*ResourceQuota, error) {
+	klog.V(4).Infof("Getting details of %s resource quota in %s namespace", name, namespace)
+
+	rawQuota, err := client.CoreV1().ResourceQuotas(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	quota := toResourceQuota(rawQuota)
+	return &quota, nil
+}
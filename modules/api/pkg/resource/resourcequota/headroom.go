@@ -0,0 +1,424 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcequota
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/errors"
+)
+
+// computeQuotaResources are resources which must be set on pods in namespaces where they are limited
+// by a quota, otherwise the pods are rejected.
+var computeQuotaResources = map[v1.ResourceName]bool{
+	v1.ResourceCPU:            true,
+	v1.ResourceMemory:         true,
+	v1.ResourceRequestsCPU:    true,
+	v1.ResourceRequestsMemory: true,
+	v1.ResourceLimitsCPU:      true,
+	v1.ResourceLimitsMemory:   true,
+}
+
+// HeadroomSpec describes a pod template to compute the quota headroom for.
+type HeadroomSpec struct {
+	Template v1.PodTemplateSpec `json:"template"`
+}
+
+// Headroom tells how many more replicas of a pod template fit into resource quotas of a namespace.
+type Headroom struct {
+	// Replicas that still fit into all quotas. Nil when no quota limits the pods.
+	Replicas *int32 `json:"replicas"`
+
+	// Quota and resource which limit the replicas the most. Empty when the replicas are not limited.
+	LimitedBy         string          `json:"limitedBy,omitempty"`
+	LimitedByResource v1.ResourceName `json:"limitedByResource,omitempty"`
+
+	// Requests and limits of a single replica after defaults of limit ranges are applied.
+	Requests v1.ResourceList `json:"requests"`
+	Limits   v1.ResourceList `json:"limits"`
+
+	// Quotas whose scopes match the pod template.
+	Quotas []QuotaHeadroom `json:"quotas"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// QuotaHeadroom is the headroom left by a single resource quota.
+type QuotaHeadroom struct {
+	Name              string             `json:"name"`
+	Replicas          *int32             `json:"replicas"`
+	LimitedByResource v1.ResourceName    `json:"limitedByResource,omitempty"`
+	Resources         []ResourceHeadroom `json:"resources"`
+}
+
+// ResourceHeadroom is the headroom of a single resource limited by a quota.
+type ResourceHeadroom struct {
+	Name       v1.ResourceName   `json:"name"`
+	PerReplica resource.Quantity `json:"perReplica"`
+	Remaining  resource.Quantity `json:"remaining"`
+	Replicas   int32             `json:"replicas"`
+
+	// Reason is set when no replica fits for other reason than remaining quota, e.g. when the
+	// resource is limited by the quota but not set by the pod template.
+	Reason string `json:"reason,omitempty"`
+}
+
+// GetHeadroom returns how many more replicas of the pod template fit into resource quotas of the
+// namespace. Defaults of limit ranges in the namespace are applied to the template first.
+func GetHeadroom(client kubernetes.Interface, namespace string, spec *HeadroomSpec) (*Headroom, error) {
+	if len(spec.Template.Spec.Containers) == 0 {
+		return nil, errors.NewBadRequest("template must have at least one container")
+	}
+
+	klog.V(4).Infof("Getting quota headroom in %s namespace", namespace)
+	nsQuery := common.NewSameNamespaceQuery(namespace)
+	channels := &common.ResourceChannels{
+		ResourceQuotaList: common.GetResourceQuotaListChannel(client, nsQuery, 1),
+		LimitRangeList:    common.GetLimitRangeListChannel(client, nsQuery, 1),
+	}
+
+	quotas := <-channels.ResourceQuotaList.List
+	err := <-channels.ResourceQuotaList.Error
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	limitRanges := <-channels.LimitRangeList.List
+	err = <-channels.LimitRangeList.Error
+	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	var quotaItems []v1.ResourceQuota
+	if quotas != nil {
+		quotaItems = quotas.Items
+	}
+
+	var limitRangeItems []v1.LimitRange
+	if limitRanges != nil {
+		limitRangeItems = limitRanges.Items
+	}
+
+	headroom := toHeadroom(spec.Template.Spec.DeepCopy(), quotaItems, limitRangeItems)
+	headroom.Errors = nonCriticalErrors
+	return headroom, nil
+}
+
+func toHeadroom(pod *v1.PodSpec, quotas []v1.ResourceQuota, limitRanges []v1.LimitRange) *Headroom {
+	applyLimitRangeDefaults(pod, limitRanges)
+	requests, limits := podRequestsAndLimits(pod)
+
+	headroom := &Headroom{Requests: requests, Limits: limits, Quotas: make([]QuotaHeadroom, 0)}
+	sort.Slice(quotas, func(i, j int) bool { return quotas[i].Name < quotas[j].Name })
+	for _, quota := range quotas {
+		if !quotaMatchesPod(&quota, pod, requests, limits) {
+			continue
+		}
+
+		quotaHeadroom := toQuotaHeadroom(&quota, requests, limits)
+		headroom.Quotas = append(headroom.Quotas, quotaHeadroom)
+		if quotaHeadroom.Replicas != nil && (headroom.Replicas == nil || *quotaHeadroom.Replicas < *headroom.Replicas) {
+			headroom.Replicas = quotaHeadroom.Replicas
+			headroom.LimitedBy = quotaHeadroom.Name
+			headroom.LimitedByResource = quotaHeadroom.LimitedByResource
+		}
+	}
+
+	return headroom
+}
+
+func toQuotaHeadroom(quota *v1.ResourceQuota, requests, limits v1.ResourceList) QuotaHeadroom {
+	result := QuotaHeadroom{Name: quota.Name, Resources: make([]ResourceHeadroom, 0)}
+	for _, name := range sortedResourceNames(quota.Spec.Hard) {
+		perReplica, limited := perReplicaUsage(name, requests, limits)
+		if !limited {
+			continue
+		}
+
+		hard := quota.Spec.Hard[name]
+		if statusHard, exists := quota.Status.Hard[name]; exists {
+			hard = statusHard
+		}
+
+		remaining := hard.DeepCopy()
+		remaining.Sub(quota.Status.Used[name])
+		if remaining.Sign() < 0 {
+			remaining = *resource.NewQuantity(0, remaining.Format)
+		}
+
+		r := ResourceHeadroom{Name: name, PerReplica: perReplica, Remaining: remaining}
+		switch {
+		case perReplica.IsZero() && computeQuotaResources[name]:
+			r.Reason = fmt.Sprintf("%s is limited by the quota, but not set by the pod template", name)
+		case perReplica.IsZero():
+			continue
+		default:
+			r.Replicas = fitReplicas(name, remaining, perReplica)
+		}
+
+		result.Resources = append(result.Resources, r)
+		if result.Replicas == nil || r.Replicas < *result.Replicas {
+			replicas := r.Replicas
+			result.Replicas = &replicas
+			result.LimitedByResource = name
+		}
+	}
+
+	return result
+}
+
+// perReplicaUsage returns how much of the quota resource a single pod uses and whether the resource
+// is used by pods at all.
+func perReplicaUsage(name v1.ResourceName, requests, limits v1.ResourceList) (resource.Quantity, bool) {
+	switch name {
+	case v1.ResourcePods, "count/pods":
+		return *resource.NewQuantity(1, resource.DecimalSI), true
+	case v1.ResourceCPU, v1.ResourceMemory, v1.ResourceEphemeralStorage:
+		return requests[name], true
+	}
+
+	if resourceName, ok := strings.CutPrefix(string(name), "requests."); ok && resourceName != "storage" {
+		return requests[v1.ResourceName(resourceName)], true
+	}
+
+	if resourceName, ok := strings.CutPrefix(string(name), "limits."); ok {
+		return limits[v1.ResourceName(resourceName)], true
+	}
+
+	return resource.Quantity{}, false
+}
+
+func fitReplicas(name v1.ResourceName, remaining, perReplica resource.Quantity) int32 {
+	var replicas int64
+	if strings.HasSuffix(string(name), string(v1.ResourceCPU)) {
+		replicas = remaining.MilliValue() / perReplica.MilliValue()
+	} else {
+		replicas = remaining.Value() / perReplica.Value()
+	}
+
+	return int32(min(replicas, math.MaxInt32))
+}
+
+// quotaMatchesPod tells whether scopes and the scope selector of the quota match the pod.
+func quotaMatchesPod(quota *v1.ResourceQuota, pod *v1.PodSpec, requests, limits v1.ResourceList) bool {
+	for _, scope := range quota.Spec.Scopes {
+		requirement := v1.ScopedResourceSelectorRequirement{ScopeName: scope, Operator: v1.ScopeSelectorOpExists}
+		if !scopeMatchesPod(requirement, pod, requests, limits) {
+			return false
+		}
+	}
+
+	if quota.Spec.ScopeSelector != nil {
+		for _, requirement := range quota.Spec.ScopeSelector.MatchExpressions {
+			if !scopeMatchesPod(requirement, pod, requests, limits) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func scopeMatchesPod(requirement v1.ScopedResourceSelectorRequirement, pod *v1.PodSpec, requests, limits v1.ResourceList) bool {
+	bestEffort := len(requests) == 0 && len(limits) == 0
+	switch requirement.ScopeName {
+	case v1.ResourceQuotaScopeTerminating:
+		return pod.ActiveDeadlineSeconds != nil && *pod.ActiveDeadlineSeconds >= 0
+	case v1.ResourceQuotaScopeNotTerminating:
+		return pod.ActiveDeadlineSeconds == nil
+	case v1.ResourceQuotaScopeBestEffort:
+		return bestEffort
+	case v1.ResourceQuotaScopeNotBestEffort:
+		return !bestEffort
+	case v1.ResourceQuotaScopeCrossNamespacePodAffinity:
+		return usesCrossNamespaceAffinity(pod)
+	case v1.ResourceQuotaScopePriorityClass:
+		switch requirement.Operator {
+		case v1.ScopeSelectorOpExists:
+			return len(pod.PriorityClassName) > 0
+		case v1.ScopeSelectorOpDoesNotExist:
+			return len(pod.PriorityClassName) == 0
+		case v1.ScopeSelectorOpIn:
+			return slices.Contains(requirement.Values, pod.PriorityClassName)
+		case v1.ScopeSelectorOpNotIn:
+			return !slices.Contains(requirement.Values, pod.PriorityClassName)
+		}
+	}
+
+	return false
+}
+
+func usesCrossNamespaceAffinity(pod *v1.PodSpec) bool {
+	if pod.Affinity == nil {
+		return false
+	}
+
+	terms := make([]v1.PodAffinityTerm, 0)
+	if pod.Affinity.PodAffinity != nil {
+		terms = append(terms, pod.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution...)
+		for _, term := range pod.Affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			terms = append(terms, term.PodAffinityTerm)
+		}
+	}
+
+	if pod.Affinity.PodAntiAffinity != nil {
+		terms = append(terms, pod.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution...)
+		for _, term := range pod.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			terms = append(terms, term.PodAffinityTerm)
+		}
+	}
+
+	for _, term := range terms {
+		if len(term.Namespaces) > 0 || term.NamespaceSelector != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// applyLimitRangeDefaults sets default requests and limits of container limit ranges on containers
+// which do not set them, the same way as the LimitRanger admission plugin does.
+func applyLimitRangeDefaults(pod *v1.PodSpec, limitRanges []v1.LimitRange) {
+	defaults, defaultRequests := v1.ResourceList{}, v1.ResourceList{}
+	for _, limitRange := range limitRanges {
+		for _, item := range limitRange.Spec.Limits {
+			if item.Type != v1.LimitTypeContainer {
+				continue
+			}
+
+			for name, quantity := range item.Default {
+				defaults[name] = quantity
+			}
+
+			for name, quantity := range item.DefaultRequest {
+				defaultRequests[name] = quantity
+			}
+		}
+	}
+
+	apply := func(container *v1.Container) {
+		if container.Resources.Limits == nil {
+			container.Resources.Limits = v1.ResourceList{}
+		}
+
+		if container.Resources.Requests == nil {
+			container.Resources.Requests = v1.ResourceList{}
+		}
+
+		for name, quantity := range defaults {
+			if _, exists := container.Resources.Limits[name]; !exists {
+				container.Resources.Limits[name] = quantity
+			}
+		}
+
+		for name, quantity := range defaultRequests {
+			if _, exists := container.Resources.Requests[name]; !exists {
+				container.Resources.Requests[name] = quantity
+			}
+		}
+
+		// Requests default to limits when only limits are set.
+		for name, quantity := range container.Resources.Limits {
+			if _, exists := container.Resources.Requests[name]; !exists {
+				container.Resources.Requests[name] = quantity
+			}
+		}
+	}
+
+	for i := range pod.InitContainers {
+		apply(&pod.InitContainers[i])
+	}
+
+	for i := range pod.Containers {
+		apply(&pod.Containers[i])
+	}
+}
+
+// podRequestsAndLimits returns effective requests and limits of the pod. Init containers run one by
+// one, so the pod uses at most the maximum of them, while sidecar init containers keep running with
+// the init containers started after them and with the regular containers.
+func podRequestsAndLimits(pod *v1.PodSpec) (v1.ResourceList, v1.ResourceList) {
+	requests, limits := v1.ResourceList{}, v1.ResourceList{}
+	for _, container := range pod.Containers {
+		addResources(requests, container.Resources.Requests)
+		addResources(limits, container.Resources.Limits)
+	}
+
+	sidecarRequests, sidecarLimits := v1.ResourceList{}, v1.ResourceList{}
+	initRequests, initLimits := v1.ResourceList{}, v1.ResourceList{}
+	for _, container := range pod.InitContainers {
+		if container.RestartPolicy != nil && *container.RestartPolicy == v1.ContainerRestartPolicyAlways {
+			addResources(sidecarRequests, container.Resources.Requests)
+			addResources(sidecarLimits, container.Resources.Limits)
+			maxResources(initRequests, sidecarRequests)
+			maxResources(initLimits, sidecarLimits)
+			continue
+		}
+
+		containerRequests, containerLimits := v1.ResourceList{}, v1.ResourceList{}
+		addResources(containerRequests, container.Resources.Requests)
+		addResources(containerRequests, sidecarRequests)
+		addResources(containerLimits, container.Resources.Limits)
+		addResources(containerLimits, sidecarLimits)
+		maxResources(initRequests, containerRequests)
+		maxResources(initLimits, containerLimits)
+	}
+
+	addResources(requests, sidecarRequests)
+	addResources(limits, sidecarLimits)
+	maxResources(requests, initRequests)
+	maxResources(limits, initLimits)
+
+	// Overhead is added to limits only for resources which are limited.
+	addResources(requests, pod.Overhead)
+	for name, quantity := range pod.Overhead {
+		if limit, exists := limits[name]; exists {
+			limit.Add(quantity)
+			limits[name] = limit
+		}
+	}
+
+	return requests, limits
+}
+
+func addResources(list, other v1.ResourceList) {
+	for name, quantity := range other {
+		sum := list[name]
+		sum.Add(quantity)
+		list[name] = sum
+	}
+}
+
+func maxResources(list, other v1.ResourceList) {
+	for name, quantity := range other {
+		if current, exists := list[name]; !exists || quantity.Cmp(current) > 0 {
+			list[name] = quantity.DeepCopy()
+		}
+	}
+}
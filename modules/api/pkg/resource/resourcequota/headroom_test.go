@@ -0,0 +1,134 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcequota
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetHeadroom(t *testing.T) {
+	always := v1.ContainerRestartPolicyAlways
+	template := v1.PodTemplateSpec{Spec: v1.PodSpec{
+		InitContainers: []v1.Container{
+			{Name: "proxy", RestartPolicy: &always, Resources: v1.ResourceRequirements{Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("100m")}}},
+			{Name: "migrate", Resources: v1.ResourceRequirements{Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")}}},
+		},
+		Containers: []v1.Container{
+			{Name: "app", Resources: v1.ResourceRequirements{Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("400m")}}},
+		},
+	}}
+
+	limitRange := &v1.LimitRange{
+		ObjectMeta: metaV1.ObjectMeta{Name: "defaults", Namespace: "default"},
+		Spec: v1.LimitRangeSpec{Limits: []v1.LimitRangeItem{{
+			Type:           v1.LimitTypeContainer,
+			Default:        v1.ResourceList{v1.ResourceMemory: resource.MustParse("256Mi")},
+			DefaultRequest: v1.ResourceList{v1.ResourceMemory: resource.MustParse("128Mi")},
+		}}},
+	}
+
+	cases := []struct {
+		name      string
+		quotas    []*v1.ResourceQuota
+		replicas  *int32
+		limitedBy string
+		resource  v1.ResourceName
+	}{
+		{"no quotas", nil, nil, "", ""},
+		{
+			"limited by memory",
+			[]*v1.ResourceQuota{
+				newQuota("compute", v1.ResourceList{
+					v1.ResourceRequestsCPU:    resource.MustParse("10"),
+					v1.ResourceRequestsMemory: resource.MustParse("2Gi"),
+					v1.ResourceServices:       resource.MustParse("1"),
+				}, v1.ResourceList{
+					v1.ResourceRequestsCPU:    resource.MustParse("1"),
+					v1.ResourceRequestsMemory: resource.MustParse("1536Mi"),
+				}),
+				newQuota("pods", v1.ResourceList{v1.ResourcePods: resource.MustParse("10")}, v1.ResourceList{v1.ResourcePods: resource.MustParse("3")}),
+			},
+			// Memory of all containers defaults to 128Mi and the sidecar runs with the app, so a replica needs 256Mi.
+			toInt32(2), "compute", v1.ResourceRequestsMemory,
+		},
+		{
+			"limited by pods",
+			[]*v1.ResourceQuota{
+				newQuota("pods", v1.ResourceList{v1.ResourcePods: resource.MustParse("10")}, v1.ResourceList{v1.ResourcePods: resource.MustParse("12")}),
+			},
+			toInt32(0), "pods", v1.ResourcePods,
+		},
+		{
+			"not set by template",
+			[]*v1.ResourceQuota{
+				newQuota("limits", v1.ResourceList{v1.ResourceLimitsCPU: resource.MustParse("10")}, v1.ResourceList{}),
+			},
+			toInt32(0), "limits", v1.ResourceLimitsCPU,
+		},
+		{
+			"scope does not match",
+			[]*v1.ResourceQuota{func() *v1.ResourceQuota {
+				quota := newQuota("best-effort", v1.ResourceList{v1.ResourcePods: resource.MustParse("0")}, v1.ResourceList{})
+				quota.Spec.Scopes = []v1.ResourceQuotaScope{v1.ResourceQuotaScopeBestEffort}
+				return quota
+			}()},
+			nil, "", "",
+		},
+	}
+
+	for _, c := range cases {
+		client := fake.NewSimpleClientset(limitRange)
+		for _, quota := range c.quotas {
+			_ = client.Tracker().Add(quota)
+		}
+
+		headroom, err := GetHeadroom(client, "default", &HeadroomSpec{Template: template})
+		if err != nil {
+			t.Fatalf("%s: GetHeadroom() returned error: %v", c.name, err)
+		}
+
+		if !equalInt32(headroom.Replicas, c.replicas) || headroom.LimitedBy != c.limitedBy || headroom.LimitedByResource != c.resource {
+			t.Errorf("%s: GetHeadroom() == %v by %s/%s, expected %v by %s/%s", c.name, headroom.Replicas,
+				headroom.LimitedBy, headroom.LimitedByResource, c.replicas, c.limitedBy, c.resource)
+		}
+	}
+
+	// The init container needs 2 + 0.1 CPU, more than the sidecar and the app together.
+	requests, _ := podRequestsAndLimits(&template.Spec)
+	if cpu := requests[v1.ResourceCPU]; cpu.String() != "2100m" {
+		t.Errorf("podRequestsAndLimits() cpu == %s, expected 2100m", cpu.String())
+	}
+
+	if template.Spec.Containers[0].Resources.Requests.Memory().String() != "0" {
+		t.Error("GetHeadroom() modified the template")
+	}
+}
+
+func toInt32(value int32) *int32 {
+	return &value
+}
+
+func equalInt32(a, b *int32) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
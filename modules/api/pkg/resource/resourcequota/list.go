@@ -0,0 +1,109 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcequota
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// ResourceQuotaList holds a list of resource quotas with their usage.
+type ResourceQuotaList struct {
+	ListMeta types.ListMeta  `json:"listMeta"`
+	Items    []ResourceQuota `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// ResourceQuota is a resource quota with usage of its limited resources in percents.
+type ResourceQuota struct {
+	ResourceQuotaDetail `json:",inline"`
+
+	// ScopeSelector limits the quota to pods of matching priority classes or other scopes.
+	ScopeSelector *v1.ScopeSelector `json:"scopeSelector,omitempty"`
+
+	// Usage of each limited resource in percents, rounded down.
+	Usage map[v1.ResourceName]int `json:"usage"`
+
+	// MaxUsage is the highest usage of all limited resources. Lists can be sorted by it with the
+	// "usage" property, and by usage of a single resource with the "usage.<resource name>" property.
+	MaxUsage int `json:"maxUsage"`
+}
+
+// GetResourceQuotaList returns a list of resource quotas in the namespaces.
+func GetResourceQuotaList(client kubernetes.Interface, nsQuery *common.NamespaceQuery,
+	dsQuery *dataselect.DataSelectQuery) (*ResourceQuotaList, error) {
+	klog.V(4).Infof("Getting list of resource quotas in %s namespace", nsQuery.ToRequestParam())
+
+	channels := &common.ResourceChannels{
+		ResourceQuotaList: common.GetResourceQuotaListChannel(client, nsQuery, 1),
+	}
+
+	return GetResourceQuotaListFromChannels(channels, dsQuery)
+}
+
+// GetResourceQuotaListFromChannels returns a list of resource quotas from the channels.
+func GetResourceQuotaListFromChannels(channels *common.ResourceChannels,
+	dsQuery *dataselect.DataSelectQuery) (*ResourceQuotaList, error) {
+	quotas := <-channels.ResourceQuotaList.List
+	err := <-channels.ResourceQuotaList.Error
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	var items []v1.ResourceQuota
+	if quotas != nil {
+		items = quotas.Items
+	}
+
+	return toResourceQuotaList(items, nonCriticalErrors, dsQuery), nil
+}
+
+func toResourceQuotaList(quotas []v1.ResourceQuota, nonCriticalErrors []error,
+	dsQuery *dataselect.DataSelectQuery) *ResourceQuotaList {
+	quotaList := &ResourceQuotaList{
+		Items:    make([]ResourceQuota, 0),
+		ListMeta: types.ListMeta{TotalItems: len(quotas)},
+		Errors:   nonCriticalErrors,
+	}
+
+	quotaCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(quotas), dsQuery)
+	quotas = fromCells(quotaCells)
+	quotaList.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+
+	for _, quota := range quotas {
+		quotaList.Items = append(quotaList.Items, toResourceQuota(&quota))
+	}
+
+	return quotaList
+}
+
+func toResourceQuota(quota *v1.ResourceQuota) ResourceQuota {
+	usage := getUsage(quota)
+	return ResourceQuota{
+		ResourceQuotaDetail: *ToResourceQuotaDetail(quota),
+		ScopeSelector:       quota.Spec.ScopeSelector,
+		Usage:               usage,
+		MaxUsage:            getMaxUsage(usage),
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcequota
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+)
+
+func newQuota(name string, hard, used v1.ResourceList) *v1.ResourceQuota {
+	return &v1.ResourceQuota{
+		ObjectMeta: metaV1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec:       v1.ResourceQuotaSpec{Hard: hard},
+		Status:     v1.ResourceQuotaStatus{Hard: hard, Used: used},
+	}
+}
+
+func TestGetResourceQuotaListSortsByUsage(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		newQuota("low", v1.ResourceList{
+			v1.ResourceRequestsCPU: resource.MustParse("4"),
+			v1.ResourcePods:        resource.MustParse("10"),
+		}, v1.ResourceList{
+			v1.ResourceRequestsCPU: resource.MustParse("1"),
+			v1.ResourcePods:        resource.MustParse("2"),
+		}),
+		newQuota("high", v1.ResourceList{
+			v1.ResourceRequestsCPU: resource.MustParse("4"),
+			v1.ResourcePods:        resource.MustParse("10"),
+		}, v1.ResourceList{
+			v1.ResourceRequestsCPU: resource.MustParse("500m"),
+			v1.ResourcePods:        resource.MustParse("9"),
+		}),
+		newQuota("zero", v1.ResourceList{
+			v1.ResourceServices: resource.MustParse("0"),
+		}, v1.ResourceList{}),
+	)
+
+	cases := []struct {
+		sort     []string
+		expected []string
+	}{
+		{[]string{"d", "usage"}, []string{"zero", "high", "low"}},
+		{[]string{"d", "usage.requests.cpu"}, []string{"low", "high", "zero"}},
+	}
+
+	for _, c := range cases {
+		dsQuery := dataselect.NewDataSelectQuery(dataselect.NoPagination, dataselect.NewSortQuery(c.sort),
+			dataselect.NoFilter, dataselect.NoMetrics)
+		list, err := GetResourceQuotaList(client, common.NewNamespaceQuery(nil), dsQuery)
+		if err != nil {
+			t.Fatalf("GetResourceQuotaList() returned error: %v", err)
+		}
+
+		actual := make([]string, 0)
+		for _, item := range list.Items {
+			actual = append(actual, item.ObjectMeta.Name)
+		}
+
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("GetResourceQuotaList() sorted by %v == %v, expected %v", c.sort, actual, c.expected)
+		}
+	}
+
+	quota, err := GetResourceQuota(client, "default", "high")
+	if err != nil {
+		t.Fatalf("GetResourceQuota() returned error: %v", err)
+	}
+
+	expected := map[v1.ResourceName]int{v1.ResourceRequestsCPU: 12, v1.ResourcePods: 90}
+	if !reflect.DeepEqual(quota.Usage, expected) || quota.MaxUsage != 90 {
+		t.Errorf("GetResourceQuota() usage == %v (max %d), expected %v (max 90)", quota.Usage, quota.MaxUsage, expected)
+	}
+}
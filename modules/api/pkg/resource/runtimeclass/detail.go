@@ -0,0 +1,69 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtimeclass
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+// RuntimeClassDetail provides the presentation layer view of Runtime Class resource.
+type RuntimeClassDetail struct {
+	// Extends list item structure.
+	RuntimeClass `json:",inline"`
+
+	// Overhead is added to resource requests of pods running with the class.
+	Overhead v1.ResourceList `json:"overhead,omitempty"`
+
+	// NodeSelector and Tolerations are merged into pods running with the class, so that they are
+	// scheduled only to nodes supporting it.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	Tolerations  []v1.Toleration   `json:"tolerations,omitempty"`
+}
+
+// GetRuntimeClass returns Runtime Class resource.
+func GetRuntimeClass(client kubernetes.Interface, name string) (*RuntimeClassDetail, error) {
+	klog.V(4).Infof("Getting details of %s runtime class", name)
+
+	rc, err := client.NodeV1().RuntimeClasses().Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	runtimeClass := toRuntimeClassDetail(rc)
+	return &runtimeClass, nil
+}
+
+func toRuntimeClassDetail(runtimeClass *nodev1.RuntimeClass) RuntimeClassDetail {
+	detail := RuntimeClassDetail{
+		RuntimeClass: toRuntimeClass(runtimeClass),
+	}
+
+	if runtimeClass.Overhead != nil {
+		detail.Overhead = runtimeClass.Overhead.PodFixed
+	}
+
+	if runtimeClass.Scheduling != nil {
+		detail.NodeSelector = runtimeClass.Scheduling.NodeSelector
+		detail.Tolerations = runtimeClass.Scheduling.Tolerations
+	}
+
+	return detail
+}
@@ -0,0 +1,72 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtimeclass
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/types"
+)
+
+func TestGetRuntimeClass(t *testing.T) {
+	overhead := v1.ResourceList{v1.ResourceMemory: resource.MustParse("120Mi")}
+	tolerations := []v1.Toleration{{Key: "sandboxed", Operator: v1.TolerationOpExists}}
+	client := fake.NewSimpleClientset(&nodev1.RuntimeClass{
+		ObjectMeta: metaV1.ObjectMeta{Name: "gvisor"},
+		Handler:    "runsc",
+		Overhead:   &nodev1.Overhead{PodFixed: overhead},
+		Scheduling: &nodev1.Scheduling{
+			NodeSelector: map[string]string{"sandbox": "gvisor"},
+			Tolerations:  tolerations,
+		},
+	})
+
+	actual, err := GetRuntimeClass(client, "gvisor")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := &RuntimeClassDetail{
+		RuntimeClass: RuntimeClass{
+			ObjectMeta: types.ObjectMeta{Name: "gvisor"},
+			TypeMeta:   types.TypeMeta{Kind: types.ResourceKindRuntimeClass},
+			Handler:    "runsc",
+		},
+		Overhead:     overhead,
+		NodeSelector: map[string]string{"sandbox": "gvisor"},
+		Tolerations:  tolerations,
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("GetRuntimeClass() == got\n%#v, expected\n %#v", actual, expected)
+	}
+
+	list, err := GetRuntimeClassList(client, dataselect.NoDataSelect)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if list.ListMeta.TotalItems != 1 || !reflect.DeepEqual(list.Items[0], expected.RuntimeClass) {
+		t.Errorf("GetRuntimeClassList() == got\n%#v, expected single\n %#v", list.Items, expected.RuntimeClass)
+	}
+}
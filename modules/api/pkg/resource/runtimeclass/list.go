@@ -0,0 +1,95 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtimeclass
+
+import (
+	nodev1 "k8s.io/api/node/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// RuntimeClassList holds a list of Runtime Class objects in the cluster.
+type RuntimeClassList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+	Items    []RuntimeClass `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// RuntimeClass is a representation of a Kubernetes Runtime Class object.
+type RuntimeClass struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+
+	// Handler is the name of the CRI runtime handler used by pods of the class.
+	Handler string `json:"handler"`
+}
+
+// GetRuntimeClassList returns a list of all runtime class objects in the cluster.
+func GetRuntimeClassList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*RuntimeClassList, error) {
+	klog.V(4).Infof("Getting list of runtime classes in the cluster")
+
+	channels := &common.ResourceChannels{
+		RuntimeClassList: common.GetRuntimeClassListChannel(client, 1),
+	}
+
+	return GetRuntimeClassListFromChannels(channels, dsQuery)
+}
+
+// GetRuntimeClassListFromChannels returns a list of all runtime class objects in the cluster.
+func GetRuntimeClassListFromChannels(channels *common.ResourceChannels,
+	dsQuery *dataselect.DataSelectQuery) (*RuntimeClassList, error) {
+	runtimeClasses := <-channels.RuntimeClassList.List
+	err := <-channels.RuntimeClassList.Error
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toRuntimeClassList(runtimeClasses.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toRuntimeClassList(runtimeClasses []nodev1.RuntimeClass, nonCriticalErrors []error,
+	dsQuery *dataselect.DataSelectQuery) *RuntimeClassList {
+	runtimeClassList := &RuntimeClassList{
+		Items:    make([]RuntimeClass, 0),
+		ListMeta: types.ListMeta{TotalItems: len(runtimeClasses)},
+		Errors:   nonCriticalErrors,
+	}
+
+	runtimeClassCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(runtimeClasses), dsQuery)
+	runtimeClasses = fromCells(runtimeClassCells)
+	runtimeClassList.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+
+	for _, runtimeClass := range runtimeClasses {
+		runtimeClassList.Items = append(runtimeClassList.Items, toRuntimeClass(&runtimeClass))
+	}
+
+	return runtimeClassList
+}
+
+func toRuntimeClass(runtimeClass *nodev1.RuntimeClass) RuntimeClass {
+	return RuntimeClass{
+		ObjectMeta: types.NewObjectMeta(runtimeClass.ObjectMeta),
+		TypeMeta:   types.NewTypeMeta(types.ResourceKindRuntimeClass),
+		Handler:    runtimeClass.Handler,
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package volumeattachment
+
+import (
+	"context"
+
+	storage "k8s.io/api/storage/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+// VolumeAttachmentDetail provides the presentation layer view of Volume Attachment resource.
+type VolumeAttachmentDetail struct {
+	// Extends list item structure.
+	VolumeAttachment `json:",inline"`
+
+	// AttachmentMetadata is returned by the attacher and passed to the node on mount.
+	AttachmentMetadata map[string]string `json:"attachmentMetadata,omitempty"`
+}
+
+// GetVolumeAttachment returns Volume Attachment resource.
+func GetVolumeAttachment(client kubernetes.Interface, name string) (*VolumeAttachmentDetail, error) {
+	klog.V(4).Infof("Getting details of %s volume attachment", name)
+
+	va, err := client.StorageV1().VolumeAttachments().Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := toVolumeAttachmentDetail(va)
+	return &attachment, nil
+}
+
+func toVolumeAttachmentDetail(attachment *storage.VolumeAttachment) VolumeAttachmentDetail {
+	return VolumeAttachmentDetail{
+		VolumeAttachment:   toVolumeAttachment(attachment),
+		AttachmentMetadata: attachment.Status.AttachmentMetadata,
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package volumeattachment
+
+import (
+	storage "k8s.io/api/storage/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// Statuses of volume attachments.
+const (
+	StatusAttached     = "Attached"
+	StatusAttaching    = "Attaching"
+	StatusAttachFailed = "AttachFailed"
+	StatusDetaching    = "Detaching"
+	StatusDetachFailed = "DetachFailed"
+)
+
+// VolumeAttachmentList holds a list of Volume Attachment objects in the cluster.
+type VolumeAttachmentList struct {
+	ListMeta types.ListMeta     `json:"listMeta"`
+	Items    []VolumeAttachment `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// VolumeAttachment is a representation of a Kubernetes Volume Attachment object.
+type VolumeAttachment struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+	Attacher   string           `json:"attacher"`
+	NodeName   string           `json:"nodeName"`
+
+	// PersistentVolumeName is empty for inline volumes migrated to CSI.
+	PersistentVolumeName string `json:"persistentVolumeName"`
+
+	Attached    bool                 `json:"attached"`
+	Status      string               `json:"status"`
+	AttachError *storage.VolumeError `json:"attachError,omitempty"`
+	DetachError *storage.VolumeError `json:"detachError,omitempty"`
+}
+
+// GetVolumeAttachmentList returns a list of all volume attachment objects in the cluster.
+func GetVolumeAttachmentList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*VolumeAttachmentList, error) {
+	klog.V(4).Infof("Getting list of volume attachments in the cluster")
+
+	channels := &common.ResourceChannels{
+		VolumeAttachmentList: common.GetVolumeAttachmentListChannel(client, 1),
+	}
+
+	return GetVolumeAttachmentListFromChannels(channels, dsQuery)
+}
+
+// GetVolumeAttachmentListFromChannels returns a list of all volume attachment objects in the cluster.
+func GetVolumeAttachmentListFromChannels(channels *common.ResourceChannels,
+	dsQuery *dataselect.DataSelectQuery) (*VolumeAttachmentList, error) {
+	attachments := <-channels.VolumeAttachmentList.List
+	err := <-channels.VolumeAttachmentList.Error
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toVolumeAttachmentList(attachments.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toVolumeAttachmentList(attachments []storage.VolumeAttachment, nonCriticalErrors []error,
+	dsQuery *dataselect.DataSelectQuery) *VolumeAttachmentList {
+	attachmentList := &VolumeAttachmentList{
+		Items:    make([]VolumeAttachment, 0),
+		ListMeta: types.ListMeta{TotalItems: len(attachments)},
+		Errors:   nonCriticalErrors,
+	}
+
+	attachmentCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(attachments), dsQuery)
+	attachments = fromCells(attachmentCells)
+	attachmentList.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+
+	for _, attachment := range attachments {
+		attachmentList.Items = append(attachmentList.Items, toVolumeAttachment(&attachment))
+	}
+
+	return attachmentList
+}
+
+func toVolumeAttachment(attachment *storage.VolumeAttachment) VolumeAttachment {
+	result := VolumeAttachment{
+		ObjectMeta:  types.NewObjectMeta(attachment.ObjectMeta),
+		TypeMeta:    types.NewTypeMeta(types.ResourceKindVolumeAttachment),
+		Attacher:    attachment.Spec.Attacher,
+		NodeName:    attachment.Spec.NodeName,
+		Attached:    attachment.Status.Attached,
+		Status:      getStatus(attachment),
+		AttachError: attachment.Status.AttachError,
+		DetachError: attachment.Status.DetachError,
+	}
+
+	if attachment.Spec.Source.PersistentVolumeName != nil {
+		result.PersistentVolumeName = *attachment.Spec.Source.PersistentVolumeName
+	}
+
+	return result
+}
+
+// getStatus returns the status of the attachment. Errors are reported by the external attacher
+// and stay set while it retries, so they take precedence over the attached flag.
+func getStatus(attachment *storage.VolumeAttachment) string {
+	if attachment.DeletionTimestamp != nil {
+		if attachment.Status.DetachError != nil {
+			return StatusDetachFailed
+		}
+
+		return StatusDetaching
+	}
+
+	if attachment.Status.AttachError != nil {
+		return StatusAttachFailed
+	}
+
+	if attachment.Status.Attached {
+		return StatusAttached
+	}
+
+	return StatusAttaching
+}
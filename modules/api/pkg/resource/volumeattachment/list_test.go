@@ -0,0 +1,107 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package volumeattachment
+
+import (
+	"reflect"
+	"testing"
+
+	storage "k8s.io/api/storage/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/types"
+)
+
+func TestGetStatus(t *testing.T) {
+	deleted := metaV1.Now()
+	volumeError := &storage.VolumeError{Message: "rpc error: code = Internal desc = volume is in use"}
+
+	cases := []struct {
+		attachment *storage.VolumeAttachment
+		expected   string
+	}{
+		{&storage.VolumeAttachment{}, StatusAttaching},
+		{&storage.VolumeAttachment{Status: storage.VolumeAttachmentStatus{Attached: true}}, StatusAttached},
+		{&storage.VolumeAttachment{Status: storage.VolumeAttachmentStatus{AttachError: volumeError}}, StatusAttachFailed},
+		{
+			&storage.VolumeAttachment{
+				ObjectMeta: metaV1.ObjectMeta{DeletionTimestamp: &deleted},
+				Status:     storage.VolumeAttachmentStatus{Attached: true},
+			},
+			StatusDetaching,
+		},
+		{
+			&storage.VolumeAttachment{
+				ObjectMeta: metaV1.ObjectMeta{DeletionTimestamp: &deleted},
+				Status:     storage.VolumeAttachmentStatus{Attached: true, DetachError: volumeError},
+			},
+			StatusDetachFailed,
+		},
+	}
+
+	for _, c := range cases {
+		if actual := getStatus(c.attachment); actual != c.expected {
+			t.Errorf("getStatus(%#v) == %s, expected %s", c.attachment.Status, actual, c.expected)
+		}
+	}
+}
+
+func TestGetVolumeAttachmentList(t *testing.T) {
+	pv := "pvc-1234"
+	attachError := &storage.VolumeError{Message: "volume vol-1 is attached to another node"}
+	client := fake.NewSimpleClientset(
+		&storage.VolumeAttachment{
+			ObjectMeta: metaV1.ObjectMeta{Name: "csi-1"},
+			Spec: storage.VolumeAttachmentSpec{
+				Attacher: "ebs.csi.aws.com",
+				NodeName: "node-1",
+				Source:   storage.VolumeAttachmentSource{PersistentVolumeName: &pv},
+			},
+			Status: storage.VolumeAttachmentStatus{AttachError: attachError},
+		},
+		&storage.VolumeAttachment{
+			ObjectMeta: metaV1.ObjectMeta{Name: "csi-2"},
+			Spec:       storage.VolumeAttachmentSpec{Attacher: "ebs.csi.aws.com", NodeName: "node-2"},
+			Status:     storage.VolumeAttachmentStatus{Attached: true},
+		},
+	)
+
+	dsQuery := dataselect.NewDataSelectQuery(dataselect.NoPagination, dataselect.NoSort,
+		dataselect.NewFilterQuery([]string{dataselect.StatusProperty, StatusAttachFailed}), dataselect.NoMetrics)
+	actual, err := GetVolumeAttachmentList(client, dsQuery)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := &VolumeAttachmentList{
+		ListMeta: types.ListMeta{TotalItems: 1},
+		Items: []VolumeAttachment{{
+			ObjectMeta:           types.ObjectMeta{Name: "csi-1"},
+			TypeMeta:             types.TypeMeta{Kind: types.ResourceKindVolumeAttachment},
+			Attacher:             "ebs.csi.aws.com",
+			NodeName:             "node-1",
+			PersistentVolumeName: pv,
+			Status:               StatusAttachFailed,
+			AttachError:          attachError,
+		}},
+		Errors: []error{},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("GetVolumeAttachmentList() == got\n%#v, expected\n %#v", actual, expected)
+	}
+}
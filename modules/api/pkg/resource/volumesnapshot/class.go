@@ -0,0 +1,123 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package volumesnapshot
+
+import (
+	"context"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// VolumeSnapshotClassList contains volume snapshot classes in the cluster.
+type VolumeSnapshotClassList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Installed is false when the volume snapshot CRDs are not installed. The list is empty then.
+	Installed bool `json:"installed"`
+
+	Items []VolumeSnapshotClass `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// VolumeSnapshotClass is a presentation layer view of snapshot.storage.k8s.io/v1 VolumeSnapshotClass.
+type VolumeSnapshotClass struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+
+	Driver         string            `json:"driver"`
+	DeletionPolicy string            `json:"deletionPolicy"`
+	Parameters     map[string]string `json:"parameters,omitempty"`
+
+	// Default is set for the class used by snapshots of the driver not naming a class.
+	Default bool `json:"default"`
+}
+
+// GetVolumeSnapshotClassList returns all volume snapshot classes.
+func GetVolumeSnapshotClassList(client kubernetes.Interface, dynamicClient dynamic.Interface,
+	dsQuery *dataselect.DataSelectQuery) (*VolumeSnapshotClassList, error) {
+	klog.V(4).Info("Getting list of volume snapshot classes")
+	result := &VolumeSnapshotClassList{Items: make([]VolumeSnapshotClass, 0), Errors: make([]error, 0)}
+
+	installed, err := common.CheckInstalled(client, classResource)
+	if err != nil || !installed {
+		return result, err
+	}
+
+	result.Installed = true
+	list, err := dynamicClient.Resource(classResource).List(context.TODO(), metaV1.ListOptions{})
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	result.Errors = nonCriticalErrors
+	classes := make([]VolumeSnapshotClass, 0)
+	if list != nil {
+		for i := range list.Items {
+			class := new(volumeSnapshotClass)
+			if err := common.FromUnstructured(&list.Items[i], class); err != nil {
+				return nil, err
+			}
+
+			classes = append(classes, toVolumeSnapshotClass(class))
+		}
+	}
+
+	cells, filteredTotal := dataselect.GenericDataSelectWithFilter(toClassCells(classes), dsQuery)
+	result.Items = fromClassCells(cells)
+	result.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+	return result, nil
+}
+
+// GetVolumeSnapshotClassDetail returns the volume snapshot class.
+func GetVolumeSnapshotClassDetail(client kubernetes.Interface, dynamicClient dynamic.Interface, name string) (*VolumeSnapshotClass, error) {
+	if err := common.RequireInstalled(client, classResource); err != nil {
+		return nil, err
+	}
+
+	obj, err := dynamicClient.Resource(classResource).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	class := new(volumeSnapshotClass)
+	if err := common.FromUnstructured(obj, class); err != nil {
+		return nil, err
+	}
+
+	result := toVolumeSnapshotClass(class)
+	return &result, nil
+}
+
+func toVolumeSnapshotClass(class *volumeSnapshotClass) VolumeSnapshotClass {
+	return VolumeSnapshotClass{
+		ObjectMeta:     types.NewObjectMeta(class.ObjectMeta),
+		TypeMeta:       types.NewTypeMeta(types.ResourceKindVolumeSnapshotClass),
+		Driver:         class.Driver,
+		DeletionPolicy: class.DeletionPolicy,
+		Parameters:     class.Parameters,
+		Default:        class.Annotations[defaultClassAnnotation] == "true",
+	}
+}
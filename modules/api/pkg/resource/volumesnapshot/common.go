@@ -0,0 +1,217 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package volumesnapshot
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+)
+
+const (
+	// Group of the volume snapshot CRDs installed together with the CSI external snapshotter.
+	Group = "snapshot.storage.k8s.io"
+
+	// defaultClassAnnotation marks the volume snapshot class used when a snapshot does not name one.
+	defaultClassAnnotation = "snapshot.storage.kubernetes.io/is-default-class"
+)
+
+var (
+	groupVersion = schema.GroupVersion{Group: Group, Version: "v1"}
+
+	snapshotResource = groupVersion.WithResource("volumesnapshots")
+	classResource    = groupVersion.WithResource("volumesnapshotclasses")
+	contentResource  = groupVersion.WithResource("volumesnapshotcontents")
+)
+
+// volumeSnapshot mirrors the parts of snapshot.storage.k8s.io/v1 VolumeSnapshot used by
+// Dashboard.
+type volumeSnapshot struct {
+	metaV1.TypeMeta   `json:",inline"`
+	metaV1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   volumeSnapshotSpec    `json:"spec"`
+	Status *volumeSnapshotStatus `json:"status,omitempty"`
+}
+
+type volumeSnapshotSpec struct {
+	Source                  volumeSnapshotSource `json:"source"`
+	VolumeSnapshotClassName *string              `json:"volumeSnapshotClassName,omitempty"`
+}
+
+type volumeSnapshotSource struct {
+	PersistentVolumeClaimName *string `json:"persistentVolumeClaimName,omitempty"`
+	VolumeSnapshotContentName *string `json:"volumeSnapshotContentName,omitempty"`
+}
+
+type volumeSnapshotStatus struct {
+	BoundVolumeSnapshotContentName *string              `json:"boundVolumeSnapshotContentName,omitempty"`
+	CreationTime                   *metaV1.Time         `json:"creationTime,omitempty"`
+	ReadyToUse                     *bool                `json:"readyToUse,omitempty"`
+	RestoreSize                    *resource.Quantity   `json:"restoreSize,omitempty"`
+	Error                          *volumeSnapshotError `json:"error,omitempty"`
+}
+
+type volumeSnapshotError struct {
+	Time    *metaV1.Time `json:"time,omitempty"`
+	Message *string      `json:"message,omitempty"`
+}
+
+type volumeSnapshotClass struct {
+	metaV1.TypeMeta   `json:",inline"`
+	metaV1.ObjectMeta `json:"metadata,omitempty"`
+
+	Driver         string            `json:"driver"`
+	DeletionPolicy string            `json:"deletionPolicy"`
+	Parameters     map[string]string `json:"parameters,omitempty"`
+}
+
+type volumeSnapshotContent struct {
+	metaV1.TypeMeta   `json:",inline"`
+	metaV1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   volumeSnapshotContentSpec    `json:"spec"`
+	Status *volumeSnapshotContentStatus `json:"status,omitempty"`
+}
+
+type volumeSnapshotContentSpec struct {
+	VolumeSnapshotRef       objectReference             `json:"volumeSnapshotRef"`
+	DeletionPolicy          string                      `json:"deletionPolicy"`
+	Driver                  string                      `json:"driver"`
+	VolumeSnapshotClassName *string                     `json:"volumeSnapshotClassName,omitempty"`
+	Source                  volumeSnapshotContentSource `json:"source"`
+}
+
+type objectReference struct {
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
+
+type volumeSnapshotContentSource struct {
+	VolumeHandle   *string `json:"volumeHandle,omitempty"`
+	SnapshotHandle *string `json:"snapshotHandle,omitempty"`
+}
+
+type volumeSnapshotContentStatus struct {
+	SnapshotHandle *string              `json:"snapshotHandle,omitempty"`
+	CreationTime   *int64               `json:"creationTime,omitempty"`
+	RestoreSize    *int64               `json:"restoreSize,omitempty"`
+	ReadyToUse     *bool                `json:"readyToUse,omitempty"`
+	Error          *volumeSnapshotError `json:"error,omitempty"`
+}
+
+func errorMessage(err *volumeSnapshotError) string {
+	if err == nil || err.Message == nil {
+		return ""
+	}
+
+	return *err.Message
+}
+
+// The code below allows to perform complex data section on volume snapshot resources.
+
+type VolumeSnapshotCell VolumeSnapshot
+
+func (in VolumeSnapshotCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(in.ObjectMeta.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(in.ObjectMeta.CreationTimestamp.Time)
+	case dataselect.NamespaceProperty:
+		return dataselect.StdComparableString(in.ObjectMeta.Namespace)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+func toSnapshotCells(std []VolumeSnapshot) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = VolumeSnapshotCell(std[i])
+	}
+	return cells
+}
+
+func fromSnapshotCells(cells []dataselect.DataCell) []VolumeSnapshot {
+	std := make([]VolumeSnapshot, len(cells))
+	for i := range std {
+		std[i] = VolumeSnapshot(cells[i].(VolumeSnapshotCell))
+	}
+	return std
+}
+
+type VolumeSnapshotClassCell VolumeSnapshotClass
+
+func (in VolumeSnapshotClassCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(in.ObjectMeta.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(in.ObjectMeta.CreationTimestamp.Time)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+func toClassCells(std []VolumeSnapshotClass) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = VolumeSnapshotClassCell(std[i])
+	}
+	return cells
+}
+
+func fromClassCells(cells []dataselect.DataCell) []VolumeSnapshotClass {
+	std := make([]VolumeSnapshotClass, len(cells))
+	for i := range std {
+		std[i] = VolumeSnapshotClass(cells[i].(VolumeSnapshotClassCell))
+	}
+	return std
+}
+
+type VolumeSnapshotContentCell VolumeSnapshotContent
+
+func (in VolumeSnapshotContentCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(in.ObjectMeta.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(in.ObjectMeta.CreationTimestamp.Time)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+func toContentCells(std []VolumeSnapshotContent) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = VolumeSnapshotContentCell(std[i])
+	}
+	return cells
+}
+
+func fromContentCells(cells []dataselect.DataCell) []VolumeSnapshotContent {
+	std := make([]VolumeSnapshotContent, len(cells))
+	for i := range std {
+		std[i] = VolumeSnapshotContent(cells[i].(VolumeSnapshotContentCell))
+	}
+	return std
+}
@@ -0,0 +1,170 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package volumesnapshot
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// VolumeSnapshotContentList contains volume snapshot contents in the cluster.
+type VolumeSnapshotContentList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Installed is false when the volume snapshot CRDs are not installed. The list is empty then.
+	Installed bool `json:"installed"`
+
+	Items []VolumeSnapshotContent `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// VolumeSnapshotContent is a presentation layer view of snapshot.storage.k8s.io/v1
+// VolumeSnapshotContent, the snapshot taken by the storage backend.
+type VolumeSnapshotContent struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+
+	// VolumeSnapshotNamespace and VolumeSnapshot identify the bound volume snapshot.
+	VolumeSnapshotNamespace string `json:"volumeSnapshotNamespace"`
+	VolumeSnapshot          string `json:"volumeSnapshot"`
+
+	VolumeSnapshotClass string `json:"volumeSnapshotClass,omitempty"`
+	Driver              string `json:"driver"`
+	DeletionPolicy      string `json:"deletionPolicy"`
+
+	// VolumeHandle is set for dynamically created snapshots, SnapshotHandle once the storage
+	// backend created the snapshot.
+	VolumeHandle   string `json:"volumeHandle,omitempty"`
+	SnapshotHandle string `json:"snapshotHandle,omitempty"`
+
+	ReadyToUse   bool               `json:"readyToUse"`
+	RestoreSize  *resource.Quantity `json:"restoreSize,omitempty"`
+	CreationTime *metaV1.Time       `json:"creationTime,omitempty"`
+	Error        string             `json:"error,omitempty"`
+}
+
+// GetVolumeSnapshotContentList returns all volume snapshot contents.
+func GetVolumeSnapshotContentList(client kubernetes.Interface, dynamicClient dynamic.Interface,
+	dsQuery *dataselect.DataSelectQuery) (*VolumeSnapshotContentList, error) {
+	klog.V(4).Info("Getting list of volume snapshot contents")
+	result := &VolumeSnapshotContentList{Items: make([]VolumeSnapshotContent, 0), Errors: make([]error, 0)}
+
+	installed, err := common.CheckInstalled(client, contentResource)
+	if err != nil || !installed {
+		return result, err
+	}
+
+	result.Installed = true
+	list, err := dynamicClient.Resource(contentResource).List(context.TODO(), metaV1.ListOptions{})
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	result.Errors = nonCriticalErrors
+	contents := make([]VolumeSnapshotContent, 0)
+	if list != nil {
+		for i := range list.Items {
+			content := new(volumeSnapshotContent)
+			if err := common.FromUnstructured(&list.Items[i], content); err != nil {
+				return nil, err
+			}
+
+			contents = append(contents, toVolumeSnapshotContent(content))
+		}
+	}
+
+	cells, filteredTotal := dataselect.GenericDataSelectWithFilter(toContentCells(contents), dsQuery)
+	result.Items = fromContentCells(cells)
+	result.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+	return result, nil
+}
+
+// GetVolumeSnapshotContentDetail returns the volume snapshot content.
+func GetVolumeSnapshotContentDetail(client kubernetes.Interface, dynamicClient dynamic.Interface, name string) (*VolumeSnapshotContent, error) {
+	if err := common.RequireInstalled(client, contentResource); err != nil {
+		return nil, err
+	}
+
+	obj, err := dynamicClient.Resource(contentResource).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	content := new(volumeSnapshotContent)
+	if err := common.FromUnstructured(obj, content); err != nil {
+		return nil, err
+	}
+
+	result := toVolumeSnapshotContent(content)
+	return &result, nil
+}
+
+func toVolumeSnapshotContent(content *volumeSnapshotContent) VolumeSnapshotContent {
+	result := VolumeSnapshotContent{
+		ObjectMeta:              types.NewObjectMeta(content.ObjectMeta),
+		TypeMeta:                types.NewTypeMeta(types.ResourceKindVolumeSnapshotContent),
+		VolumeSnapshotNamespace: content.Spec.VolumeSnapshotRef.Namespace,
+		VolumeSnapshot:          content.Spec.VolumeSnapshotRef.Name,
+		Driver:                  content.Spec.Driver,
+		DeletionPolicy:          content.Spec.DeletionPolicy,
+	}
+
+	if content.Spec.VolumeSnapshotClassName != nil {
+		result.VolumeSnapshotClass = *content.Spec.VolumeSnapshotClassName
+	}
+
+	if content.Spec.Source.VolumeHandle != nil {
+		result.VolumeHandle = *content.Spec.Source.VolumeHandle
+	}
+
+	if content.Spec.Source.SnapshotHandle != nil {
+		result.SnapshotHandle = *content.Spec.Source.SnapshotHandle
+	}
+
+	if status := content.Status; status != nil {
+		if status.SnapshotHandle != nil {
+			result.SnapshotHandle = *status.SnapshotHandle
+		}
+
+		result.ReadyToUse = status.ReadyToUse != nil && *status.ReadyToUse
+		if status.RestoreSize != nil {
+			result.RestoreSize = resource.NewQuantity(*status.RestoreSize, resource.BinarySI)
+		}
+
+		// Creation time of the content is reported in nanoseconds since the epoch.
+		if status.CreationTime != nil {
+			creationTime := metaV1.NewTime(time.Unix(0, *status.CreationTime))
+			result.CreationTime = &creationTime
+		}
+
+		result.Error = errorMessage(status.Error)
+	}
+
+	return result
+}
@@ -0,0 +1,126 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package volumesnapshot
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/persistentvolumeclaim"
+	"k8s.io/dashboard/errors"
+)
+
+// RestoreSpec is a specification of a persistent volume claim restored from a volume snapshot.
+type RestoreSpec struct {
+	// Name of the new persistent volume claim.
+	Name string `json:"name"`
+
+	// StorageClassName, AccessModes and Storage default to values of the snapshotted claim.
+	// Storage cannot be smaller than the restore size of the snapshot.
+	StorageClassName *string                         `json:"storageClassName,omitempty"`
+	AccessModes      []v1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+	Storage          *resource.Quantity              `json:"storage,omitempty"`
+}
+
+// RestoreVolumeSnapshot creates a new persistent volume claim in the namespace of the snapshot
+// with the snapshot as its data source.
+func RestoreVolumeSnapshot(client kubernetes.Interface, dynamicClient dynamic.Interface, namespace, name string,
+	spec *RestoreSpec) (*persistentvolumeclaim.PersistentVolumeClaimDetail, error) {
+	if len(spec.Name) == 0 {
+		return nil, errors.NewBadRequest("name is required")
+	}
+
+	snapshot, err := getVolumeSnapshot(client, dynamicClient, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot.Status == nil || snapshot.Status.ReadyToUse == nil || !*snapshot.Status.ReadyToUse {
+		return nil, errors.NewBadRequest(fmt.Sprintf("volume snapshot %s is not ready to use", name))
+	}
+
+	pvc, err := newRestoredClaim(client, snapshot, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(4).Infof("Restoring %s volume snapshot into %s persistent volume claim", name, spec.Name)
+	pvc, err = client.CoreV1().PersistentVolumeClaims(namespace).Create(context.TODO(), pvc, metaV1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return persistentvolumeclaim.GetPersistentVolumeClaimDetail(client, namespace, pvc.Name)
+}
+
+func newRestoredClaim(client kubernetes.Interface, snapshot *volumeSnapshot, spec *RestoreSpec) (*v1.PersistentVolumeClaim, error) {
+	apiGroup := Group
+	pvc := &v1.PersistentVolumeClaim{
+		ObjectMeta: metaV1.ObjectMeta{Namespace: snapshot.Namespace, Name: spec.Name},
+		Spec: v1.PersistentVolumeClaimSpec{
+			StorageClassName: spec.StorageClassName,
+			AccessModes:      spec.AccessModes,
+			DataSource:       &v1.TypedLocalObjectReference{APIGroup: &apiGroup, Kind: "VolumeSnapshot", Name: snapshot.Name},
+		},
+	}
+
+	// The source claim may have been deleted since the snapshot was taken.
+	if source := snapshot.Spec.Source.PersistentVolumeClaimName; source != nil {
+		sourceClaim, err := client.CoreV1().PersistentVolumeClaims(snapshot.Namespace).Get(context.TODO(), *source, metaV1.GetOptions{})
+		switch {
+		case err == nil:
+			if pvc.Spec.StorageClassName == nil {
+				pvc.Spec.StorageClassName = sourceClaim.Spec.StorageClassName
+			}
+
+			if len(pvc.Spec.AccessModes) == 0 {
+				pvc.Spec.AccessModes = sourceClaim.Spec.AccessModes
+			}
+
+			pvc.Spec.VolumeMode = sourceClaim.Spec.VolumeMode
+		case !k8serrors.IsNotFound(err):
+			return nil, err
+		}
+	}
+
+	if len(pvc.Spec.AccessModes) == 0 {
+		pvc.Spec.AccessModes = []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}
+	}
+
+	restoreSize := snapshot.Status.RestoreSize
+	storage := spec.Storage
+	if storage == nil {
+		storage = restoreSize
+	}
+
+	if storage == nil {
+		return nil, errors.NewBadRequest("storage is required, the snapshot does not report its restore size")
+	}
+
+	if restoreSize != nil && storage.Cmp(*restoreSize) < 0 {
+		return nil, errors.NewBadRequest(fmt.Sprintf("storage must not be smaller than the restore size %s", restoreSize.String()))
+	}
+
+	pvc.Spec.Resources.Requests = v1.ResourceList{v1.ResourceStorage: *storage}
+	return pvc, nil
+}
@@ -0,0 +1,226 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package volumesnapshot
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// VolumeSnapshotList contains volume snapshots of a namespace or of a single persistent volume claim.
+type VolumeSnapshotList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Installed is false when the volume snapshot CRDs are not installed. The list is empty then.
+	Installed bool `json:"installed"`
+
+	Items []VolumeSnapshot `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// VolumeSnapshot is a presentation layer view of snapshot.storage.k8s.io/v1 VolumeSnapshot.
+type VolumeSnapshot struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+
+	// PersistentVolumeClaim is the source of a dynamically provisioned snapshot.
+	PersistentVolumeClaim string `json:"persistentVolumeClaim,omitempty"`
+
+	VolumeSnapshotClass   string `json:"volumeSnapshotClass,omitempty"`
+	VolumeSnapshotContent string `json:"volumeSnapshotContent,omitempty"`
+
+	// ReadyToUse is set once the snapshot can be restored.
+	ReadyToUse   bool               `json:"readyToUse"`
+	RestoreSize  *resource.Quantity `json:"restoreSize,omitempty"`
+	CreationTime *metaV1.Time       `json:"creationTime,omitempty"`
+
+	// Error is the last error reported by the snapshot controller.
+	Error string `json:"error,omitempty"`
+}
+
+// VolumeSnapshotSpec is a specification of a volume snapshot of a persistent volume claim.
+type VolumeSnapshotSpec struct {
+	Name string `json:"name"`
+
+	// VolumeSnapshotClassName defaults to the default volume snapshot class of the driver.
+	VolumeSnapshotClassName *string `json:"volumeSnapshotClassName,omitempty"`
+}
+
+// GetVolumeSnapshotList returns volume snapshots in the namespace. Only snapshots of the given
+// persistent volume claim are returned when the claim is not empty.
+func GetVolumeSnapshotList(client kubernetes.Interface, dynamicClient dynamic.Interface, namespace, claim string,
+	dsQuery *dataselect.DataSelectQuery) (*VolumeSnapshotList, error) {
+	klog.V(4).Infof("Getting list of volume snapshots in %s namespace", namespace)
+	result := &VolumeSnapshotList{Items: make([]VolumeSnapshot, 0), Errors: make([]error, 0)}
+
+	installed, err := common.CheckInstalled(client, snapshotResource)
+	if err != nil || !installed {
+		return result, err
+	}
+
+	result.Installed = true
+	list, err := dynamicClient.Resource(snapshotResource).Namespace(namespace).List(context.TODO(), metaV1.ListOptions{})
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	result.Errors = nonCriticalErrors
+	snapshots := make([]VolumeSnapshot, 0)
+	if list != nil {
+		for i := range list.Items {
+			snapshot := new(volumeSnapshot)
+			if err := common.FromUnstructured(&list.Items[i], snapshot); err != nil {
+				return nil, err
+			}
+
+			if len(claim) == 0 || (snapshot.Spec.Source.PersistentVolumeClaimName != nil &&
+				*snapshot.Spec.Source.PersistentVolumeClaimName == claim) {
+				snapshots = append(snapshots, toVolumeSnapshot(snapshot))
+			}
+		}
+	}
+
+	cells, filteredTotal := dataselect.GenericDataSelectWithFilter(toSnapshotCells(snapshots), dsQuery)
+	result.Items = fromSnapshotCells(cells)
+	result.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+	return result, nil
+}
+
+// GetVolumeSnapshotDetail returns the volume snapshot.
+func GetVolumeSnapshotDetail(client kubernetes.Interface, dynamicClient dynamic.Interface, namespace, name string) (*VolumeSnapshot, error) {
+	snapshot, err := getVolumeSnapshot(client, dynamicClient, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	result := toVolumeSnapshot(snapshot)
+	return &result, nil
+}
+
+// CreateVolumeSnapshot takes a snapshot of the bound persistent volume claim.
+func CreateVolumeSnapshot(client kubernetes.Interface, dynamicClient dynamic.Interface, namespace, claim string,
+	spec *VolumeSnapshotSpec) (*VolumeSnapshot, error) {
+	if err := common.RequireInstalled(client, snapshotResource); err != nil {
+		return nil, err
+	}
+
+	if len(spec.Name) == 0 {
+		return nil, errors.NewBadRequest("name is required")
+	}
+
+	pvc, err := client.CoreV1().PersistentVolumeClaims(namespace).Get(context.TODO(), claim, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if pvc.Status.Phase != v1.ClaimBound {
+		return nil, errors.NewBadRequest(fmt.Sprintf("persistent volume claim %s is not bound", claim))
+	}
+
+	if spec.VolumeSnapshotClassName != nil {
+		if _, err := dynamicClient.Resource(classResource).Get(context.TODO(), *spec.VolumeSnapshotClassName, metaV1.GetOptions{}); err != nil {
+			return nil, err
+		}
+	}
+
+	snapshot := &volumeSnapshot{
+		TypeMeta:   metaV1.TypeMeta{APIVersion: groupVersion.String(), Kind: "VolumeSnapshot"},
+		ObjectMeta: metaV1.ObjectMeta{Namespace: namespace, Name: spec.Name},
+		Spec: volumeSnapshotSpec{
+			Source:                  volumeSnapshotSource{PersistentVolumeClaimName: &claim},
+			VolumeSnapshotClassName: spec.VolumeSnapshotClassName,
+		},
+	}
+
+	obj, err := common.ToUnstructured(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(4).Infof("Creating %s volume snapshot of %s persistent volume claim", spec.Name, claim)
+	obj, err = dynamicClient.Resource(snapshotResource).Namespace(namespace).Create(context.TODO(), obj, metaV1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	created := new(volumeSnapshot)
+	if err := common.FromUnstructured(obj, created); err != nil {
+		return nil, err
+	}
+
+	result := toVolumeSnapshot(created)
+	return &result, nil
+}
+
+func getVolumeSnapshot(client kubernetes.Interface, dynamicClient dynamic.Interface, namespace, name string) (*volumeSnapshot, error) {
+	if err := common.RequireInstalled(client, snapshotResource); err != nil {
+		return nil, err
+	}
+
+	obj, err := dynamicClient.Resource(snapshotResource).Namespace(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := new(volumeSnapshot)
+	if err := common.FromUnstructured(obj, snapshot); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+func toVolumeSnapshot(snapshot *volumeSnapshot) VolumeSnapshot {
+	result := VolumeSnapshot{
+		ObjectMeta: types.NewObjectMeta(snapshot.ObjectMeta),
+		TypeMeta:   types.NewTypeMeta(types.ResourceKindVolumeSnapshot),
+	}
+
+	if snapshot.Spec.Source.PersistentVolumeClaimName != nil {
+		result.PersistentVolumeClaim = *snapshot.Spec.Source.PersistentVolumeClaimName
+	}
+
+	if snapshot.Spec.VolumeSnapshotClassName != nil {
+		result.VolumeSnapshotClass = *snapshot.Spec.VolumeSnapshotClassName
+	}
+
+	if status := snapshot.Status; status != nil {
+		if status.BoundVolumeSnapshotContentName != nil {
+			result.VolumeSnapshotContent = *status.BoundVolumeSnapshotContentName
+		}
+
+		result.ReadyToUse = status.ReadyToUse != nil && *status.ReadyToUse
+		result.RestoreSize = status.RestoreSize
+		result.CreationTime = status.CreationTime
+		result.Error = errorMessage(status.Error)
+	}
+
+	return result
+}
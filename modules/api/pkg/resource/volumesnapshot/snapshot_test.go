@@ -0,0 +1,213 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package volumesnapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/api/pkg/resource/dataselect"
+)
+
+func newTestClients(t *testing.T, installed bool, objects ...interface{}) (*fake.Clientset, *dynamicfake.FakeDynamicClient) {
+	client := fake.NewSimpleClientset(
+		&v1.PersistentVolumeClaim{
+			ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "data"},
+			Spec: v1.PersistentVolumeClaimSpec{
+				StorageClassName: lo.ToPtr("fast"),
+				AccessModes:      []v1.PersistentVolumeAccessMode{v1.ReadWriteMany},
+			},
+			Status: v1.PersistentVolumeClaimStatus{Phase: v1.ClaimBound},
+		},
+		&v1.PersistentVolumeClaim{
+			ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "pending"},
+			Status:     v1.PersistentVolumeClaimStatus{Phase: v1.ClaimPending},
+		},
+	)
+
+	if installed {
+		client.Resources = []*metaV1.APIResourceList{{
+			GroupVersion: groupVersion.String(),
+			APIResources: []metaV1.APIResource{
+				{Name: "volumesnapshots", Kind: "VolumeSnapshot", Namespaced: true},
+				{Name: "volumesnapshotclasses", Kind: "VolumeSnapshotClass"},
+				{Name: "volumesnapshotcontents", Kind: "VolumeSnapshotContent"},
+			},
+		}}
+	}
+
+	unstructuredObjects := make([]runtime.Object, 0, len(objects))
+	for _, obj := range objects {
+		u, err := common.ToUnstructured(obj)
+		if err != nil {
+			t.Fatalf("ToUnstructured() returned error: %v", err)
+		}
+
+		unstructuredObjects = append(unstructuredObjects, u)
+	}
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		snapshotResource: "VolumeSnapshotList",
+		classResource:    "VolumeSnapshotClassList",
+		contentResource:  "VolumeSnapshotContentList",
+	}, unstructuredObjects...)
+
+	return client, dynamicClient
+}
+
+func newTestSnapshot(name, claim string, ready bool) *volumeSnapshot {
+	restoreSize := resource.MustParse("10Gi")
+	return &volumeSnapshot{
+		TypeMeta:   metaV1.TypeMeta{APIVersion: groupVersion.String(), Kind: "VolumeSnapshot"},
+		ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: name},
+		Spec:       volumeSnapshotSpec{Source: volumeSnapshotSource{PersistentVolumeClaimName: lo.ToPtr(claim)}},
+		Status: &volumeSnapshotStatus{
+			BoundVolumeSnapshotContentName: lo.ToPtr("content-" + name),
+			ReadyToUse:                     lo.ToPtr(ready),
+			RestoreSize:                    &restoreSize,
+		},
+	}
+}
+
+func TestGetVolumeSnapshotList(t *testing.T) {
+	client, dynamicClient := newTestClients(t, true,
+		newTestSnapshot("data-1", "data", true),
+		newTestSnapshot("data-2", "data", false),
+		newTestSnapshot("logs-1", "logs", true),
+	)
+
+	list, err := GetVolumeSnapshotList(client, dynamicClient, "default", "data", dataselect.NoDataSelect)
+	if err != nil {
+		t.Fatalf("GetVolumeSnapshotList() returned error: %v", err)
+	}
+
+	if !list.Installed || list.ListMeta.TotalItems != 2 {
+		t.Errorf("expected two snapshots of data claim, got %+v", list)
+	}
+
+	for _, snapshot := range list.Items {
+		if snapshot.PersistentVolumeClaim != "data" || snapshot.VolumeSnapshotContent != "content-"+snapshot.ObjectMeta.Name {
+			t.Errorf("unexpected snapshot %+v", snapshot)
+		}
+	}
+
+	client, dynamicClient = newTestClients(t, false)
+	list, err = GetVolumeSnapshotList(client, dynamicClient, "default", "", dataselect.NoDataSelect)
+	if err != nil || list.Installed || len(list.Items) != 0 {
+		t.Errorf("GetVolumeSnapshotList() = %+v, %v, expected empty list without CRDs", list, err)
+	}
+
+	if _, err := CreateVolumeSnapshot(client, dynamicClient, "default", "data", &VolumeSnapshotSpec{Name: "data-1"}); err == nil {
+		t.Error("CreateVolumeSnapshot() should fail without CRDs")
+	}
+}
+
+func TestCreateVolumeSnapshot(t *testing.T) {
+	client, dynamicClient := newTestClients(t, true)
+
+	snapshot, err := CreateVolumeSnapshot(client, dynamicClient, "default", "data", &VolumeSnapshotSpec{Name: "data-1"})
+	if err != nil {
+		t.Fatalf("CreateVolumeSnapshot() returned error: %v", err)
+	}
+
+	if snapshot.ObjectMeta.Name != "data-1" || snapshot.PersistentVolumeClaim != "data" {
+		t.Errorf("unexpected created snapshot %+v", snapshot)
+	}
+
+	for _, c := range []struct {
+		claim string
+		spec  VolumeSnapshotSpec
+	}{
+		{"data", VolumeSnapshotSpec{}},
+		{"pending", VolumeSnapshotSpec{Name: "pending-1"}},
+		{"data", VolumeSnapshotSpec{Name: "data-2", VolumeSnapshotClassName: lo.ToPtr("missing")}},
+	} {
+		if _, err := CreateVolumeSnapshot(client, dynamicClient, "default", c.claim, &c.spec); err == nil {
+			t.Errorf("CreateVolumeSnapshot(%s, %+v) should fail", c.claim, c.spec)
+		}
+	}
+}
+
+func TestRestoreVolumeSnapshot(t *testing.T) {
+	client, dynamicClient := newTestClients(t, true,
+		newTestSnapshot("data-1", "data", true),
+		newTestSnapshot("data-2", "data", false),
+	)
+
+	detail, err := RestoreVolumeSnapshot(client, dynamicClient, "default", "data-1", &RestoreSpec{Name: "restored"})
+	if err != nil {
+		t.Fatalf("RestoreVolumeSnapshot() returned error: %v", err)
+	}
+
+	pvc, _ := client.CoreV1().PersistentVolumeClaims("default").Get(context.TODO(), "restored", metaV1.GetOptions{})
+	storage := pvc.Spec.Resources.Requests[v1.ResourceStorage]
+	if detail.ObjectMeta.Name != "restored" || pvc.Spec.DataSource == nil || pvc.Spec.DataSource.Name != "data-1" ||
+		*pvc.Spec.StorageClassName != "fast" || pvc.Spec.AccessModes[0] != v1.ReadWriteMany || storage.String() != "10Gi" {
+		t.Errorf("unexpected restored claim %+v", pvc.Spec)
+	}
+
+	smaller := resource.MustParse("1Gi")
+	for _, c := range []struct {
+		snapshot string
+		spec     RestoreSpec
+	}{
+		{"data-1", RestoreSpec{}},
+		{"data-2", RestoreSpec{Name: "not-ready"}},
+		{"data-1", RestoreSpec{Name: "too-small", Storage: &smaller}},
+	} {
+		if _, err := RestoreVolumeSnapshot(client, dynamicClient, "default", c.snapshot, &c.spec); err == nil {
+			t.Errorf("RestoreVolumeSnapshot(%s, %+v) should fail", c.snapshot, c.spec)
+		}
+	}
+}
+
+func TestVolumeSnapshotClassesAndContents(t *testing.T) {
+	client, dynamicClient := newTestClients(t, true,
+		&volumeSnapshotClass{
+			TypeMeta:   metaV1.TypeMeta{APIVersion: groupVersion.String(), Kind: "VolumeSnapshotClass"},
+			ObjectMeta: metaV1.ObjectMeta{Name: "csi", Annotations: map[string]string{defaultClassAnnotation: "true"}},
+			Driver:     "csi.example.com",
+		},
+		&volumeSnapshotContent{
+			TypeMeta:   metaV1.TypeMeta{APIVersion: groupVersion.String(), Kind: "VolumeSnapshotContent"},
+			ObjectMeta: metaV1.ObjectMeta{Name: "content-data-1"},
+			Spec: volumeSnapshotContentSpec{
+				VolumeSnapshotRef: objectReference{Namespace: "default", Name: "data-1"},
+				Driver:            "csi.example.com",
+			},
+			Status: &volumeSnapshotContentStatus{RestoreSize: lo.ToPtr(int64(1024)), ReadyToUse: lo.ToPtr(true)},
+		},
+	)
+
+	classes, err := GetVolumeSnapshotClassList(client, dynamicClient, dataselect.NoDataSelect)
+	if err != nil || len(classes.Items) != 1 || !classes.Items[0].Default {
+		t.Errorf("GetVolumeSnapshotClassList() = %+v, %v, expected default csi class", classes, err)
+	}
+
+	content, err := GetVolumeSnapshotContentDetail(client, dynamicClient, "content-data-1")
+	if err != nil || content.VolumeSnapshot != "data-1" || !content.ReadyToUse || content.RestoreSize.Value() != 1024 {
+		t.Errorf("GetVolumeSnapshotContentDetail() = %+v, %v", content, err)
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/helpers"
+	"k8s.io/dashboard/types"
+)
+
+// maxConcurrentLists limits the number of custom resource lists fetched at the same time.
+const maxConcurrentLists = 10
+
+// getCustomResourceSource returns a source of objects of all custom resource definitions. Objects
+// are grouped by the name of their definition, the same as on custom resource list pages. Errors
+// of single definitions are always non-critical, as e.g. an unavailable conversion webhook must not
+// break the search.
+func getCustomResourceSource(apiextensionsClient apiextensionsclientset.Interface, dynamicClient dynamic.Interface,
+	nsQuery *common.NamespaceQuery) source {
+	channel := common.GetCustomResourceDefinitionChannelV1(apiextensionsClient, 1)
+
+	return func() ([]candidate, []error, error) {
+		list := <-channel.List
+		nonCriticalErrors, criticalError := errors.ExtractErrors(<-channel.Error)
+		if criticalError != nil || len(nonCriticalErrors) > 0 {
+			return nil, nonCriticalErrors, criticalError
+		}
+
+		var (
+			wg        sync.WaitGroup
+			mutex     sync.Mutex
+			semaphore = make(chan struct{}, maxConcurrentLists)
+			result    = make([]candidate, 0)
+		)
+
+		for i := range list.Items {
+			crd := &list.Items[i]
+			version := servedVersion(crd)
+			if len(version) == 0 || (crd.Spec.Scope == apiextensions.ClusterScoped && !nsQuery.Matches(v1.NamespaceAll)) {
+				continue
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				semaphore <- struct{}{}
+				defer func() { <-semaphore }()
+
+				candidates, err := listCustomResources(dynamicClient, crd, version, nsQuery)
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				if err != nil {
+					klog.V(3).InfoS("Could not list custom resources", "crd", crd.Name, "error", err)
+					nonCriticalErrors = errors.MergeErrors(nonCriticalErrors, []error{errors.LocalizeError(err)})
+					return
+				}
+
+				result = append(result, candidates...)
+			}()
+		}
+
+		wg.Wait()
+		return result, nonCriticalErrors, nil
+	}
+}
+
+func listCustomResources(client dynamic.Interface, crd *apiextensions.CustomResourceDefinition, version string,
+	nsQuery *common.NamespaceQuery) ([]candidate, error) {
+	gvr := schema.GroupVersionResource{Group: crd.Spec.Group, Version: version, Resource: crd.Spec.Names.Plural}
+
+	var resource dynamic.ResourceInterface = client.Resource(gvr)
+	if crd.Spec.Scope == apiextensions.NamespaceScoped {
+		resource = client.Resource(gvr).Namespace(nsQuery.ToRequestParam())
+	}
+
+	list, err := resource.List(context.TODO(), helpers.ListEverything)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]candidate, 0, len(list.Items))
+	for i := range list.Items {
+		result = append(result, candidate{kind: types.ResourceKind(crd.Name), object: &list.Items[i]})
+	}
+
+	return result, nil
+}
+
+// servedVersion returns the storage version when it is served, otherwise the first served version.
+func servedVersion(crd *apiextensions.CustomResourceDefinition) string {
+	result := ""
+	for _, version := range crd.Spec.Versions {
+		if !version.Served {
+			continue
+		}
+
+		if version.Storage {
+			return version.Name
+		}
+
+		if len(result) == 0 {
+			result = version.Name
+		}
+	}
+
+	return result
+}
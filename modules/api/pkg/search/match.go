@@ -0,0 +1,179 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// MatchField is the field of an object that matched the query.
+type MatchField string
+
+// List of fields matched against the query.
+const (
+	MatchFieldName       MatchField = "name"
+	MatchFieldLabel      MatchField = "label"
+	MatchFieldAnnotation MatchField = "annotation"
+	MatchFieldImage      MatchField = "image"
+)
+
+// Match is a single field matching the query.
+type Match struct {
+	Field MatchField `json:"field"`
+
+	// Key is the label or annotation key.
+	Key string `json:"key,omitempty"`
+
+	Value string `json:"value"`
+}
+
+// Scores of matches. The score of an object is the score of its best match.
+const (
+	scoreNameExact       = 100
+	scoreNamePrefix      = 80
+	scoreNameContains    = 60
+	scoreLabelExact      = 50
+	scoreImageExact      = 45
+	scoreLabelContains   = 30
+	scoreImageContains   = 25
+	scoreAnnotationMatch = 10
+)
+
+// ignoredAnnotations contain copies of the whole object and would match almost any query.
+var ignoredAnnotations = map[string]bool{
+	v1.LastAppliedConfigAnnotation: true,
+}
+
+// matcher matches objects against a case-insensitive query. Queries in the "key=value" form
+// match labels exactly.
+type matcher struct {
+	query string
+}
+
+func newMatcher(query string) *matcher {
+	return &matcher{query: strings.ToLower(query)}
+}
+
+func (in *matcher) match(c candidate) (Result, bool) {
+	score := 0
+	matches := make([]Match, 0)
+	add := func(matchScore int, match Match) {
+		score = max(score, matchScore)
+		matches = append(matches, match)
+	}
+
+	if matchScore := in.matchString(c.object.GetName(), scoreNameExact, scoreNamePrefix, scoreNameContains); matchScore > 0 {
+		add(matchScore, Match{Field: MatchFieldName, Value: c.object.GetName()})
+	}
+
+	labels := c.object.GetLabels()
+	for _, key := range sortedKeys(labels) {
+		if matchScore := in.matchLabel(key, labels[key]); matchScore > 0 {
+			add(matchScore, Match{Field: MatchFieldLabel, Key: key, Value: labels[key]})
+		}
+	}
+
+	annotations := c.object.GetAnnotations()
+	for _, key := range sortedKeys(annotations) {
+		if ignoredAnnotations[key] {
+			continue
+		}
+
+		if in.contains(key) || in.contains(annotations[key]) {
+			add(scoreAnnotationMatch, Match{Field: MatchFieldAnnotation, Key: key, Value: annotations[key]})
+		}
+	}
+
+	for _, image := range c.images {
+		matchScore := 0
+		if in.equals(imageName(image)) {
+			matchScore = scoreImageExact
+		} else if in.contains(image) {
+			matchScore = scoreImageContains
+		}
+
+		if matchScore > 0 {
+			add(matchScore, Match{Field: MatchFieldImage, Value: image})
+		}
+	}
+
+	if score == 0 {
+		return Result{}, false
+	}
+
+	return c.toResult(score, matches), true
+}
+
+func (in *matcher) matchLabel(key, value string) int {
+	if in.equals(key+"="+value) || in.equals(value) {
+		return scoreLabelExact
+	}
+
+	if in.contains(key) || in.contains(value) {
+		return scoreLabelContains
+	}
+
+	return 0
+}
+
+func (in *matcher) matchString(s string, exact, prefix, contains int) int {
+	s = strings.ToLower(s)
+	switch {
+	case s == in.query:
+		return exact
+	case strings.HasPrefix(s, in.query):
+		return prefix
+	case strings.Contains(s, in.query):
+		return contains
+	}
+
+	return 0
+}
+
+func (in *matcher) equals(s string) bool {
+	return strings.ToLower(s) == in.query
+}
+
+func (in *matcher) contains(s string) bool {
+	return strings.Contains(strings.ToLower(s), in.query)
+}
+
+// imageName returns the last path segment of the image without tag and digest, e.g. "payments"
+// for "registry.example.com/team/payments:1.0".
+func imageName(image string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+
+	image = image[strings.LastIndex(image, "/")+1:]
+	if i := strings.Index(image, ":"); i >= 0 {
+		image = image[:i]
+	}
+
+	return image
+}
+
+func sortedKeys(m map[string]string) []string {
+	result := make([]string, 0, len(m))
+	for key := range m {
+		result = append(result, key)
+	}
+
+	sort.Strings(result)
+	return result
+}
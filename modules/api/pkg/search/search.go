@@ -0,0 +1,188 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+const (
+	// DefaultLimit is the number of results returned per kind by default.
+	DefaultLimit = 10
+
+	// MaxLimit is the maximum number of results returned per kind.
+	MaxLimit = 100
+)
+
+// SearchResult contains objects matching the query grouped by kind. Groups are ordered by the
+// score of their best result.
+type SearchResult struct {
+	Query string `json:"query"`
+
+	// Total number of matching objects, including the ones over the per kind limit.
+	TotalItems int `json:"totalItems"`
+
+	Groups []ResultGroup `json:"groups"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// ResultGroup contains matching objects of a single kind ordered by relevance.
+type ResultGroup struct {
+	Kind types.ResourceKind `json:"kind"`
+
+	// Total number of matching objects of the kind, including the ones over the limit.
+	TotalItems int `json:"totalItems"`
+
+	Items []Result `json:"items"`
+}
+
+// Result is a single object matching the query.
+type Result struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+
+	// Score is the relevance of the result, higher is better.
+	Score int `json:"score"`
+
+	// Matches lists fields of the object matching the query.
+	Matches []Match `json:"matches"`
+}
+
+// Search returns objects from the namespaces matching the query by name, labels, annotations or
+// container images. Cluster scoped objects are searched only when no namespace is selected.
+// Kinds that cannot be listed, e.g. because of missing permissions, are reported as non-critical
+// errors.
+func Search(client kubernetes.Interface, apiextensionsClient apiextensionsclientset.Interface,
+	dynamicClient dynamic.Interface, nsQuery *common.NamespaceQuery, query string, limit int) (*SearchResult, error) {
+	query = strings.TrimSpace(query)
+	if len(query) == 0 {
+		return nil, errors.NewBadRequest("query must not be empty")
+	}
+
+	if limit < 1 || limit > MaxLimit {
+		return nil, errors.NewBadRequest(fmt.Sprintf("limit must be between 1 and %d", MaxLimit))
+	}
+
+	klog.V(4).InfoS("Searching resources", "query", query, "namespace", nsQuery.ToRequestParam())
+	m := newMatcher(query)
+	groups := make(map[types.ResourceKind][]Result)
+	nonCriticalErrors := make([]error, 0)
+	for _, source := range getSources(client, apiextensionsClient, dynamicClient, nsQuery) {
+		candidates, sourceErrors, criticalError := source()
+		if criticalError != nil {
+			return nil, criticalError
+		}
+
+		nonCriticalErrors = errors.MergeErrors(nonCriticalErrors, sourceErrors)
+		for _, c := range candidates {
+			if len(c.object.GetNamespace()) > 0 && !nsQuery.Matches(c.object.GetNamespace()) {
+				continue
+			}
+
+			if result, matches := m.match(c); matches {
+				groups[c.kind] = append(groups[c.kind], result)
+			}
+		}
+	}
+
+	return toSearchResult(query, groups, nonCriticalErrors, limit), nil
+}
+
+func toSearchResult(query string, groups map[types.ResourceKind][]Result, nonCriticalErrors []error, limit int) *SearchResult {
+	result := &SearchResult{
+		Query:  query,
+		Groups: make([]ResultGroup, 0, len(groups)),
+		Errors: nonCriticalErrors,
+	}
+
+	for kind, items := range groups {
+		sort.Slice(items, func(i, j int) bool { return moreRelevant(items[i], items[j]) })
+
+		group := ResultGroup{Kind: kind, TotalItems: len(items), Items: items}
+		if len(items) > limit {
+			group.Items = items[:limit]
+		}
+
+		result.TotalItems += group.TotalItems
+		result.Groups = append(result.Groups, group)
+	}
+
+	sort.Slice(result.Groups, func(i, j int) bool {
+		left, right := result.Groups[i], result.Groups[j]
+		if left.Items[0].Score != right.Items[0].Score {
+			return left.Items[0].Score > right.Items[0].Score
+		}
+
+		return left.Kind < right.Kind
+	})
+
+	return result
+}
+
+// moreRelevant orders results by score, then by the number of matching fields and finally by
+// namespace and name.
+func moreRelevant(left, right Result) bool {
+	if left.Score != right.Score {
+		return left.Score > right.Score
+	}
+
+	if len(left.Matches) != len(right.Matches) {
+		return len(left.Matches) > len(right.Matches)
+	}
+
+	if left.ObjectMeta.Namespace != right.ObjectMeta.Namespace {
+		return left.ObjectMeta.Namespace < right.ObjectMeta.Namespace
+	}
+
+	return left.ObjectMeta.Name < right.ObjectMeta.Name
+}
+
+// candidate is an object that is matched against the query.
+type candidate struct {
+	kind   types.ResourceKind
+	object metaV1.Object
+	images []string
+}
+
+func (in candidate) toResult(score int, matches []Match) Result {
+	return Result{
+		ObjectMeta: types.NewObjectMeta(metaV1.ObjectMeta{
+			Name:              in.object.GetName(),
+			Namespace:         in.object.GetNamespace(),
+			Labels:            in.object.GetLabels(),
+			Annotations:       in.object.GetAnnotations(),
+			CreationTimestamp: in.object.GetCreationTimestamp(),
+			UID:               in.object.GetUID(),
+			OwnerReferences:   in.object.GetOwnerReferences(),
+		}),
+		TypeMeta: types.NewTypeMeta(in.kind),
+		Score:    score,
+		Matches:  matches,
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/types"
+)
+
+var widgetResource = schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+
+func newDeployment(namespace, name string, labels map[string]string, image string) *apps.Deployment {
+	return &apps.Deployment{
+		ObjectMeta: metaV1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels},
+		Spec: apps.DeploymentSpec{
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{Containers: []v1.Container{{Name: "app", Image: image}}},
+			},
+		},
+	}
+}
+
+func newTestClients(objects ...runtime.Object) (*fake.Clientset, *apiextensionsfake.Clientset, *dynamicfake.FakeDynamicClient) {
+	crd := &apiextensions.CustomResourceDefinition{
+		ObjectMeta: metaV1.ObjectMeta{Name: "widgets.example.com"},
+		Spec: apiextensions.CustomResourceDefinitionSpec{
+			Group: "example.com",
+			Names: apiextensions.CustomResourceDefinitionNames{Plural: "widgets", Kind: "Widget"},
+			Scope: apiextensions.NamespaceScoped,
+			Versions: []apiextensions.CustomResourceDefinitionVersion{
+				{Name: "v1beta1", Served: true},
+				{Name: "v1", Served: true, Storage: true},
+			},
+		},
+	}
+
+	widget := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Widget",
+		"metadata":   map[string]interface{}{"name": "payments-widget", "namespace": "shop"},
+	}}
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{widgetResource: "WidgetList"}, widget)
+
+	return fake.NewClientset(objects...), apiextensionsfake.NewClientset(crd), dynamicClient
+}
+
+func TestSearch(t *testing.T) {
+	client, apiextensionsClient, dynamicClient := newTestClients(
+		newDeployment("shop", "payments-api", nil, "nginx:1.25"),
+		newDeployment("shop", "payments", nil, "nginx:1.25"),
+		newDeployment("shop", "checkout", map[string]string{"team": "payments"}, "nginx:1.25"),
+		newDeployment("shop", "worker", nil, "registry.example.com/team/payments:2.0"),
+		newDeployment("other", "payments", nil, "nginx:1.25"),
+		&v1.Service{ObjectMeta: metaV1.ObjectMeta{Namespace: "shop", Name: "payments"}},
+		&v1.ConfigMap{ObjectMeta: metaV1.ObjectMeta{
+			Namespace: "shop",
+			Name:      "settings",
+			Annotations: map[string]string{
+				"owner":                        "payments team",
+				v1.LastAppliedConfigAnnotation: "payments",
+			},
+		}},
+		&v1.Namespace{ObjectMeta: metaV1.ObjectMeta{Name: "payments"}},
+	)
+
+	result, err := Search(client, apiextensionsClient, dynamicClient, common.NewSameNamespaceQuery("shop"), "Payments", DefaultLimit)
+	if err != nil {
+		t.Fatalf("Search() returned error: %v", err)
+	}
+
+	kinds := make([]types.ResourceKind, 0)
+	for _, group := range result.Groups {
+		kinds = append(kinds, group.Kind)
+	}
+
+	expectedKinds := []types.ResourceKind{types.ResourceKindDeployment, types.ResourceKindService, "widgets.example.com", types.ResourceKindConfigMap}
+	if !reflect.DeepEqual(kinds, expectedKinds) {
+		t.Errorf("expected groups %v, got %v", expectedKinds, kinds)
+	}
+
+	deployments := make([]string, 0)
+	for _, item := range result.Groups[0].Items {
+		deployments = append(deployments, fmt.Sprintf("%s:%d", item.ObjectMeta.Name, item.Score))
+	}
+
+	expectedDeployments := []string{"payments:100", "payments-api:80", "checkout:50", "worker:45"}
+	if !reflect.DeepEqual(deployments, expectedDeployments) {
+		t.Errorf("expected deployments %v, got %v", expectedDeployments, deployments)
+	}
+
+	configMap := result.Groups[3].Items[0]
+	expectedMatches := []Match{{Field: MatchFieldAnnotation, Key: "owner", Value: "payments team"}}
+	if !reflect.DeepEqual(configMap.Matches, expectedMatches) {
+		t.Errorf("expected matches %+v, got %+v", expectedMatches, configMap.Matches)
+	}
+
+	if result.TotalItems != 7 || len(result.Errors) > 0 {
+		t.Errorf("expected 7 results without errors, got %+v", result)
+	}
+}
+
+func TestSearchLimit(t *testing.T) {
+	objects := make([]runtime.Object, 0)
+	for i := 0; i < 5; i++ {
+		objects = append(objects, &v1.Secret{ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: fmt.Sprintf("db-%d", i)}})
+	}
+
+	client, apiextensionsClient, dynamicClient := newTestClients(objects...)
+	result, err := Search(client, apiextensionsClient, dynamicClient, common.NewNamespaceQuery(nil), "db", 2)
+	if err != nil {
+		t.Fatalf("Search() returned error: %v", err)
+	}
+
+	if len(result.Groups) != 1 || result.Groups[0].TotalItems != 5 || len(result.Groups[0].Items) != 2 {
+		t.Fatalf("expected 2 of 5 secrets, got %+v", result.Groups)
+	}
+
+	if result.Groups[0].Items[0].ObjectMeta.Name != "db-0" || result.Groups[0].Items[1].ObjectMeta.Name != "db-1" {
+		t.Errorf("expected results with equal score ordered by name, got %+v", result.Groups[0].Items)
+	}
+}
+
+func TestSearchForbidden(t *testing.T) {
+	client, apiextensionsClient, dynamicClient := newTestClients(
+		&v1.ConfigMap{ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "payments"}},
+	)
+	client.PrependReactor("list", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, k8serrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "", fmt.Errorf("denied"))
+	})
+
+	result, err := Search(client, apiextensionsClient, dynamicClient, common.NewNamespaceQuery(nil), "payments", DefaultLimit)
+	if err != nil {
+		t.Fatalf("Search() returned error: %v", err)
+	}
+
+	if len(result.Errors) != 1 || result.Groups[0].Kind != types.ResourceKindConfigMap {
+		t.Errorf("expected config map result and non-critical error, got %+v", result)
+	}
+
+	client.PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, fmt.Errorf("connection refused")
+	})
+
+	if _, err := Search(client, apiextensionsClient, dynamicClient, common.NewNamespaceQuery(nil), "payments", DefaultLimit); err == nil {
+		t.Error("Search() should fail for critical errors")
+	}
+}
+
+func TestSearchInvalid(t *testing.T) {
+	client, apiextensionsClient, dynamicClient := newTestClients()
+	cases := []struct {
+		query string
+		limit int
+	}{
+		{" ", DefaultLimit},
+		{"payments", 0},
+		{"payments", MaxLimit + 1},
+	}
+
+	for _, c := range cases {
+		if _, err := Search(client, apiextensionsClient, dynamicClient, common.NewNamespaceQuery(nil), c.query, c.limit); err == nil {
+			t.Errorf("Search(%q, %d) should fail", c.query, c.limit)
+		}
+	}
+}
+
+func TestImageName(t *testing.T) {
+	cases := map[string]string{
+		"nginx":      "nginx",
+		"nginx:1.25": "nginx",
+		"registry.example.com:5000/team/payments":  "payments",
+		"registry.example.com/payments@sha256:abc": "payments",
+	}
+
+	for image, expected := range cases {
+		if actual := imageName(image); actual != expected {
+			t.Errorf("imageName(%q) = %q, expected %q", image, actual, expected)
+		}
+	}
+}
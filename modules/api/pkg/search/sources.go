@@ -0,0 +1,240 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	apps "k8s.io/api/apps/v1"
+	batch "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s.io/dashboard/api/pkg/resource/common"
+	"k8s.io/dashboard/errors"
+	"k8s.io/dashboard/types"
+)
+
+// source returns objects of a single kind, or of all custom resources, together with
+// non-critical errors.
+type source func() ([]candidate, []error, error)
+
+// getSources starts listing of all searched kinds. Lists are fetched concurrently through the
+// resource channels and are read when the returned sources are called.
+func getSources(client kubernetes.Interface, apiextensionsClient apiextensionsclientset.Interface,
+	dynamicClient dynamic.Interface, nsQuery *common.NamespaceQuery) []source {
+	channels := &common.ResourceChannels{
+		DeploymentList:              common.GetDeploymentListChannel(client, nsQuery, 1),
+		ReplicaSetList:              common.GetReplicaSetListChannel(client, nsQuery, 1),
+		StatefulSetList:             common.GetStatefulSetListChannel(client, nsQuery, 1),
+		DaemonSetList:               common.GetDaemonSetListChannel(client, nsQuery, 1),
+		JobList:                     common.GetJobListChannel(client, nsQuery, 1),
+		CronJobList:                 common.GetCronJobListChannel(client, nsQuery, 1),
+		ReplicationControllerList:   common.GetReplicationControllerListChannel(client, nsQuery, 1),
+		PodList:                     common.GetPodListChannel(client, nsQuery, 1),
+		ServiceList:                 common.GetServiceListChannel(client, nsQuery, 1),
+		IngressList:                 common.GetIngressListChannel(client, nsQuery, 1),
+		ConfigMapList:               common.GetConfigMapListChannel(client, nsQuery, 1),
+		SecretList:                  common.GetSecretListChannel(client, nsQuery, 1),
+		PersistentVolumeClaimList:   common.GetPersistentVolumeClaimListChannel(client, nsQuery, 1),
+		HorizontalPodAutoscalerList: common.GetHorizontalPodAutoscalerListChannel(client, nsQuery, 1),
+		ResourceQuotaList:           common.GetResourceQuotaListChannel(client, nsQuery, 1),
+		LimitRangeList:              common.GetLimitRangeListChannel(client, nsQuery, 1),
+		RoleList:                    common.GetRoleListChannel(client, nsQuery, 1),
+		RoleBindingList:             common.GetRoleBindingListChannel(client, nsQuery, 1),
+		PodDisruptionBudget:         common.GetPodDisruptionBudgetListChannel(client, nsQuery, 1),
+	}
+
+	sources := []source{
+		func() ([]candidate, []error, error) {
+			list := <-channels.DeploymentList.List
+			return toCandidates(types.ResourceKindDeployment, list.Items, <-channels.DeploymentList.Error,
+				func(item *apps.Deployment) []string { return podSpecImages(&item.Spec.Template.Spec) })
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.ReplicaSetList.List
+			return toCandidates(types.ResourceKindReplicaSet, list.Items, <-channels.ReplicaSetList.Error,
+				func(item *apps.ReplicaSet) []string { return podSpecImages(&item.Spec.Template.Spec) })
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.StatefulSetList.List
+			return toCandidates(types.ResourceKindStatefulSet, list.Items, <-channels.StatefulSetList.Error,
+				func(item *apps.StatefulSet) []string { return podSpecImages(&item.Spec.Template.Spec) })
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.DaemonSetList.List
+			return toCandidates(types.ResourceKindDaemonSet, list.Items, <-channels.DaemonSetList.Error,
+				func(item *apps.DaemonSet) []string { return podSpecImages(&item.Spec.Template.Spec) })
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.JobList.List
+			return toCandidates(types.ResourceKindJob, list.Items, <-channels.JobList.Error,
+				func(item *batch.Job) []string { return podSpecImages(&item.Spec.Template.Spec) })
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.CronJobList.List
+			return toCandidates(types.ResourceKindCronJob, list.Items, <-channels.CronJobList.Error,
+				func(item *batch.CronJob) []string { return podSpecImages(&item.Spec.JobTemplate.Spec.Template.Spec) })
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.ReplicationControllerList.List
+			return toCandidates(types.ResourceKindReplicationController, list.Items, <-channels.ReplicationControllerList.Error,
+				func(item *v1.ReplicationController) []string {
+					if item.Spec.Template == nil {
+						return nil
+					}
+
+					return podSpecImages(&item.Spec.Template.Spec)
+				})
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.PodList.List
+			return toCandidates(types.ResourceKindPod, list.Items, <-channels.PodList.Error,
+				func(item *v1.Pod) []string { return podSpecImages(&item.Spec) })
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.ServiceList.List
+			return toCandidates(types.ResourceKindService, list.Items, <-channels.ServiceList.Error, nil)
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.IngressList.List
+			return toCandidates(types.ResourceKindIngress, list.Items, <-channels.IngressList.Error, nil)
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.ConfigMapList.List
+			return toCandidates(types.ResourceKindConfigMap, list.Items, <-channels.ConfigMapList.Error, nil)
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.SecretList.List
+			return toCandidates(types.ResourceKindSecret, list.Items, <-channels.SecretList.Error, nil)
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.PersistentVolumeClaimList.List
+			return toCandidates(types.ResourceKindPersistentVolumeClaim, list.Items, <-channels.PersistentVolumeClaimList.Error, nil)
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.HorizontalPodAutoscalerList.List
+			return toCandidates(types.ResourceKindHorizontalPodAutoscaler, list.Items, <-channels.HorizontalPodAutoscalerList.Error, nil)
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.ResourceQuotaList.List
+			return toCandidates(types.ResourceKindResourceQuota, list.Items, <-channels.ResourceQuotaList.Error, nil)
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.LimitRangeList.List
+			return toCandidates(types.ResourceKindLimitRange, list.Items, <-channels.LimitRangeList.Error, nil)
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.RoleList.List
+			return toCandidates(types.ResourceKindRole, list.Items, <-channels.RoleList.Error, nil)
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.RoleBindingList.List
+			return toCandidates(types.ResourceKindRoleBinding, list.Items, <-channels.RoleBindingList.Error, nil)
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.PodDisruptionBudget.List
+			return toCandidates(types.ResourceKindPodDisruptionBudget, list.Items, <-channels.PodDisruptionBudget.Error, nil)
+		},
+	}
+
+	// Namespace query without namespaces selects all of them.
+	if nsQuery.Matches(v1.NamespaceAll) {
+		sources = append(sources, getClusterSources(client)...)
+	}
+
+	return append(sources, getCustomResourceSource(apiextensionsClient, dynamicClient, nsQuery))
+}
+
+func getClusterSources(client kubernetes.Interface) []source {
+	channels := &common.ResourceChannels{
+		NamespaceList:          common.GetNamespaceListChannel(client, 1),
+		NodeList:               common.GetNodeListChannel(client, 1),
+		PersistentVolumeList:   common.GetPersistentVolumeListChannel(client, 1),
+		StorageClassList:       common.GetStorageClassListChannel(client, 1),
+		IngressClassList:       common.GetIngressClassListChannel(client, 1),
+		ClusterRoleList:        common.GetClusterRoleListChannel(client, 1),
+		ClusterRoleBindingList: common.GetClusterRoleBindingListChannel(client, 1),
+	}
+
+	return []source{
+		func() ([]candidate, []error, error) {
+			list := <-channels.NamespaceList.List
+			return toCandidates(types.ResourceKindNamespace, list.Items, <-channels.NamespaceList.Error, nil)
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.NodeList.List
+			return toCandidates(types.ResourceKindNode, list.Items, <-channels.NodeList.Error, nil)
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.PersistentVolumeList.List
+			return toCandidates(types.ResourceKindPersistentVolume, list.Items, <-channels.PersistentVolumeList.Error, nil)
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.StorageClassList.List
+			return toCandidates(types.ResourceKindStorageClass, list.Items, <-channels.StorageClassList.Error, nil)
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.IngressClassList.List
+			return toCandidates(types.ResourceKindIngressClass, list.Items, <-channels.IngressClassList.Error, nil)
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.ClusterRoleList.List
+			return toCandidates(types.ResourceKindClusterRole, list.Items, <-channels.ClusterRoleList.Error, nil)
+		},
+		func() ([]candidate, []error, error) {
+			list := <-channels.ClusterRoleBindingList.List
+			return toCandidates(types.ResourceKindClusterRoleBinding, list.Items, <-channels.ClusterRoleBindingList.Error, nil)
+		},
+	}
+}
+
+// toCandidates converts listed objects to candidates. Forbidden lists are returned as
+// non-critical errors.
+func toCandidates[T any, PT interface {
+	*T
+	metaV1.Object
+}](kind types.ResourceKind, items []T, err error, images func(*T) []string) ([]candidate, []error, error) {
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if err != nil {
+		return nil, nonCriticalErrors, criticalError
+	}
+
+	result := make([]candidate, 0, len(items))
+	for i := range items {
+		c := candidate{kind: kind, object: PT(&items[i])}
+		if images != nil {
+			c.images = images(&items[i])
+		}
+
+		result = append(result, c)
+	}
+
+	return result, nil, nil
+}
+
+func podSpecImages(spec *v1.PodSpec) []string {
+	result := make([]string, 0, len(spec.InitContainers)+len(spec.Containers))
+	for _, container := range spec.InitContainers {
+		result = append(result, container.Image)
+	}
+
+	for _, container := range spec.Containers {
+		result = append(result, container.Image)
+	}
+
+	return result
+}
@@ -17,6 +17,8 @@ package client
 import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 )
 
 const (
@@ -50,6 +52,14 @@ const (
 // ResourceVerber is responsible for performing generic CRUD operations on all supported resources.
 type ResourceVerber interface {
 	Update(object *unstructured.Unstructured) error
+	Patch(kind string, namespace string, name string, patchType k8stypes.PatchType, data []byte) (runtime.Object, error)
 	Get(kind string, namespace string, name string) (runtime.Object, error)
 	Delete(kind string, namespace string, name string, propagationPolicy string, deleteNow bool) error
+
+	// Methods below address resources by explicit group, version and resource. Subresource is
+	// optional and can be one of "status", "scale" or "ephemeralcontainers".
+	UpdateResource(gvr schema.GroupVersionResource, object *unstructured.Unstructured, subresource string) error
+	PatchResource(gvr schema.GroupVersionResource, namespace string, name string, subresource string, patchType k8stypes.PatchType, data []byte) (runtime.Object, error)
+	GetResource(gvr schema.GroupVersionResource, namespace string, name string, subresource string) (runtime.Object, error)
+	DeleteResource(gvr schema.GroupVersionResource, namespace string, name string, propagationPolicy string, deleteNow bool) error
 }
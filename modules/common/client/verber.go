@@ -20,6 +20,7 @@ import (
 	"net/http"
 	"reflect"
 	"strings"
+	"sync"
 
 	jsonpatch "github.com/evanphx/json-patch/v5"
 	"github.com/gobuffalo/flect"
@@ -34,10 +35,26 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
+
+	"k8s.io/dashboard/errors"
 )
 
+// supportedSubresources lists subresources that can be accessed through the verber.
+var supportedSubresources = map[string]bool{
+	"status":              true,
+	"scale":               true,
+	"ephemeralcontainers": true,
+}
+
 var (
+	// cacheLock guards the discovery caches below, as they are shared between all requests.
+	cacheLock sync.RWMutex
+
 	kindToGroupVersionResource = map[string]schema.GroupVersionResource{}
+
+	// groupVersionResources contains all resources and subresources served by the API server.
+	// Subresources are stored with the "resource/subresource" name, same as in discovery.
+	groupVersionResources = map[schema.GroupVersionResource]metav1.APIResource{}
 )
 
 // resourceVerber is a struct responsible for doing common verb operations on resources, like
@@ -58,30 +75,93 @@ func (v *resourceVerber) groupVersionResourceFromUnstructured(object *unstructur
 }
 
 func (v *resourceVerber) groupVersionResourceFromKind(kind string) (schema.GroupVersionResource, error) {
-	if gvr, exists := kindToGroupVersionResource[kind]; exists {
+	if gvr, exists := lookupKind(kind); exists {
 		klog.V(4).InfoS("GroupVersionResource cache hit", "kind", kind)
 		return gvr, nil
 	}
 
 	klog.V(4).InfoS("GroupVersionResource cache miss", "kind", kind)
-	_, resourceList, err := v.discovery.ServerGroupsAndResources()
-	if err != nil {
-		return schema.GroupVersionResource{}, err
-	}
-
-	// Update cache
-	if err = v.buildGroupVersionResourceCache(resourceList); err != nil {
+	if err := v.refreshGroupVersionResourceCache(); err != nil {
 		return schema.GroupVersionResource{}, err
 	}
 
-	if gvr, exists := kindToGroupVersionResource[kind]; exists {
+	if gvr, exists := lookupKind(kind); exists {
 		return gvr, nil
 	}
 
 	return schema.GroupVersionResource{}, fmt.Errorf("could not find GVR for kind %s", kind)
 }
 
+// apiResourceFor returns the discovery information about given resource or subresource. The cache
+// is refreshed on miss, so that resources of newly added CRDs can be found.
+func (v *resourceVerber) apiResourceFor(gvr schema.GroupVersionResource, subresource string) (metav1.APIResource, error) {
+	if len(subresource) > 0 {
+		if !supportedSubresources[subresource] {
+			return metav1.APIResource{}, errors.NewBadRequest(fmt.Sprintf("unsupported subresource %q", subresource))
+		}
+
+		gvr.Resource = fmt.Sprintf("%s/%s", gvr.Resource, subresource)
+	}
+
+	if apiResource, exists := lookupGroupVersionResource(gvr); exists {
+		klog.V(4).InfoS("APIResource cache hit", "group", gvr.Group, "version", gvr.Version, "resource", gvr.Resource)
+		return apiResource, nil
+	}
+
+	klog.V(4).InfoS("APIResource cache miss", "group", gvr.Group, "version", gvr.Version, "resource", gvr.Resource)
+	if err := v.refreshGroupVersionResourceCache(); err != nil {
+		return metav1.APIResource{}, err
+	}
+
+	if apiResource, exists := lookupGroupVersionResource(gvr); exists {
+		return apiResource, nil
+	}
+
+	return metav1.APIResource{}, errors.NewNotFound(fmt.Sprintf("resource %s not found", gvr.String()))
+}
+
+// resourceInterfaceFor validates the request against discovery information and returns the client
+// for given resource.
+func (v *resourceVerber) resourceInterfaceFor(gvr schema.GroupVersionResource, namespace, subresource string) (dynamic.ResourceInterface, error) {
+	apiResource, err := v.apiResourceFor(gvr, subresource)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiResource.Namespaced && len(namespace) == 0 {
+		return nil, errors.NewBadRequest(fmt.Sprintf("resource %s is namespaced, namespace is required", gvr.String()))
+	}
+
+	if !apiResource.Namespaced && len(namespace) > 0 {
+		return nil, errors.NewBadRequest(fmt.Sprintf("resource %s is not namespaced", gvr.String()))
+	}
+
+	return v.client.Resource(gvr).Namespace(namespace), nil
+}
+
+func (v *resourceVerber) refreshGroupVersionResourceCache() error {
+	_, resourceList, err := v.discovery.ServerGroupsAndResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return err
+	}
+
+	// Partial results are used when some API groups, e.g. unavailable aggregated APIs, could not
+	// be discovered.
+	if err != nil {
+		klog.V(3).InfoS("could not discover some API groups", "error", err)
+	}
+
+	return v.buildGroupVersionResourceCache(resourceList)
+}
+
+// buildGroupVersionResourceCache replaces the discovery caches. Discovery returns groups in the
+// server preferred order with the core group first. The last match wins for the kind mapping, so
+// kinds served by multiple groups resolve to the non-core group, e.g. "event" resolves to
+// "events.k8s.io" and not to the core group.
 func (v *resourceVerber) buildGroupVersionResourceCache(resourceList []*metav1.APIResourceList) error {
+	kinds := make(map[string]schema.GroupVersionResource)
+	resources := make(map[schema.GroupVersionResource]metav1.APIResource)
+
 	for _, resource := range resourceList {
 		gv, err := schema.ParseGroupVersion(resource.GroupVersion)
 		if err != nil {
@@ -96,6 +176,8 @@ func (v *resourceVerber) buildGroupVersionResourceCache(resourceList []*metav1.A
 				Resource: apiResource.Name,
 			}
 
+			resources[gvr] = apiResource
+
 			// Ignore sub-resources. Top level resource names should not contain slash
 			if strings.Contains(apiResource.Name, "/") {
 				continue
@@ -103,17 +185,36 @@ func (v *resourceVerber) buildGroupVersionResourceCache(resourceList []*metav1.A
 
 			// Mapping for core resources
 			if len(apiResource.Group) == 0 {
-				kindToGroupVersionResource[strings.ToLower(apiResource.Kind)] = gvr
+				kinds[strings.ToLower(apiResource.Kind)] = gvr
 			}
 
 			// Mapping for CRD resources with custom kind
-			kindToGroupVersionResource[crdKind] = gvr
+			kinds[crdKind] = gvr
 		}
 	}
 
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+	kindToGroupVersionResource = kinds
+	groupVersionResources = resources
+
 	return nil
 }
 
+func lookupKind(kind string) (schema.GroupVersionResource, bool) {
+	cacheLock.RLock()
+	defer cacheLock.RUnlock()
+	gvr, exists := kindToGroupVersionResource[kind]
+	return gvr, exists
+}
+
+func lookupGroupVersionResource(gvr schema.GroupVersionResource) (metav1.APIResource, bool) {
+	cacheLock.RLock()
+	defer cacheLock.RUnlock()
+	apiResource, exists := groupVersionResources[gvr]
+	return apiResource, exists
+}
+
 func (v *resourceVerber) toDeletePropagationPolicy(propagation string) metav1.DeletionPropagation {
 	switch metav1.DeletionPropagation(propagation) {
 	case metav1.DeletePropagationBackground:
@@ -135,6 +236,23 @@ func (v *resourceVerber) Delete(kind string, namespace string, name string, prop
 		return err
 	}
 
+	klog.V(2).InfoS("deleting resource", "kind", kind, "namespace", namespace, "name", name, "propagationPolicy", propagationPolicy, "deleteNow", deleteNow)
+	return v.delete(v.client.Resource(gvr).Namespace(namespace), name, propagationPolicy, deleteNow)
+}
+
+// DeleteResource deletes the resource identified by the given group, version and resource in the given
+// namespace with the given name.
+func (v *resourceVerber) DeleteResource(gvr schema.GroupVersionResource, namespace string, name string, propagationPolicy string, deleteNow bool) error {
+	resource, err := v.resourceInterfaceFor(gvr, namespace, "")
+	if err != nil {
+		return err
+	}
+
+	klog.V(2).InfoS("deleting resource", "group", gvr.Group, "version", gvr.Version, "resource", gvr.Resource, "namespace", namespace, "name", name, "propagationPolicy", propagationPolicy, "deleteNow", deleteNow)
+	return v.delete(resource, name, propagationPolicy, deleteNow)
+}
+
+func (v *resourceVerber) delete(resource dynamic.ResourceInterface, name string, propagationPolicy string, deleteNow bool) error {
 	defaultPropagationPolicy := v.toDeletePropagationPolicy(propagationPolicy)
 	defaultDeleteOptions := metav1.DeleteOptions{
 		PropagationPolicy: &defaultPropagationPolicy,
@@ -145,19 +263,34 @@ func (v *resourceVerber) Delete(kind string, namespace string, name string, prop
 		defaultDeleteOptions.GracePeriodSeconds = &gracePeriodSeconds
 	}
 
-	klog.V(2).InfoS("deleting resource", "kind", kind, "namespace", namespace, "name", name, "propagationPolicy", propagationPolicy, "deleteNow", deleteNow)
-	return v.client.Resource(gvr).Namespace(namespace).Delete(context.TODO(), name, defaultDeleteOptions)
+	return resource.Delete(context.TODO(), name, defaultDeleteOptions)
 }
 
 // Update patches resource of the given kind in the given namespace with the given name.
 func (v *resourceVerber) Update(object *unstructured.Unstructured) error {
+	gvr := v.groupVersionResourceFromUnstructured(object)
+	return v.update(v.client.Resource(gvr).Namespace(object.GetNamespace()), gvr, object, "")
+}
+
+// UpdateResource patches the resource or its subresource identified by the given group, version and
+// resource, so that it matches the given object.
+func (v *resourceVerber) UpdateResource(gvr schema.GroupVersionResource, object *unstructured.Unstructured, subresource string) error {
+	resource, err := v.resourceInterfaceFor(gvr, object.GetNamespace(), subresource)
+	if err != nil {
+		return err
+	}
+
+	return v.update(resource, gvr, object, subresource)
+}
+
+func (v *resourceVerber) update(resource dynamic.ResourceInterface, gvr schema.GroupVersionResource, object *unstructured.Unstructured, subresource string) error {
 	name := object.GetName()
 	namespace := object.GetNamespace()
-	gvr := v.groupVersionResourceFromUnstructured(object)
+	subresources := toSubresources(subresource)
 
 	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		klog.V(4).InfoS("fetching latest resource version", "group", gvr.Group, "version", gvr.Version, "resource", gvr.Resource, "name", name, "namespace", namespace)
-		result, getErr := v.client.Resource(gvr).Namespace(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		klog.V(4).InfoS("fetching latest resource version", "group", gvr.Group, "version", gvr.Version, "resource", gvr.Resource, "subresource", subresource, "name", name, "namespace", namespace)
+		result, getErr := resource.Get(context.TODO(), name, metav1.GetOptions{}, subresources...)
 		if getErr != nil {
 			return fmt.Errorf("failed to get latest %s version: %w", gvr.Resource, getErr)
 		}
@@ -192,8 +325,8 @@ func (v *resourceVerber) Update(object *unstructured.Unstructured) error {
 				return fmt.Errorf("failed creating merge patch: %w", err)
 			}
 
-			klog.V(2).InfoS("patching resource", "group", gvr.Group, "version", gvr.Version, "resource", gvr.Resource, "name", name, "namespace", namespace, "patch", string(patchBytes))
-			_, updateErr := v.client.Resource(gvr).Namespace(namespace).Patch(context.TODO(), name, k8stypes.MergePatchType, patchBytes, metav1.PatchOptions{})
+			klog.V(2).InfoS("patching resource", "group", gvr.Group, "version", gvr.Version, "resource", gvr.Resource, "subresource", subresource, "name", name, "namespace", namespace, "patch", string(patchBytes))
+			_, updateErr := resource.Patch(context.TODO(), name, k8stypes.MergePatchType, patchBytes, metav1.PatchOptions{}, subresources...)
 			return updateErr
 		case err != nil:
 			return err
@@ -203,13 +336,53 @@ func (v *resourceVerber) Update(object *unstructured.Unstructured) error {
 				return fmt.Errorf("failed creating two way merge patch: %w", err)
 			}
 
-			klog.V(2).InfoS("patching resource", "group", gvr.Group, "version", gvr.Version, "resource", gvr.Resource, "name", name, "namespace", namespace, "patch", string(patchBytes))
-			_, updateErr := v.client.Resource(gvr).Namespace(namespace).Patch(context.TODO(), name, k8stypes.StrategicMergePatchType, patchBytes, metav1.PatchOptions{})
+			klog.V(2).InfoS("patching resource", "group", gvr.Group, "version", gvr.Version, "resource", gvr.Resource, "subresource", subresource, "name", name, "namespace", namespace, "patch", string(patchBytes))
+			_, updateErr := resource.Patch(context.TODO(), name, k8stypes.StrategicMergePatchType, patchBytes, metav1.PatchOptions{}, subresources...)
 			return updateErr
 		}
 	})
 }
 
+// Patch applies the patch of the given type to the resource of the given kind in the given namespace
+// with the given name. Strategic merge patches of resources that are not known to the client
+// scheme, e.g. custom resources, are sent as JSON merge patches, as the API server does not
+// support strategic merge for them.
+func (v *resourceVerber) Patch(kind string, namespace string, name string, patchType k8stypes.PatchType, data []byte) (runtime.Object, error) {
+	gvr, err := v.groupVersionResourceFromKind(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.patch(v.client.Resource(gvr).Namespace(namespace), gvr, namespace, name, "", patchType, data)
+}
+
+// PatchResource applies the patch of the given type to the resource or its subresource identified by
+// the given group, version and resource. See Patch for details about supported patch types.
+func (v *resourceVerber) PatchResource(gvr schema.GroupVersionResource, namespace string, name string, subresource string, patchType k8stypes.PatchType, data []byte) (runtime.Object, error) {
+	resource, err := v.resourceInterfaceFor(gvr, namespace, subresource)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.patch(resource, gvr, namespace, name, subresource, patchType, data)
+}
+
+func (v *resourceVerber) patch(resource dynamic.ResourceInterface, gvr schema.GroupVersionResource, namespace string, name string, subresource string, patchType k8stypes.PatchType, data []byte) (runtime.Object, error) {
+	switch patchType {
+	case k8stypes.JSONPatchType, k8stypes.MergePatchType:
+	case k8stypes.StrategicMergePatchType:
+		if !scheme.Scheme.IsVersionRegistered(gvr.GroupVersion()) {
+			klog.V(3).InfoS("strategic merge patch is not supported, falling back to merge patch", "group", gvr.Group, "version", gvr.Version, "resource", gvr.Resource)
+			patchType = k8stypes.MergePatchType
+		}
+	default:
+		return nil, errors.NewBadRequest(fmt.Sprintf("unsupported patch type %q", patchType))
+	}
+
+	klog.V(2).InfoS("patching resource", "group", gvr.Group, "version", gvr.Version, "resource", gvr.Resource, "subresource", subresource, "name", name, "namespace", namespace, "patchType", patchType, "patch", string(data))
+	return resource.Patch(context.TODO(), name, patchType, data, metav1.PatchOptions{}, toSubresources(subresource)...)
+}
+
 // Get gets the resource of the given kind in the given namespace with the given name.
 func (v *resourceVerber) Get(kind string, namespace string, name string) (runtime.Object, error) {
 	gvr, err := v.groupVersionResourceFromKind(kind)
@@ -220,6 +393,25 @@ func (v *resourceVerber) Get(kind string, namespace string, name string) (runtim
 	return v.client.Resource(gvr).Namespace(namespace).Get(context.TODO(), name, metav1.GetOptions{})
 }
 
+// GetResource gets the resource or its subresource identified by the given group, version and resource
+// in the given namespace with the given name.
+func (v *resourceVerber) GetResource(gvr schema.GroupVersionResource, namespace string, name string, subresource string) (runtime.Object, error) {
+	resource, err := v.resourceInterfaceFor(gvr, namespace, subresource)
+	if err != nil {
+		return nil, err
+	}
+
+	return resource.Get(context.TODO(), name, metav1.GetOptions{}, toSubresources(subresource)...)
+}
+
+func toSubresources(subresource string) []string {
+	if len(subresource) == 0 {
+		return nil
+	}
+
+	return []string{subresource}
+}
+
 func VerberClient(request *http.Request) (ResourceVerber, error) {
 	config, err := configFromRequest(request)
 	if err != nil {
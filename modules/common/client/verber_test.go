@@ -0,0 +1,123 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var (
+	podGVR        = schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	nodeGVR       = schema.GroupVersionResource{Version: "v1", Resource: "nodes"}
+	deploymentGVR = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+)
+
+// newTestVerber returns a verber with discovery serving resources in the server preferred order,
+// i.e. the core group first.
+func newTestVerber() *resourceVerber {
+	resources := []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Namespaced: true, Kind: "Pod"},
+				{Name: "pods/status", Namespaced: true, Kind: "Pod"},
+				{Name: "events", Namespaced: true, Kind: "Event"},
+				{Name: "nodes", Namespaced: false, Kind: "Node"},
+			},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", Namespaced: true, Kind: "Deployment"},
+				{Name: "deployments/scale", Namespaced: true, Group: "autoscaling", Version: "v1", Kind: "Scale"},
+			},
+		},
+		{
+			GroupVersion: "events.k8s.io/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "events", Namespaced: true, Kind: "Event"},
+			},
+		},
+	}
+
+	return &resourceVerber{
+		client:    dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()),
+		discovery: &fakediscovery.FakeDiscovery{Fake: &k8stesting.Fake{Resources: resources}},
+	}
+}
+
+func TestGroupVersionResourceFromKind(t *testing.T) {
+	verber := newTestVerber()
+	cases := map[string]schema.GroupVersionResource{
+		"pod":                  podGVR,
+		"node":                 nodeGVR,
+		"deployment":           deploymentGVR,
+		"deployments.apps":     deploymentGVR,
+		"event":                {Group: "events.k8s.io", Version: "v1", Resource: "events"},
+		"events.events.k8s.io": {Group: "events.k8s.io", Version: "v1", Resource: "events"},
+	}
+
+	for kind, expected := range cases {
+		actual, err := verber.groupVersionResourceFromKind(kind)
+		require.NoError(t, err, kind)
+		assert.Equal(t, expected, actual, kind)
+	}
+
+	_, err := verber.groupVersionResourceFromKind("unknown")
+	assert.Error(t, err)
+}
+
+func TestResourceInterfaceFor(t *testing.T) {
+	verber := newTestVerber()
+	cases := []struct {
+		name        string
+		gvr         schema.GroupVersionResource
+		namespace   string
+		subresource string
+		check       func(error) bool
+	}{
+		{"namespaced resource", podGVR, "default", "", nil},
+		{"cluster-scoped resource", nodeGVR, "", "", nil},
+		{"served subresource", deploymentGVR, "default", "scale", nil},
+		{"unknown subresource", podGVR, "default", "exec", k8serrors.IsBadRequest},
+		{"subresource not served", deploymentGVR, "default", "ephemeralcontainers", k8serrors.IsNotFound},
+		{"unknown resource", schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}, "default", "", k8serrors.IsNotFound},
+		{"namespace on cluster-scoped resource", nodeGVR, "default", "", k8serrors.IsBadRequest},
+		{"namespaced resource without namespace", podGVR, "", "", k8serrors.IsBadRequest},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			resource, err := verber.resourceInterfaceFor(c.gvr, c.namespace, c.subresource)
+			if c.check == nil {
+				require.NoError(t, err)
+				assert.NotNil(t, resource)
+				return
+			}
+
+			assert.True(t, c.check(err), "unexpected error: %v", err)
+		})
+	}
+}
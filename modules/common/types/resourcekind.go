@@ -21,37 +21,55 @@ type ResourceKind string
 
 // List of all resource kinds supported by the UI.
 const (
-	ResourceKindConfigMap                = "configmap"
-	ResourceKindDaemonSet                = "daemonset"
-	ResourceKindDeployment               = "deployment"
-	ResourceKindEvent                    = "event"
-	ResourceKindHorizontalPodAutoscaler  = "horizontalpodautoscaler"
-	ResourceKindIngress                  = "ingress"
-	ResourceKindServiceAccount           = "serviceaccount"
-	ResourceKindJob                      = "job"
-	ResourceKindCronJob                  = "cronjob"
-	ResourceKindLimitRange               = "limitrange"
-	ResourceKindNamespace                = "namespace"
-	ResourceKindNode                     = "node"
-	ResourceKindPersistentVolumeClaim    = "persistentvolumeclaim"
-	ResourceKindPodDisruptionBudget      = "poddisruptionbudget"
-	ResourceKindPersistentVolume         = "persistentvolume"
-	ResourceKindCustomResourceDefinition = "customresourcedefinition"
-	ResourceKindPod                      = "pod"
-	ResourceKindReplicaSet               = "replicaset"
-	ResourceKindReplicationController    = "replicationcontroller"
-	ResourceKindResourceQuota            = "resourcequota"
-	ResourceKindSecret                   = "secret"
-	ResourceKindService                  = "service"
-	ResourceKindStatefulSet              = "statefulset"
-	ResourceKindStorageClass             = "storageclass"
-	ResourceKindClusterRole              = "clusterrole"
-	ResourceKindClusterRoleBinding       = "clusterrolebinding"
-	ResourceKindRole                     = "role"
-	ResourceKindRoleBinding              = "rolebinding"
-	ResourceKindEndpoint                 = "endpoint"
-	ResourceKindNetworkPolicy            = "networkpolicy"
-	ResourceKindIngressClass             = "ingressclass"
+	ResourceKindConfigMap                      = "configmap"
+	ResourceKindDaemonSet                      = "daemonset"
+	ResourceKindDeployment                     = "deployment"
+	ResourceKindEvent                          = "event"
+	ResourceKindHorizontalPodAutoscaler        = "horizontalpodautoscaler"
+	ResourceKindIngress                        = "ingress"
+	ResourceKindServiceAccount                 = "serviceaccount"
+	ResourceKindJob                            = "job"
+	ResourceKindCronJob                        = "cronjob"
+	ResourceKindLimitRange                     = "limitrange"
+	ResourceKindNamespace                      = "namespace"
+	ResourceKindNode                           = "node"
+	ResourceKindPersistentVolumeClaim          = "persistentvolumeclaim"
+	ResourceKindPodDisruptionBudget            = "poddisruptionbudget"
+	ResourceKindPersistentVolume               = "persistentvolume"
+	ResourceKindCustomResourceDefinition       = "customresourcedefinition"
+	ResourceKindPod                            = "pod"
+	ResourceKindReplicaSet                     = "replicaset"
+	ResourceKindReplicationController          = "replicationcontroller"
+	ResourceKindResourceQuota                  = "resourcequota"
+	ResourceKindSecret                         = "secret"
+	ResourceKindService                        = "service"
+	ResourceKindStatefulSet                    = "statefulset"
+	ResourceKindStorageClass                   = "storageclass"
+	ResourceKindClusterRole                    = "clusterrole"
+	ResourceKindClusterRoleBinding             = "clusterrolebinding"
+	ResourceKindRole                           = "role"
+	ResourceKindRoleBinding                    = "rolebinding"
+	ResourceKindEndpoint                       = "endpoint"
+	ResourceKindNetworkPolicy                  = "networkpolicy"
+	ResourceKindIngressClass                   = "ingressclass"
+	ResourceKindHelmRelease                    = "helmrelease"
+	ResourceKindVolumeSnapshot                 = "volumesnapshot"
+	ResourceKindVolumeSnapshotClass            = "volumesnapshotclass"
+	ResourceKindVolumeSnapshotContent          = "volumesnapshotcontent"
+	ResourceKindGatewayClass                   = "gatewayclass"
+	ResourceKindGateway                        = "gateway"
+	ResourceKindHTTPRoute                      = "httproute"
+	ResourceKindGRPCRoute                      = "grpcroute"
+	ResourceKindReferenceGrant                 = "referencegrant"
+	ResourceKindValidatingWebhookConfiguration = "validatingwebhookconfiguration"
+	ResourceKindMutatingWebhookConfiguration   = "mutatingwebhookconfiguration"
+	ResourceKindValidatingAdmissionPolicy      = "validatingadmissionpolicy"
+	ResourceKindPriorityClass                  = "priorityclass"
+	ResourceKindRuntimeClass                   = "runtimeclass"
+	ResourceKindLease                          = "lease"
+	ResourceKindCSIDriver                      = "csidriver"
+	ResourceKindCSINode                        = "csinode"
+	ResourceKindVolumeAttachment               = "volumeattachment"
 )
 
 // Scalable method return whether ResourceKind is scalable.